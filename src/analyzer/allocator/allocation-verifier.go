@@ -0,0 +1,856 @@
+package allocator
+
+import (
+	"fmt"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer/util"
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// A mismatch between the allocator's operations and the instructions' /
+// blocks' location requirements.
+type AllocationDiagnostic struct {
+	Block *ast.Block
+
+	// Index into the block's operations.  The index is -1 when the mismatch is
+	// in the block's LocationIn, and is len(Operations) when the mismatch is
+	// at the block's exit boundary (i.e., a child block's LocationIn).
+	OperationIndex int
+
+	Message string
+}
+
+func (diag *AllocationDiagnostic) String() string {
+	return fmt.Sprintf(
+		"block %s (%s) operation %d: %s",
+		diag.Block.Label,
+		diag.Block.Loc(),
+		diag.OperationIndex,
+		diag.Message)
+}
+
+type AllocationVerifier struct {
+	*Allocator
+	*parseutil.Emitter
+}
+
+// An independent checker pass that runs after the allocator.  Any mismatch
+// indicates an allocator bug.
+func VerifyAllocation(
+	allocator *Allocator,
+	emitter *parseutil.Emitter,
+) util.Pass[ast.SourceEntry] {
+	return &AllocationVerifier{
+		Allocator: allocator,
+		Emitter:   emitter,
+	}
+}
+
+func (verifier *AllocationVerifier) Process(entry ast.SourceEntry) {
+	_, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	for _, diag := range Verify(verifier.Allocator) {
+		verifier.Emit(
+			diag.Block.Loc(),
+			"allocation mismatch in %s: %s",
+			verifier.FuncDef.Label,
+			diag)
+	}
+}
+
+// A symbolic value produced by an operation.  Copies of the same value share
+// the same symbolic value.
+type symbolicValue struct {
+	name string
+
+	// The value which this value was renamed from (i.e., the allocator
+	// transferred the value's location to a different definition).
+	renamedFrom *symbolicValue
+}
+
+// One register sized chunk of a symbolic value.
+type registerContent struct {
+	*symbolicValue
+	chunk int
+}
+
+// The simulated register / stack content at a particular point in execution.
+// Allocation ownership is tracked separately from content since a freed
+// location still holds its (stale) value.
+type simulatedLocations struct {
+	// Definition name -> the definition's latest value.
+	current map[string]*symbolicValue
+
+	registerOwners   map[*arch.Register]string
+	registerContents map[*arch.Register]registerContent
+
+	// Fixed stack locations are keyed by definition name.
+	fixedStackOwners   map[string]bool
+	fixedStackContents map[string]*symbolicValue
+
+	// Temp stack locations are keyed by offset.
+	tempStackOwners   map[int]string
+	tempStackContents map[int]*symbolicValue
+}
+
+func newSimulatedLocations() *simulatedLocations {
+	return &simulatedLocations{
+		current:            map[string]*symbolicValue{},
+		registerOwners:     map[*arch.Register]string{},
+		registerContents:   map[*arch.Register]registerContent{},
+		fixedStackOwners:   map[string]bool{},
+		fixedStackContents: map[string]*symbolicValue{},
+		tempStackOwners:    map[int]string{},
+		tempStackContents:  map[int]*symbolicValue{},
+	}
+}
+
+// Returns a copy of the locations where only the named definitions remain
+// allocated.
+func (locations *simulatedLocations) copyWith(
+	names map[string]struct{},
+) *simulatedLocations {
+	result := newSimulatedLocations()
+	for name, value := range locations.current {
+		result.current[name] = value
+	}
+
+	for reg, name := range locations.registerOwners {
+		_, ok := names[name]
+		if ok {
+			result.registerOwners[reg] = name
+		}
+	}
+
+	for reg, content := range locations.registerContents {
+		result.registerContents[reg] = content
+	}
+
+	for name := range locations.fixedStackOwners {
+		_, ok := names[name]
+		if ok {
+			result.fixedStackOwners[name] = true
+		}
+	}
+
+	for name, value := range locations.fixedStackContents {
+		result.fixedStackContents[name] = value
+	}
+
+	return result
+}
+
+type allocationVerifier struct {
+	*Allocator
+
+	calleeSavedParameters map[string]*ast.VariableDefinition
+	entryLocationIn       LocationSet
+
+	diagnostics []*AllocationDiagnostic
+}
+
+// Symbolically replays the allocator's operations, and checks that:
+//   - every block's LocationIn covers the block's live in definitions.
+//   - every ExecuteInstruction's sources hold the referenced definitions'
+//     values, and their locations satisfy the instruction's constraints.
+//   - callee-saved registers hold their original values at ret.
+//   - every block's exit locations agree with its children's LocationIn.
+//
+// Transfer blocks (which have no LocationIn) are replayed as part of their
+// parent block.
+func Verify(allocator *Allocator) []*AllocationDiagnostic {
+	if allocator.FuncDef == nil {
+		return nil
+	}
+
+	entryBlock := allocator.FuncDef.Blocks[0]
+	verifier := &allocationVerifier{
+		Allocator:             allocator,
+		calleeSavedParameters: map[string]*ast.VariableDefinition{},
+		entryLocationIn:       allocator.BlockStates[entryBlock].LocationIn,
+	}
+
+	for _, param := range allocator.FuncDef.CalleeSavedParameters {
+		verifier.calleeSavedParameters[param.Name] = param
+	}
+
+	for _, block := range allocator.FuncDef.Blocks {
+		state := allocator.BlockStates[block]
+		if state.LocationIn == nil { // transfer block
+			continue
+		}
+
+		replayer := &operationsReplayer{
+			allocationVerifier: verifier,
+			BlockState:         state,
+			simulatedLocations: newSimulatedLocations(),
+			operationIndex:     -1,
+		}
+		replayer.initializeLocationIn()
+		replayer.replay()
+	}
+
+	return verifier.diagnostics
+}
+
+// The register destination of the last executed instruction.  The
+// destination's registers are only known once the location is allocated.
+type pendingDestination struct {
+	name       string
+	constraint *arch.LocationConstraint
+	selected   map[*arch.RegisterConstraint]*arch.Register
+}
+
+type operationsReplayer struct {
+	*allocationVerifier
+	*BlockState
+	*simulatedLocations
+
+	operationIndex int
+	previous       *arch.Operation
+	pending        *pendingDestination
+}
+
+func (replayer *operationsReplayer) emit(
+	format string,
+	args ...interface{},
+) {
+	replayer.diagnostics = append(
+		replayer.diagnostics,
+		&AllocationDiagnostic{
+			Block:          replayer.Block,
+			OperationIndex: replayer.operationIndex,
+			Message:        fmt.Sprintf(format, args...),
+		})
+}
+
+func (replayer *operationsReplayer) initializeLocationIn() {
+	for def := range replayer.LiveIn {
+		_, ok := replayer.LocationIn[def]
+		if !ok {
+			replayer.emit("live in definition (%s) has no location", def.Name)
+		}
+	}
+
+	for def, loc := range replayer.LocationIn {
+		if loc.Name != def.Name {
+			replayer.emit(
+				"definition (%s) has mismatched location name (%s)",
+				def.Name,
+				loc.Name)
+		}
+
+		if loc.OnTempStack {
+			replayer.emit("definition (%s) located on temp stack", def.Name)
+			continue
+		}
+
+		value := &symbolicValue{name: def.Name}
+		replayer.current[def.Name] = value
+		replayer.allocate(loc)
+		replayer.write(loc, value)
+	}
+}
+
+func (replayer *operationsReplayer) replay() {
+	for idx := range replayer.Operations {
+		op := &replayer.Operations[idx]
+		replayer.operationIndex = idx
+
+		switch op.Kind {
+		case arch.ExecuteInstruction:
+			replayer.executeInstruction(op)
+		case arch.PushStackFrame, arch.PopStackFrame:
+			// Stack frame adjustments do not modify data locations.
+		case arch.MoveRegister:
+			replayer.moveRegister(op.SrcRegister, op.DestRegister)
+		case arch.CopyLocation:
+			replayer.copyLocation(op.Sources[0], op.Destination, op.DestRegister)
+		case arch.SetConstantValue,
+			arch.SetFramePointerAddress,
+			arch.InitializeZeros:
+
+			replayer.checkAllocated(op.Destination)
+			replayer.clobberScratch(op.DestRegister)
+			replayer.write(op.Destination, replayer.define(op.Destination.Name))
+		case arch.AllocateLocation:
+			replayer.allocateOperation(op.Destination)
+		case arch.FreeLocation:
+			replayer.free(op.Destination)
+		default:
+			replayer.emit("unexpected operation (%s)", op.Kind)
+		}
+
+		replayer.previous = op
+	}
+
+	replayer.operationIndex = len(replayer.Operations)
+	replayer.pending = nil
+
+	for offset, name := range replayer.tempStackOwners {
+		replayer.emit(
+			"temp stack location (%s at offset %d) is not freed",
+			name,
+			offset)
+	}
+
+	for _, child := range replayer.Children {
+		childState := replayer.BlockStates[child]
+		if childState.LocationIn != nil {
+			replayer.checkChildLocationIn(childState)
+			continue
+		}
+
+		// Transfer block.  Only the definitions used by the transfer block's
+		// child remain allocated.
+		names := map[string]struct{}{}
+		for _, grandchild := range child.Children {
+			for def := range replayer.BlockStates[grandchild].LocationIn {
+				names[def.Name] = struct{}{}
+			}
+		}
+
+		transferReplayer := &operationsReplayer{
+			allocationVerifier: replayer.allocationVerifier,
+			BlockState:         childState,
+			simulatedLocations: replayer.copyWith(names),
+			operationIndex:     -1,
+		}
+		transferReplayer.replay()
+	}
+}
+
+func (replayer *operationsReplayer) checkChildLocationIn(child *BlockState) {
+	for def, loc := range child.LocationIn {
+		replayer.checkHolds(
+			fmt.Sprintf("child %s's LocationIn", child.Label),
+			loc,
+			def.Name)
+	}
+}
+
+// Creates a new value for the definition name.
+func (replayer *operationsReplayer) define(name string) *symbolicValue {
+	_, ok := replayer.calleeSavedParameters[name]
+	if ok {
+		replayer.emit("callee-saved parameter (%s) is overwritten", name)
+	}
+
+	value := &symbolicValue{name: name}
+	replayer.current[name] = value
+	return value
+}
+
+func (replayer *operationsReplayer) read(
+	loc *arch.DataLocation,
+) *symbolicValue {
+	if loc.OnFixedStack {
+		return replayer.fixedStackContents[loc.Name]
+	} else if loc.OnTempStack {
+		return replayer.tempStackContents[loc.Offset]
+	}
+
+	var value *symbolicValue
+	for idx, reg := range loc.Registers {
+		content, ok := replayer.registerContents[reg]
+		if !ok || content.chunk != idx {
+			return nil
+		}
+
+		if idx == 0 {
+			value = content.symbolicValue
+		} else if value != content.symbolicValue {
+			return nil
+		}
+	}
+
+	return value
+}
+
+func (replayer *operationsReplayer) write(
+	loc *arch.DataLocation,
+	value *symbolicValue,
+) {
+	if value == nil {
+		replayer.clobber(loc)
+		return
+	}
+
+	if loc.OnFixedStack {
+		replayer.fixedStackContents[loc.Name] = value
+	} else if loc.OnTempStack {
+		replayer.tempStackContents[loc.Offset] = value
+	} else {
+		for idx, reg := range loc.Registers {
+			replayer.registerContents[reg] = registerContent{
+				symbolicValue: value,
+				chunk:         idx,
+			}
+		}
+	}
+}
+
+func (replayer *operationsReplayer) clobber(loc *arch.DataLocation) {
+	if loc.OnFixedStack {
+		delete(replayer.fixedStackContents, loc.Name)
+	} else if loc.OnTempStack {
+		delete(replayer.tempStackContents, loc.Offset)
+	} else {
+		for _, reg := range loc.Registers {
+			delete(replayer.registerContents, reg)
+		}
+	}
+}
+
+func (replayer *operationsReplayer) clobberScratch(scratch *arch.Register) {
+	if scratch == nil {
+		return
+	}
+
+	owner, ok := replayer.registerOwners[scratch]
+	if ok {
+		replayer.emit("scratch register %s is used by %s", scratch.Name, owner)
+	}
+	delete(replayer.registerContents, scratch)
+}
+
+func (replayer *operationsReplayer) checkAllocated(
+	loc *arch.DataLocation,
+) bool {
+	if loc.OnFixedStack {
+		if !replayer.fixedStackOwners[loc.Name] {
+			replayer.emit("fixed stack location (%s) is not allocated", loc.Name)
+			return false
+		}
+	} else if loc.OnTempStack {
+		owner, ok := replayer.tempStackOwners[loc.Offset]
+		if !ok || owner != loc.Name {
+			replayer.emit(
+				"temp stack location (%s at offset %d) is not allocated",
+				loc.Name,
+				loc.Offset)
+			return false
+		}
+	} else {
+		for _, reg := range loc.Registers {
+			owner, ok := replayer.registerOwners[reg]
+			if !ok || owner != loc.Name {
+				replayer.emit("register %s is not allocated to %s", reg.Name, loc.Name)
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Checks that the location is allocated to the named definition, and holds
+// the definition's latest value.
+func (replayer *operationsReplayer) checkHolds(
+	context string,
+	loc *arch.DataLocation,
+	name string,
+) bool {
+	value, ok := replayer.current[name]
+	if !ok {
+		replayer.emit("%s: definition (%s) is not available", context, name)
+		return false
+	}
+
+	if loc.Name != name {
+		replayer.emit(
+			"%s: location (%s) does not belong to definition (%s)",
+			context,
+			loc.Name,
+			name)
+		return false
+	}
+
+	if !replayer.checkAllocated(loc) {
+		return false
+	}
+
+	// Zero-sized (unit) values occupy no space and are trivially up to date.
+	if !loc.OnFixedStack && !loc.OnTempStack && len(loc.Registers) == 0 {
+		return true
+	}
+
+	if replayer.read(loc) != value {
+		replayer.emit(
+			"%s: location (%s) does not hold definition (%s)'s latest value",
+			context,
+			loc,
+			name)
+		return false
+	}
+
+	return true
+}
+
+// Checks the location against the constraint.  Register constraints which
+// share the same pointer must be satisfied by the same register.
+func (replayer *operationsReplayer) checkConstraint(
+	context string,
+	loc *arch.DataLocation,
+	constraint *arch.LocationConstraint,
+	selected map[*arch.RegisterConstraint]*arch.Register,
+) {
+	if constraint == nil || constraint.AnyLocation {
+		return
+	}
+
+	if constraint.RequireOnStack {
+		if !loc.IsOnStack() {
+			replayer.emit("%s: location (%s) is not on stack", context, loc)
+		}
+		return
+	}
+
+	if loc.IsOnStack() || len(loc.Registers) != len(constraint.Registers) {
+		replayer.emit(
+			"%s: location (%s) does not match %d register constraints",
+			context,
+			loc,
+			len(constraint.Registers))
+		return
+	}
+
+	for idx, reg := range loc.Registers {
+		regConstraint := constraint.Registers[idx]
+		if !regConstraint.SatisfyBy(reg) {
+			replayer.emit(
+				"%s: register %s does not satisfy constraint %d",
+				context,
+				reg.Name,
+				idx)
+		}
+
+		prev, ok := selected[regConstraint]
+		if ok && prev != reg {
+			replayer.emit(
+				"%s: register %s does not match shared constraint's register %s",
+				context,
+				reg.Name,
+				prev.Name)
+		}
+		selected[regConstraint] = reg
+	}
+}
+
+func (replayer *operationsReplayer) executeInstruction(op *arch.Operation) {
+	replayer.pending = nil
+
+	inst := op.Instruction
+	if inst == nil {
+		replayer.emit("instruction not set")
+		return
+	}
+
+	// NOTE: transfer blocks and inserted jumps have no constraints.
+	constraints := replayer.Constraints[inst]
+
+	srcValues := inst.Sources()
+	if len(srcValues) != len(op.Sources) {
+		replayer.emit(
+			"expected %d sources, found %d (%s)",
+			len(srcValues),
+			len(op.Sources),
+			inst)
+		return
+	}
+
+	selected := map[*arch.RegisterConstraint]*arch.Register{}
+	for idx, loc := range op.Sources {
+		var constraint *arch.LocationConstraint
+		if constraints != nil {
+			constraint = constraints.Sources[idx]
+		}
+
+		replayer.checkSource(idx, srcValues[idx], loc, constraint, selected)
+	}
+
+	if constraints != nil && constraints.FramePointerRegister != nil {
+		replayer.checkHolds(
+			"frame pointer",
+			arch.NewRegistersDataLocation(
+				arch.CurrentFramePointer,
+				nil,
+				[]*arch.Register{constraints.FramePointerRegister}),
+			arch.CurrentFramePointer)
+	}
+
+	switch term := inst.(type) {
+	case *ast.Terminal:
+		if term.Kind == ast.Ret {
+			replayer.checkCalleeSavedRegisters()
+		}
+	case *ast.TailCall:
+		replayer.checkCalleeSavedRegisters()
+	}
+
+	if constraints != nil {
+		for reg, clobbered := range constraints.RequiredRegisters {
+			if clobbered {
+				delete(replayer.registerContents, reg)
+			}
+		}
+
+		for idx, loc := range op.Sources {
+			if loc.EncodedImmediate == nil &&
+				constraints.Sources[idx].ClobberedByInstruction() {
+
+				replayer.clobber(loc)
+			}
+		}
+	}
+
+	dest := inst.Destination()
+	if op.Destination != nil {
+		if dest == nil {
+			replayer.emit("unexpected destination (%s)", op.Destination)
+			return
+		}
+
+		if replayer.checkAllocated(op.Destination) && constraints != nil {
+			replayer.checkConstraint(
+				"destination",
+				op.Destination,
+				constraints.Destination,
+				selected)
+		}
+
+		replayer.write(op.Destination, replayer.define(op.Destination.Name))
+	} else if dest != nil && !ast.IsTerminal(inst) {
+		_, isLiveOut := replayer.LiveOut[dest]
+		if len(dest.DefUses) == 0 && !isLiveOut {
+			// The allocator does not allocate dead destinations.
+			return
+		}
+
+		var constraint *arch.LocationConstraint
+		if constraints != nil {
+			constraint = constraints.Destination
+			if constraint.RequireOnStack {
+				replayer.emit("missing stack destination (%s)", dest.Name)
+				return
+			}
+		}
+
+		replayer.pending = &pendingDestination{
+			name:       dest.Name,
+			constraint: constraint,
+			selected:   selected,
+		}
+	}
+}
+
+func (replayer *operationsReplayer) checkSource(
+	idx int,
+	value ast.Value,
+	loc *arch.DataLocation,
+	constraint *arch.LocationConstraint,
+	selected map[*arch.RegisterConstraint]*arch.Register,
+) {
+	context := fmt.Sprintf("source %d", idx)
+
+	ref, isRef := value.(*ast.VariableReference)
+	if loc.EncodedImmediate != nil {
+		if isRef {
+			replayer.emit("%s: definition (%s) is encoded", context, ref.Name)
+		} else if constraint != nil && !constraint.SupportEncodedImmediate {
+			replayer.emit(
+				"%s: encoded immediate (%s) is not supported",
+				context,
+				loc.EncodedImmediate)
+		}
+		return
+	}
+
+	if isRef && ref.UseDef != nil && ref.UseDef.Name != loc.Name {
+		replayer.emit(
+			"%s: expected definition (%s), found (%s)",
+			context,
+			ref.UseDef.Name,
+			loc.Name)
+		return
+	}
+
+	if !replayer.checkHolds(context, loc, loc.Name) {
+		return
+	}
+
+	replayer.checkConstraint(context, loc, constraint, selected)
+}
+
+func (replayer *operationsReplayer) checkCalleeSavedRegisters() {
+	for _, param := range replayer.FuncDef.CalleeSavedParameters {
+		loc, ok := replayer.entryLocationIn[param]
+		if !ok || loc.IsOnStack() {
+			continue
+		}
+
+		replayer.checkHolds("callee-saved register", loc, param.Name)
+	}
+}
+
+func (replayer *operationsReplayer) moveRegister(
+	src *arch.Register,
+	dest *arch.Register,
+) {
+	owner, ok := replayer.registerOwners[src]
+	if !ok {
+		replayer.emit("move from unallocated register %s", src.Name)
+	}
+
+	destOwner, ok := replayer.registerOwners[dest]
+	if ok {
+		replayer.emit("move to register %s used by %s", dest.Name, destOwner)
+	}
+
+	delete(replayer.registerOwners, src)
+	if owner != "" {
+		replayer.registerOwners[dest] = owner
+	}
+
+	content, ok := replayer.registerContents[src]
+	if ok {
+		replayer.registerContents[dest] = content
+	} else {
+		delete(replayer.registerContents, dest)
+	}
+}
+
+func (replayer *operationsReplayer) copyLocation(
+	src *arch.DataLocation,
+	dest *arch.DataLocation,
+	scratch *arch.Register,
+) {
+	replayer.checkHolds("copy source", src, src.Name)
+	replayer.checkAllocated(dest)
+
+	value := replayer.read(src)
+	replayer.clobberScratch(scratch)
+
+	if src.Name != dest.Name {
+		value = replayer.define(dest.Name)
+	}
+	replayer.write(dest, value)
+}
+
+func (replayer *operationsReplayer) allocate(loc *arch.DataLocation) {
+	if loc.OnFixedStack {
+		if replayer.fixedStackOwners[loc.Name] {
+			replayer.emit("fixed stack location (%s) is already allocated", loc.Name)
+		}
+		replayer.fixedStackOwners[loc.Name] = true
+	} else if loc.OnTempStack {
+		owner, ok := replayer.tempStackOwners[loc.Offset]
+		if ok {
+			replayer.emit(
+				"temp stack location (offset %d) is already allocated to %s",
+				loc.Offset,
+				owner)
+		}
+		replayer.tempStackOwners[loc.Offset] = loc.Name
+	} else {
+		for _, reg := range loc.Registers {
+			owner, ok := replayer.registerOwners[reg]
+			if ok {
+				replayer.emit("register %s is already allocated to %s", reg.Name, owner)
+			}
+			replayer.registerOwners[reg] = loc.Name
+		}
+	}
+}
+
+func (replayer *operationsReplayer) allocateOperation(loc *arch.DataLocation) {
+	renamed := replayer.renamedValue(loc)
+
+	replayer.allocate(loc)
+
+	if loc.OnTempStack {
+		// Temp stack locations are always initialized after allocation.
+		delete(replayer.tempStackContents, loc.Offset)
+	} else if replayer.pending != nil && replayer.pending.name == loc.Name {
+		replayer.checkConstraint(
+			"destination",
+			loc,
+			replayer.pending.constraint,
+			replayer.pending.selected)
+		replayer.write(loc, replayer.define(loc.Name))
+		replayer.pending = nil
+	} else if renamed != nil {
+		value := replayer.current[loc.Name]
+		if value == nil || value.renamedFrom != renamed {
+			value = replayer.define(loc.Name)
+			value.renamedFrom = renamed
+		}
+		replayer.write(loc, value)
+	}
+}
+
+// The allocator transfers a dead definition's register location to a copy
+// destination definition by freeing the location and then immediately
+// allocating the same registers to the destination.  This returns the
+// transferred value, or nil if the allocation is not a transfer.
+func (replayer *operationsReplayer) renamedValue(
+	loc *arch.DataLocation,
+) *symbolicValue {
+	prev := replayer.previous
+	if prev == nil ||
+		prev.Kind != arch.FreeLocation ||
+		prev.Destination.Name == loc.Name ||
+		len(loc.Registers) == 0 ||
+		len(prev.Destination.Registers) != len(loc.Registers) {
+
+		return nil
+	}
+
+	for idx, reg := range loc.Registers {
+		if prev.Destination.Registers[idx] != reg {
+			return nil
+		}
+	}
+
+	value := replayer.read(loc)
+	if value == nil || value != replayer.current[prev.Destination.Name] {
+		return nil
+	}
+
+	// A destination which is still allocated elsewhere must have been
+	// transferred from the same value.
+	current := replayer.current[loc.Name]
+	if current != nil && current.renamedFrom != value {
+		for _, owner := range replayer.registerOwners {
+			if owner == loc.Name {
+				return nil
+			}
+		}
+
+		if replayer.fixedStackOwners[loc.Name] {
+			return nil
+		}
+	}
+
+	return value
+}
+
+func (replayer *operationsReplayer) free(loc *arch.DataLocation) {
+	if replayer.checkAllocated(loc) {
+		if loc.OnFixedStack {
+			delete(replayer.fixedStackOwners, loc.Name)
+		} else if loc.OnTempStack {
+			delete(replayer.tempStackOwners, loc.Offset)
+		} else {
+			for _, reg := range loc.Registers {
+				delete(replayer.registerOwners, reg)
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// NOTE: this is an external test package since the analyzer (which sets up
+// the allocator's input) imports the allocator.
+package allocator_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/analyzer/allocator"
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+const verifierSource = `
+define func @f(%a I64, %b I64) I64 {
+  %c = add %a, %b
+  jlt :small, %c, 10
+  %c = sub %c, %b
+:small
+  ret %c
+}
+`
+
+func allocate(t *testing.T) *allocator.Allocator {
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(verifierSource)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	analyzer.AnalyzeSemantics(entries, targetPlatform, emitter, false)
+	expect.False(t, emitter.HasErrors())
+
+	allocators := analyzer.AllocateRegisters(entries, targetPlatform)
+	expect.Equal(t, 1, len(allocators))
+	return allocators[0]
+}
+
+// Returns the block state and operation index of the add instruction.
+func findAdd(
+	t *testing.T,
+	registerStackAllocator *allocator.Allocator,
+) (
+	*allocator.BlockState,
+	int,
+) {
+	for _, block := range registerStackAllocator.FuncDef.Blocks {
+		state := registerStackAllocator.BlockStates[block]
+		for idx, op := range state.Operations {
+			if op.Kind != arch.ExecuteInstruction {
+				continue
+			}
+
+			binary, ok := op.Instruction.(*ast.BinaryOperation)
+			if ok && binary.Kind == ast.Add {
+				return state, idx
+			}
+		}
+	}
+
+	t.FailNow()
+	return nil, 0
+}
+
+func expectDiagnostic(
+	t *testing.T,
+	diagnostics []*allocator.AllocationDiagnostic,
+	operationIndex int,
+	snippet string,
+) {
+	for _, diag := range diagnostics {
+		if diag.OperationIndex == operationIndex &&
+			strings.Contains(diag.Message, snippet) {
+
+			return
+		}
+	}
+
+	t.Errorf(
+		"missing diagnostic (%d: %s) in %v",
+		operationIndex,
+		snippet,
+		diagnostics)
+}
+
+func TestVerifyValidAllocation(t *testing.T) {
+	expect.Equal(t, 0, len(allocator.Verify(allocate(t))))
+}
+
+func TestVerifyClobberedLiveRegister(t *testing.T) {
+	registerStackAllocator := allocate(t)
+	state, addIdx := findAdd(t, registerStackAllocator)
+
+	src := state.Operations[addIdx].Sources[1]
+	expect.Equal(t, "b", src.Name)
+	expect.Equal(t, 1, len(src.Registers))
+
+	// Overwrites %b's register right before the add reads it.
+	clobber := src.Copy()
+	clobber.Name = "%clobber"
+	state.Operations = slices.Insert(
+		state.Operations,
+		addIdx,
+		arch.Operation{
+			Kind:        arch.SetConstantValue,
+			Destination: clobber,
+		})
+
+	expectDiagnostic(
+		t,
+		allocator.Verify(registerStackAllocator),
+		addIdx+1,
+		"does not hold definition (b)'s latest value")
+}
+
+func TestVerifyMissingCopy(t *testing.T) {
+	registerStackAllocator := allocate(t)
+	state, addIdx := findAdd(t, registerStackAllocator)
+
+	src := state.Operations[addIdx].Sources[1]
+	expect.Equal(t, "b", src.Name)
+	expect.Equal(t, 1, len(src.Registers))
+
+	// Drops the copy which loads %b into the add's source register.
+	copyIdx := -1
+	for idx, op := range state.Operations[:addIdx] {
+		if op.Kind == arch.CopyLocation &&
+			op.Destination.Name == "b" &&
+			slices.Equal(op.Destination.Registers, src.Registers) {
+
+			copyIdx = idx
+		}
+	}
+	expect.True(t, copyIdx >= 0)
+
+	state.Operations = slices.Delete(state.Operations, copyIdx, copyIdx+1)
+
+	expectDiagnostic(
+		t,
+		allocator.Verify(registerStackAllocator),
+		addIdx-1,
+		"does not hold definition (b)'s latest value")
+}
+
+func TestVerifyMismatchedChildLocationIn(t *testing.T) {
+	registerStackAllocator := allocate(t)
+	state, _ := findAdd(t, registerStackAllocator)
+
+	// The child expects %c in a register which the parent never populated
+	// (i.e., a missing copy at the block boundary).
+	expect.Equal(t, 2, len(state.Children))
+	child := registerStackAllocator.BlockStates[state.Children[0]]
+
+	var moved *arch.DataLocation
+	for def, loc := range child.LocationIn {
+		if def.Name != "c" {
+			continue
+		}
+
+		expect.Equal(t, 1, len(loc.Registers))
+		for _, reg := range x64.RegisterSet.General {
+			if reg != loc.Registers[0] && reg.AllowGeneralOp {
+				moved = loc.Copy()
+				moved.Registers = []*arch.Register{reg}
+				break
+			}
+		}
+		child.LocationIn[def] = moved
+	}
+	expect.NotNil(t, moved)
+
+	expectDiagnostic(
+		t,
+		allocator.Verify(registerStackAllocator),
+		len(state.Operations),
+		"register "+moved.Registers[0].Name+" is not allocated to c")
+}
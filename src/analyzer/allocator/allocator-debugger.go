@@ -12,6 +12,10 @@ import (
 type AllocatorDebugger struct {
 	*Allocator
 
+	// Statistics collected by earlier passes (e.g., optimization passes).  Each
+	// entry is printed as a line in the statistics section.
+	statistics []string
+
 	debugLiveness      bool
 	debugLiveRanges    bool
 	debugPreferences   bool
@@ -20,9 +24,13 @@ type AllocatorDebugger struct {
 	debugOperations    bool
 }
 
-func Debug(allocator *Allocator) util.Pass[ast.SourceEntry] {
+func Debug(
+	allocator *Allocator,
+	statistics ...string,
+) util.Pass[ast.SourceEntry] {
 	return &AllocatorDebugger{
-		Allocator: allocator,
+		Allocator:  allocator,
+		statistics: statistics,
 		//debugLiveness: true,
 		//debugLiveRanges: true,
 		//debugPreferences: true,
@@ -194,6 +202,14 @@ func (debugger *AllocatorDebugger) Process(entry ast.SourceEntry) {
 
 	printf("Definition: %s\n", funcDef.Label)
 
+	if len(debugger.statistics) > 0 {
+		printf("------------------------------------------\n")
+		printf("Statistics:\n")
+		for _, line := range debugger.statistics {
+			printf("  %s\n", line)
+		}
+	}
+
 	if debugger.debugLiveness {
 		printf("------------------------------------------\n")
 		debugger.printLiveness(funcDef, printf)
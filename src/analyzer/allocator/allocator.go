@@ -84,6 +84,9 @@ func (allocator *Allocator) stripExplicitUnconditionalJumps() {
 		case *ast.ConditionalJump:
 			// Both branches ends up at the next block
 			strip = len(block.Children) == 1
+		case *ast.Switch:
+			// All cases jump to the default block
+			strip = len(block.Children) == 1
 		}
 
 		if strip {
@@ -98,6 +101,22 @@ func (allocator *Allocator) reorderBlocksAndUpdateJumps() {
 
 	numBlocks := len(allocator.FuncDef.Blocks)
 	for idx, block := range allocator.FuncDef.Blocks {
+		if len(block.Instructions) > 0 {
+			last := block.Instructions[len(block.Instructions)-1]
+			inst, ok := last.(*ast.Switch)
+			if ok {
+				// Switch explicitly jumps to all of its N children (the children are
+				// in the same order as the switch's targets).  Transfer blocks may
+				// have been inserted between the switch and its original targets.
+				labels := make([]string, 0, len(block.Children))
+				for _, child := range block.Children {
+					labels = append(labels, child.Label)
+				}
+				inst.ReplaceTargets(labels)
+				continue
+			}
+		}
+
 		switch len(block.Children) {
 		case 0: // terminal block
 			// sanity check
@@ -107,7 +126,7 @@ func (allocator *Allocator) reorderBlocksAndUpdateJumps() {
 
 			last := block.Instructions[len(block.Instructions)-1]
 			switch inst := last.(type) {
-			case *ast.Terminal: // ok
+			case *ast.Terminal, *ast.TailCall: // ok
 			case *ast.FuncCall:
 				if !inst.IsExitTerminal {
 					panic("should never happen")
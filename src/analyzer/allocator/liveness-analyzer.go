@@ -27,7 +27,8 @@ import (
 // first real instruction counts as one.
 //
 // TODO: The distance heuristic does not take into account of loops /
-// branch probability. Improve after we have a working compiler.
+// branch probability. Improve after we have a working compiler (see
+// util.LoopForest and util.EstimateBlockFrequencies).
 // If pgo statistics is available, maybe use markov chain weighted distance.
 type LiveInfo struct {
 	// There could be multiple next use instructions if they are all in children
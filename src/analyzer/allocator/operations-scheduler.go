@@ -41,7 +41,8 @@ type allocation struct {
 	numRequired  int
 	numClobbered int
 
-	requireOnTempStack bool
+	requireOnTempStack  bool
+	requireOnFixedStack bool
 
 	numActual         int
 	hasFixedStackCopy bool
@@ -271,6 +272,12 @@ func (scheduler *operationsScheduler) ScheduleInstructionOperations(
 	scheduler.tearDownInstruction()
 }
 
+// Arrays are always held in fixed stack slots, and are never loaded onto
+// registers.
+func isStackOnly(def *ast.VariableDefinition) bool {
+	return ast.IsArrayType(def.Type)
+}
+
 func (scheduler *operationsScheduler) nextUseDelta(
 	def *ast.VariableDefinition,
 ) int {
@@ -303,6 +310,21 @@ func (scheduler *operationsScheduler) initializeInstructionConstraints(
 		instruction,
 		nonSrcExacts)
 
+	// Wildcard sources (e.g., immediates) must not be placed in registers
+	// required by exact match sources, or in registers that must be evicted.
+	// Otherwise, the exact match constraints may become unsatisfiable.
+	for _, src := range srcs {
+		for _, reg := range src.constraint.Registers {
+			if reg.Require != nil {
+				scheduler.ExactMatch(reg.Require)
+			}
+		}
+	}
+
+	for reg := range nonSrcExacts {
+		scheduler.ExactMatch(reg)
+	}
+
 	if constraints.Destination == nil {
 		// do nothing
 	} else {
@@ -342,7 +364,11 @@ func (scheduler *operationsScheduler) initializeInstructionConstraints(
 			if loc.constraint.AnyLocation { // only copy op uses AnyLocation for src
 				loc.numPreferred++
 			} else if loc.constraint.RequireOnStack {
-				loc.requireOnTempStack = true
+				if loc.constraint.ReadInPlace && alloc.immediate == nil {
+					loc.requireOnFixedStack = true
+				} else {
+					loc.requireOnTempStack = true
+				}
 			} else {
 				loc.numPreferred++
 				loc.numRequired++
@@ -364,7 +390,7 @@ func (scheduler *operationsScheduler) initializeInstructionConstraints(
 			if !alloc.hasFixedStackCopy ||
 				alloc.numActual > 0 ||
 				alloc.numRequired > 0 ||
-				alloc.requireOnTempStack {
+				(alloc.requireOnTempStack && !isStackOnly(alloc.definition)) {
 
 				alloc.numPreferred++
 			}
@@ -815,6 +841,12 @@ func (scheduler *operationsScheduler) transferLocations(
 	locs := append(
 		[]*arch.DataLocation{},
 		scheduler.ValueLocations.Values[srcDef]...)
+
+	// NOTE: src and dest may share the same name (and hence the same fixed
+	// stack location).  Since value names must be unique among allocated
+	// definitions, all transferred src locations are freed prior to allocating
+	// any dest location.
+	transferred := []*arch.DataLocation{}
 	for _, loc := range locs {
 		if loc.OnTempStack {
 			panic("should never happen")
@@ -825,20 +857,30 @@ func (scheduler *operationsScheduler) transferLocations(
 				continue
 			}
 			scheduler.FreeLocation(loc)
-			scheduler.AllocateFixedStackLocation(destDef)
+			transferred = append(transferred, loc)
 		} else {
 			scheduler.FreeLocation(loc)
-			scheduler.AllocateRegistersLocation(destDef, loc.Registers...)
+			transferred = append(transferred, loc)
 
 			if singleRegisterCopy {
-				return
+				break
 			}
 		}
 	}
 
-	if singleRegisterCopy {
+	if singleRegisterCopy &&
+		(len(transferred) == 0 || transferred[len(transferred)-1].OnFixedStack) {
+
 		panic("should never reach here")
 	}
+
+	for _, loc := range transferred {
+		if loc.OnFixedStack {
+			scheduler.AllocateFixedStackLocation(destDef)
+		} else {
+			scheduler.AllocateRegistersLocation(destDef, loc.Registers...)
+		}
+	}
 }
 
 func (scheduler *operationsScheduler) setUpTempStack(
@@ -848,7 +890,7 @@ func (scheduler *operationsScheduler) setUpTempStack(
 	var srcDefs []*ast.VariableDefinition
 	copySrcs := map[*ast.VariableDefinition]*arch.DataLocation{}
 	for _, src := range scheduler.srcs {
-		if !src.constraint.RequireOnStack {
+		if !src.constraint.RequireOnStack || src.requireOnFixedStack {
 			continue
 		}
 
@@ -886,6 +928,33 @@ func (scheduler *operationsScheduler) setUpTempStack(
 		src.hasAllocated = true
 	}
 
+	// In place sources are read directly from the fixed stack.  Spill the
+	// definition if it's not already on the fixed stack.
+	for _, src := range scheduler.srcs {
+		if !src.requireOnFixedStack || src.hasAllocated {
+			continue
+		}
+
+		if !src.hasFixedStackCopy {
+			copySrc := scheduler.selectCopySourceLocation(src.definition)
+			dest := scheduler.AllocateFixedStackLocation(src.definition)
+			scheduler.CopyLocation(copySrc, dest, scratchRegister)
+			src.hasFixedStackCopy = true
+		}
+
+		for _, loc := range scheduler.ValueLocations.Values[src.definition] {
+			if loc.OnFixedStack {
+				src.location = loc
+				break
+			}
+		}
+
+		if src.location == nil {
+			panic("should never happen")
+		}
+		src.hasAllocated = true
+	}
+
 	if tempDestLoc != nil {
 		scheduler.tempDest.location = tempDestLoc
 		scheduler.InitializeZeros(tempDestLoc, scratchRegister)
@@ -1105,7 +1174,7 @@ func (scheduler *operationsScheduler) assignAllocatedLocation(
 	misplacedChunks := make([]int, 0, len(loc.Registers))
 	for regIdx, reg := range loc.Registers {
 		constraint := constrained.constraint.Registers[regIdx]
-		if constraint.SatisfyBy(reg) {
+		if scheduler.IsSelectable(constraint, reg) {
 			scheduler.ReserveSource(reg, constraint)
 		} else {
 			misplacedChunks = append(misplacedChunks, regIdx)
@@ -1196,7 +1265,7 @@ func (scheduler *operationsScheduler) initCompatible(
 			compatibility := 0
 			for regIdx, reg := range candidate.Registers {
 				constraint := constrained.constraint.Registers[regIdx]
-				if constraint.SatisfyBy(reg) {
+				if scheduler.IsSelectable(constraint, reg) {
 					compatibility++
 				}
 			}
@@ -1275,7 +1344,7 @@ func (scheduler *operationsScheduler) reduceMisplacedWithoutEvication(
 			// NOTE: We need to recheck the existing register since register
 			// eviction may have replaced a previous register with a register that
 			// satisfy the constraint.
-			if loc.hasAllocated && constraint.SatisfyBy(existingReg) {
+			if loc.hasAllocated && scheduler.IsSelectable(constraint, existingReg) {
 				scheduler.ReserveSource(existingReg, constraint)
 				continue
 			}
@@ -1382,6 +1451,12 @@ func (scheduler *operationsScheduler) freeDeadSources() {
 }
 
 func (scheduler *operationsScheduler) allocateAnyDestination() *arch.Register {
+	if isStackOnly(scheduler.finalDest.definition) {
+		scheduler.finalDest.location = scheduler.AllocateFixedStackLocation(
+			scheduler.finalDest.definition)
+		return scheduler.SelectScratch()
+	}
+
 	numFreeRegisters := 0
 	for _, regInfo := range scheduler.ValueLocations.Registers {
 		if regInfo.UsedBy == nil {
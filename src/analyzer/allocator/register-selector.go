@@ -39,6 +39,21 @@ func (selector *RegisterSelector) ExactMatch(reg *arch.Register) {
 	selector.exactMatch[reg] = struct{}{}
 }
 
+// Returns true if the register satisfies the constraint.  Exact match
+// registers are not selectable by AnyGeneral/AnyFloat constraints.
+func (selector *RegisterSelector) IsSelectable(
+	constraint *arch.RegisterConstraint,
+	reg *arch.Register,
+) bool {
+	if constraint.AnyGeneral || constraint.AnyFloat {
+		_, ok := selector.exactMatch[reg]
+		if ok {
+			return false
+		}
+	}
+	return constraint.SatisfyBy(reg)
+}
+
 func (selector *RegisterSelector) isSelected(register *arch.Register) bool {
 	_, ok := selector.selectedSrc[register]
 	if ok {
@@ -119,13 +134,7 @@ func (selector *RegisterSelector) selectRegister(
 	}
 
 	isCandidate := func(reg *arch.Register) bool {
-		if constraint.AnyGeneral || constraint.AnyFloat {
-			_, ok := selector.exactMatch[reg]
-			if ok {
-				return false
-			}
-		}
-		return constraint.SatisfyBy(reg)
+		return selector.IsSelectable(constraint, reg)
 	}
 
 	var free *arch.Register
@@ -163,10 +163,13 @@ func (locations *ValueLocations) AllocateRegistersLocation(
 	def *ast.VariableDefinition,
 	registers ...*architecture.Register,
 ) *architecture.DataLocation {
+	// NOTE: MoveRegister modifies the location's registers in place.  Copy the
+	// registers to avoid modifying the caller's slice (e.g., LocationIn's
+	// registers).
 	dest := architecture.NewRegistersDataLocation(
 		def.Name,
 		def.Type,
-		registers)
+		append([]*architecture.Register{}, registers...))
 	locations.allocateRegisters(dest, def)
 	return dest
 }
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pattyshack/gt/parseutil"
 
@@ -9,13 +10,39 @@ import (
 	"github.com/pattyshack/chickadee/analyzer/util"
 	"github.com/pattyshack/chickadee/ast"
 	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
 )
 
+// Analyzes the sources and lowers all function definitions into machine code,
+// and all data definitions into data segments.  This returns nil if the
+// sources have errors.
 func Analyze(
 	sources []ast.SourceEntry,
 	targetPlatform platform.Platform,
 	emitter *parseutil.Emitter,
+) []executable.LabelledSegment {
+	return analyze(sources, targetPlatform, emitter, true, true)
+}
+
+// Analyzes the sources up to (and including) type checking.  When optimize is
+// true, the optimization passes are also applied.  The function definitions
+// are in ssa form, but are not register / stack allocated.
+func AnalyzeSemantics(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+	emitter *parseutil.Emitter,
+	optimize bool,
 ) {
+	analyze(sources, targetPlatform, emitter, optimize, false)
+}
+
+func analyze(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+	emitter *parseutil.Emitter,
+	optimize bool,
+	generateCode bool,
+) []executable.LabelledSegment {
 	abortBuildCtx, abortBuild := context.WithCancel(context.Background())
 	shouldAbortBuild := func() bool {
 		select {
@@ -27,8 +54,10 @@ func Analyze(
 	}
 
 	entryEmitters := map[ast.SourceEntry]*parseutil.Emitter{}
+	entrySegments := map[ast.SourceEntry]*[]executable.LabelledSegment{}
 	for _, entry := range sources {
 		entryEmitters[entry] = &parseutil.Emitter{}
+		entrySegments[entry] = &[]executable.LabelledSegment{}
 	}
 
 	util.ParallelProcess(
@@ -66,6 +95,7 @@ func Analyze(
 			}
 
 			setupPasses := [][]util.Pass[ast.SourceEntry]{
+				{LowerTuples(entryEmitter)},
 				{InitializeControlFlowGraph(entryEmitter)},
 				{ModifyTerminals(targetPlatform)},
 			}
@@ -91,8 +121,20 @@ func Analyze(
 				return
 			}
 
+			deadCodeEliminator := EliminateDeadCode()
+			if optimize {
+				optimizationPasses := [][]util.Pass[ast.SourceEntry]{
+					{PropagateConstants()},
+					{NumberGlobalValues()},
+					{deadCodeEliminator},
+					{OptimizeTailCalls(targetPlatform)},
+				}
+
+				util.Process(entry, optimizationPasses, nil)
+			}
+
 			// At this point, the entry is well-form and no more error could occur.
-			if shouldAbortBuild() {
+			if !generateCode || shouldAbortBuild() {
 				return
 			}
 
@@ -100,17 +142,29 @@ func Analyze(
 			registerStackAllocator := allocator.NewAllocator(
 				targetPlatform,
 				debugMode)
+
 			backendPasses := [][]util.Pass[ast.SourceEntry]{
 				{registerStackAllocator},
+				{allocator.VerifyAllocation(registerStackAllocator, entryEmitter)},
+				{GenerateCode(registerStackAllocator, entrySegments[entry])},
 			}
 			if debugMode {
+				statistics := []string{}
+				if optimize {
+					statistics = append(
+						statistics,
+						fmt.Sprintf(
+							"# of dead instructions removed: %d",
+							deadCodeEliminator.NumRemoved))
+				}
+
 				backendPasses = append(
 					backendPasses,
 					[]util.Pass[ast.SourceEntry]{
 						// these passes are only used for debugging the compiler
 						// implementation and should be removed or flag guarded once the
 						// compiler works.
-						allocator.Debug(registerStackAllocator),
+						allocator.Debug(registerStackAllocator, statistics...),
 					})
 			}
 
@@ -120,4 +174,54 @@ func Analyze(
 	for _, entryEmitter := range entryEmitters {
 		emitter.EmitErrors(entryEmitter.Errors()...)
 	}
+
+	if !generateCode || emitter.HasErrors() {
+		return nil
+	}
+
+	segments := make([]executable.LabelledSegment, 0, len(sources))
+	for _, entry := range sources {
+		// NOTE: entries without code have no segments.
+		segments = append(segments, *entrySegments[entry]...)
+	}
+
+	return segments
+}
+
+// Register / stack allocates the function definitions.  The sources must have
+// been analyzed by AnalyzeSemantics without errors.  This returns the
+// function definitions' allocators in source order.
+//
+// NOTE: The allocator modifies the function definitions' control flow graphs
+// (blocks are reordered, and transfer blocks are inserted).
+func AllocateRegisters(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+) []*allocator.Allocator {
+	allocators := map[ast.SourceEntry]*allocator.Allocator{}
+	for _, entry := range sources {
+		_, ok := entry.(*ast.FunctionDefinition)
+		if ok {
+			allocators[entry] = allocator.NewAllocator(targetPlatform, false)
+		}
+	}
+
+	util.ParallelProcess(
+		sources,
+		func(entry ast.SourceEntry) {
+			registerStackAllocator, ok := allocators[entry]
+			if ok {
+				registerStackAllocator.Process(entry)
+			}
+		})
+
+	result := make([]*allocator.Allocator, 0, len(allocators))
+	for _, entry := range sources {
+		registerStackAllocator, ok := allocators[entry]
+		if ok {
+			result = append(result, registerStackAllocator)
+		}
+	}
+
+	return result
 }
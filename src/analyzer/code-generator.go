@@ -0,0 +1,125 @@
+package analyzer
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pattyshack/chickadee/analyzer/allocator"
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+type codeGenerator struct {
+	*allocator.Allocator
+
+	segments *[]executable.LabelledSegment
+}
+
+// Lowers the allocated function into machine code (or encodes the data
+// definition's values).  The results are written to segments.
+func GenerateCode(
+	registerStackAllocator *allocator.Allocator,
+	segments *[]executable.LabelledSegment,
+) util.Pass[ast.SourceEntry] {
+	return &codeGenerator{
+		Allocator: registerStackAllocator,
+		segments:  segments,
+	}
+}
+
+func (generator *codeGenerator) Process(entry ast.SourceEntry) {
+	dataDef, ok := entry.(*ast.DataDefinition)
+	if ok {
+		*generator.segments = []executable.LabelledSegment{
+			generateData(dataDef, generator.Platform.ByteOrder()),
+		}
+		return
+	}
+
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	operations := make(
+		map[*ast.Block][]architecture.Operation,
+		len(generator.BlockStates))
+	for block, state := range generator.BlockStates {
+		operations[block] = state.Operations
+	}
+
+	*generator.segments = generator.Platform.GenerateCode(
+		funcDef,
+		generator.StackFrame,
+		operations)
+}
+
+func generateData(
+	def *ast.DataDefinition,
+	byteOrder binary.ByteOrder,
+) executable.LabelledSegment {
+	elementSize := architecture.ByteSize(def.ElementType)
+
+	bytes := make([]byte, 0, elementSize*def.NumElements())
+	for _, value := range def.Values {
+		switch data := value.(type) {
+		case *ast.ImmediateData:
+			var bits uint64
+			switch imm := data.Value.(type) {
+			case *ast.IntImmediate:
+				bits = imm.Value
+				if imm.IsNegative {
+					bits = -imm.Value
+				}
+			case *ast.FloatImmediate:
+				bits = math.Float64bits(imm.Value)
+				if elementSize == 4 {
+					bits = uint64(math.Float32bits(float32(imm.Value)))
+				}
+			case *ast.BoolImmediate:
+				if imm.Value {
+					bits = 1
+				}
+			default:
+				panic("should never happen")
+			}
+
+			element := make([]byte, 8)
+			if byteOrder == binary.BigEndian {
+				byteOrder.PutUint64(element, bits<<(64-8*elementSize))
+			} else {
+				byteOrder.PutUint64(element, bits)
+			}
+			element = element[:elementSize]
+
+			for i := 0; i < data.Count; i++ {
+				bytes = append(bytes, element...)
+			}
+		case *ast.StringData:
+			bytes = append(bytes, data.Value...)
+		default:
+			panic("should never happen")
+		}
+	}
+
+	section := executable.ReadOnlyDataSection
+	if def.IsMutable {
+		section = executable.ZeroDataSection
+		for _, b := range bytes {
+			if b != 0 {
+				section = executable.DataSection
+				break
+			}
+		}
+	}
+
+	return executable.LabelledSegment{
+		Label:   def.Label,
+		Section: section,
+		Segment: executable.Segment{
+			Bytes: bytes,
+		},
+	}
+}
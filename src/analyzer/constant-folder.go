@@ -0,0 +1,505 @@
+package analyzer
+
+import (
+	"math"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Constant folding operates on int / float / bool immediates.  Int values are
+// represented by their 64-bit canonical form, i.e., the value is truncated to
+// the type's size, then sign / zero extended to 64 bits based on the type.
+// All folded results match the interpreter's semantics.  Operations that
+// fail at runtime (e.g., integer division by zero, or out of range float to
+// int conversion) are not folded.
+
+func isFoldableImmediate(value ast.Value) bool {
+	switch value.(type) {
+	case *ast.IntImmediate, *ast.FloatImmediate, *ast.BoolImmediate:
+		return true
+	default:
+		return false
+	}
+}
+
+// Only int / float / bool definitions are tracked as constants.
+func isFoldableType(valueType ast.Type) bool {
+	return ast.IsIntSubType(valueType) ||
+		ast.IsFloatSubType(valueType) ||
+		ast.IsBoolType(valueType)
+}
+
+// Returns the int type's canonical value.
+func normalizeInt(intType ast.Type, bits uint64) uint64 {
+	size := 8 * architecture.ByteSize(intType)
+	if size == 64 {
+		return bits
+	}
+
+	shift := uint64(64 - size)
+	if ast.IsSignedIntSubType(intType) {
+		return uint64(int64(bits<<shift) >> shift)
+	}
+	return bits << shift >> shift
+}
+
+// Returns the immediate's canonical 64-bit int value.
+func intImmediateValue(imm *ast.IntImmediate) uint64 {
+	if imm.IsNegative {
+		return -imm.Value
+	}
+	return imm.Value
+}
+
+func newIntImmediate(
+	pos parseutil.StartEndPos,
+	intType ast.Type,
+	bits uint64,
+) *ast.IntImmediate {
+	bits = normalizeInt(intType, bits)
+	imm := ast.NewIntImmediate(pos, bits, false)
+	if ast.IsSignedIntSubType(intType) && int64(bits) < 0 {
+		imm.Value = -bits
+		imm.IsNegative = true
+	}
+	imm.BindedType = intType
+	return imm
+}
+
+func is32BitFloat(floatType ast.Type) bool {
+	return architecture.ByteSize(floatType) == 4
+}
+
+// F32 values are rounded to float32 precision.
+func newFloatImmediate(
+	pos parseutil.StartEndPos,
+	floatType ast.Type,
+	value float64,
+) *ast.FloatImmediate {
+	if is32BitFloat(floatType) {
+		value = float64(float32(value))
+	}
+
+	return &ast.FloatImmediate{
+		StartEndPos: pos,
+		Value:       value,
+		BindedType:  floatType,
+	}
+}
+
+func newBoolImmediate(
+	pos parseutil.StartEndPos,
+	value bool,
+) *ast.BoolImmediate {
+	return &ast.BoolImmediate{
+		StartEndPos: pos,
+		Value:       value,
+	}
+}
+
+// Returns a copy of the immediate bound to the given type.  Int immediates
+// are sign / zero extended, and float immediates are rounded to the type's
+// precision.
+func convertImmediate(
+	pos parseutil.StartEndPos,
+	imm ast.Value,
+	valueType ast.Type,
+) ast.Value {
+	switch value := imm.(type) {
+	case *ast.IntImmediate:
+		return newIntImmediate(pos, valueType, intImmediateValue(value))
+	case *ast.FloatImmediate:
+		return newFloatImmediate(pos, valueType, value.Value)
+	case *ast.BoolImmediate:
+		return newBoolImmediate(pos, value.Value)
+	default:
+		panic("should never happen")
+	}
+}
+
+// Returns true if both immediates have the same canonical value.
+func isSameImmediate(imm1 ast.Value, imm2 ast.Value) bool {
+	switch value1 := imm1.(type) {
+	case *ast.IntImmediate:
+		value2, ok := imm2.(*ast.IntImmediate)
+		return ok && intImmediateValue(value1) == intImmediateValue(value2)
+	case *ast.FloatImmediate:
+		value2, ok := imm2.(*ast.FloatImmediate)
+		return ok &&
+			math.Float64bits(value1.Value) == math.Float64bits(value2.Value)
+	case *ast.BoolImmediate:
+		value2, ok := imm2.(*ast.BoolImmediate)
+		return ok && value1.Value == value2.Value
+	default:
+		panic("should never happen")
+	}
+}
+
+// Returns nil if the operation cannot be folded.
+func foldUnaryOperation(inst *ast.UnaryOperation, src ast.Value) ast.Value {
+	pos := inst.StartEnd()
+	destType := inst.Dest.Type
+
+	switch value := src.(type) {
+	case *ast.BoolImmediate:
+		switch inst.Kind {
+		case ast.Not:
+			return newBoolImmediate(pos, !value.Value)
+		case ast.ToI8, ast.ToI16, ast.ToI32, ast.ToI64,
+			ast.ToU8, ast.ToU16, ast.ToU32, ast.ToU64:
+
+			bits := uint64(0)
+			if value.Value {
+				bits = 1
+			}
+			return newIntImmediate(pos, destType, bits)
+		}
+	case *ast.IntImmediate:
+		bits := intImmediateValue(value)
+		switch inst.Kind {
+		case ast.Neg:
+			return newIntImmediate(pos, destType, -bits)
+		case ast.Not:
+			return newIntImmediate(pos, destType, ^bits)
+		case ast.ToI8, ast.ToI16, ast.ToI32, ast.ToI64,
+			ast.ToU8, ast.ToU16, ast.ToU32, ast.ToU64:
+
+			return newIntImmediate(pos, destType, bits)
+		case ast.ToF32:
+			// NOTE: int to F32 conversion must round directly from the int value
+			// (rounding through float64 could round twice).
+			if ast.IsSignedIntSubType(src.Type()) {
+				return newFloatImmediate(pos, destType, float64(float32(int64(bits))))
+			}
+			return newFloatImmediate(pos, destType, float64(float32(bits)))
+		case ast.ToF64:
+			if ast.IsSignedIntSubType(src.Type()) {
+				return newFloatImmediate(pos, destType, float64(int64(bits)))
+			}
+			return newFloatImmediate(pos, destType, float64(bits))
+		}
+	case *ast.FloatImmediate:
+		switch inst.Kind {
+		case ast.Neg:
+			return newFloatImmediate(pos, destType, -value.Value)
+		case ast.ToI8, ast.ToI16, ast.ToI32, ast.ToI64,
+			ast.ToU8, ast.ToU16, ast.ToU32, ast.ToU64:
+
+			return truncateFloatImmediate(pos, destType, value.Value)
+		case ast.ToF32, ast.ToF64:
+			return newFloatImmediate(pos, destType, value.Value)
+		}
+	}
+
+	return nil
+}
+
+// Float to int conversion truncates toward zero.  NaN and out of range
+// values are not folded.
+func truncateFloatImmediate(
+	pos parseutil.StartEndPos,
+	intType ast.Type,
+	value float64,
+) ast.Value {
+	size := 8 * architecture.ByteSize(intType)
+	isSigned := ast.IsSignedIntSubType(intType)
+
+	truncated := math.Trunc(value)
+
+	var min float64
+	var max float64 // exclusive
+	if isSigned {
+		min = -math.Ldexp(1, size-1)
+		max = math.Ldexp(1, size-1)
+	} else {
+		min = 0
+		max = math.Ldexp(1, size)
+	}
+
+	if math.IsNaN(value) || truncated < min || truncated >= max {
+		return nil
+	}
+
+	if isSigned {
+		return newIntImmediate(pos, intType, uint64(int64(truncated)))
+	}
+	return newIntImmediate(pos, intType, uint64(truncated))
+}
+
+// Returns nil if the operation cannot be folded.
+func foldBinaryOperation(
+	inst *ast.BinaryOperation,
+	src1 ast.Value,
+	src2 ast.Value,
+) ast.Value {
+	pos := inst.StartEnd()
+	if inst.Kind.IsComparison() {
+		return foldComparison(pos, inst.Kind, src1, src2)
+	}
+
+	opType := inst.Dest.Type
+
+	float1, ok := src1.(*ast.FloatImmediate)
+	if ok {
+		float2, ok := src2.(*ast.FloatImmediate)
+		if !ok {
+			panic("should never happen")
+		}
+
+		return foldFloatBinaryOperation(pos, inst.Kind, opType, float1, float2)
+	}
+
+	bool1, ok := src1.(*ast.BoolImmediate)
+	if ok {
+		bool2, ok := src2.(*ast.BoolImmediate)
+		if !ok {
+			panic("should never happen")
+		}
+
+		switch inst.Kind {
+		case ast.Xor:
+			return newBoolImmediate(pos, bool1.Value != bool2.Value)
+		case ast.Or:
+			return newBoolImmediate(pos, bool1.Value || bool2.Value)
+		case ast.And:
+			return newBoolImmediate(pos, bool1.Value && bool2.Value)
+		default:
+			panic("unhandled bool binary operation kind: " + inst.Kind)
+		}
+	}
+
+	int1, ok := src1.(*ast.IntImmediate)
+	if !ok {
+		panic("should never happen")
+	}
+
+	int2, ok := src2.(*ast.IntImmediate)
+	if !ok {
+		panic("should never happen")
+	}
+
+	value1 := intImmediateValue(int1)
+	value2 := intImmediateValue(int2)
+	isSigned := ast.IsSignedIntSubType(opType)
+	size := 8 * architecture.ByteSize(opType)
+
+	var result uint64
+	switch inst.Kind {
+	case ast.Add:
+		result = value1 + value2
+	case ast.Sub:
+		result = value1 - value2
+	case ast.Mul:
+		result = value1 * value2
+	case ast.Div, ast.Rem:
+		if value2 == 0 {
+			return nil // integer division by zero
+		}
+
+		if isSigned {
+			dividend := int64(value1)
+			divisor := int64(value2)
+
+			minInt := -int64(1) << (size - 1)
+			if dividend == minInt && divisor == -1 {
+				return nil // integer division overflow
+			}
+
+			if inst.Kind == ast.Div {
+				result = uint64(dividend / divisor)
+			} else {
+				result = uint64(dividend % divisor)
+			}
+		} else if inst.Kind == ast.Div {
+			result = value1 / value2
+		} else {
+			result = value1 % value2
+		}
+	case ast.Xor:
+		result = value1 ^ value2
+	case ast.Or:
+		result = value1 | value2
+	case ast.And:
+		result = value1 & value2
+	case ast.Shl, ast.Shr:
+		// The shift count is masked to the operand size (see interpreter).
+		mask := uint64(31)
+		if size == 64 {
+			mask = 63
+		}
+		count := value2 & mask
+
+		if inst.Kind == ast.Shl {
+			result = value1 << count
+		} else if isSigned {
+			result = uint64(int64(value1) >> count)
+		} else {
+			result = value1 >> count
+		}
+	default:
+		panic("unhandled binary operation kind: " + inst.Kind)
+	}
+
+	return newIntImmediate(pos, opType, result)
+}
+
+func foldFloatBinaryOperation(
+	pos parseutil.StartEndPos,
+	kind ast.BinaryOperationKind,
+	opType ast.Type,
+	src1 *ast.FloatImmediate,
+	src2 *ast.FloatImmediate,
+) ast.Value {
+	if is32BitFloat(opType) {
+		a := float32(src1.Value)
+		b := float32(src2.Value)
+
+		var result float32
+		switch kind {
+		case ast.Add:
+			result = a + b
+		case ast.Sub:
+			result = a - b
+		case ast.Mul:
+			result = a * b
+		case ast.Div:
+			result = a / b
+		default:
+			panic("unhandled float binary operation kind: " + kind)
+		}
+		return newFloatImmediate(pos, opType, float64(result))
+	}
+
+	a := src1.Value
+	b := src2.Value
+
+	var result float64
+	switch kind {
+	case ast.Add:
+		result = a + b
+	case ast.Sub:
+		result = a - b
+	case ast.Mul:
+		result = a * b
+	case ast.Div:
+		result = a / b
+	default:
+		panic("unhandled float binary operation kind: " + kind)
+	}
+	return newFloatImmediate(pos, opType, result)
+}
+
+// Returns whether src1 is equal to, less than, or greater than src2.  Float
+// comparisons follow IEEE 754 semantics (NaN is unordered).  Int immediates
+// are compared using the wider of the two immediates' types.
+func compareImmediates(src1 ast.Value, src2 ast.Value) (bool, bool, bool) {
+	switch value1 := src1.(type) {
+	case *ast.BoolImmediate:
+		value2, ok := src2.(*ast.BoolImmediate)
+		if !ok {
+			panic("should never happen")
+		}
+		return value1.Value == value2.Value, false, false
+	case *ast.FloatImmediate:
+		value2, ok := src2.(*ast.FloatImmediate)
+		if !ok {
+			panic("should never happen")
+		}
+
+		a := value1.Value
+		b := value2.Value
+		return a == b, a < b, a > b
+	case *ast.IntImmediate:
+		value2, ok := src2.(*ast.IntImmediate)
+		if !ok {
+			panic("should never happen")
+		}
+
+		cmpType := value1.Type()
+		if cmpType.IsSubTypeOf(value2.Type()) {
+			cmpType = value2.Type()
+		}
+
+		a := intImmediateValue(value1)
+		b := intImmediateValue(value2)
+		if ast.IsSignedIntSubType(cmpType) {
+			return a == b, int64(a) < int64(b), int64(a) > int64(b)
+		}
+		return a == b, a < b, a > b
+	default:
+		panic("should never happen")
+	}
+}
+
+func foldComparison(
+	pos parseutil.StartEndPos,
+	kind ast.BinaryOperationKind,
+	src1 ast.Value,
+	src2 ast.Value,
+) ast.Value {
+	isEqual, isLessThan, isGreaterThan := compareImmediates(src1, src2)
+
+	var result bool
+	switch kind {
+	case ast.Eq:
+		result = isEqual
+	case ast.Ne:
+		result = !isEqual
+	case ast.Lt:
+		result = isLessThan
+	case ast.Le:
+		result = isLessThan || isEqual
+	case ast.Gt:
+		result = isGreaterThan
+	case ast.Ge:
+		result = isGreaterThan || isEqual
+	default:
+		panic("unhandled comparison kind: " + kind)
+	}
+
+	return newBoolImmediate(pos, result)
+}
+
+// Returns true if the jump is taken.
+func foldConditionalJump(
+	inst *ast.ConditionalJump,
+	src1 ast.Value,
+	src2 ast.Value,
+) bool {
+	isEqual, isLessThan, isGreaterThan := compareImmediates(src1, src2)
+
+	switch inst.Kind {
+	case ast.Jeq:
+		return isEqual
+	case ast.Jne:
+		return !isEqual
+	case ast.Jlt:
+		return isLessThan
+	case ast.Jge:
+		return isGreaterThan || isEqual
+	default:
+		panic("unhandled conditional jump kind: " + inst.Kind)
+	}
+}
+
+// Returns the taken target's index in inst.Targets(), which is also the
+// taken child's index in the switch's parent block.
+func foldSwitch(inst *ast.Switch, src *ast.IntImmediate) int {
+	label := inst.DefaultLabel
+	for _, switchCase := range inst.Cases {
+		if intImmediateValue(switchCase.Value) == intImmediateValue(src) {
+			label = switchCase.Label
+			break
+		}
+	}
+
+	for idx, target := range inst.Targets() {
+		if target == label {
+			return idx
+		}
+	}
+	panic("should never happen")
+}
@@ -0,0 +1,625 @@
+package analyzer
+
+import (
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+type controlFlowEdge struct {
+	parent *ast.Block // nil for the function's entry edge
+	child  *ast.Block
+}
+
+// Sparse conditional constant propagation (Wegman and Zadeck).  This folds
+// unary / binary operations on immediates, propagates the folded constants
+// through phis, resolves conditional jumps / switches with constant sources,
+// and deletes the resulting unreachable blocks.
+//
+// Each definition's lattice value is tracked in the values map:
+//   - undefined: the definition is not in the map.
+//   - constant: the definition maps to an int / float / bool immediate.
+//   - overdefined: the definition maps to nil.
+//
+// The function definition must be in ssa form and type checked.
+type constantPropagator struct {
+	funcDef *ast.FunctionDefinition
+
+	values map[*ast.VariableDefinition]ast.Value
+
+	executableEdges  map[controlFlowEdge]struct{}
+	executableBlocks map[*ast.Block]struct{}
+
+	edgeWorkList []controlFlowEdge
+	defWorkList  []*ast.VariableDefinition
+}
+
+func PropagateConstants() util.Pass[ast.SourceEntry] {
+	return &constantPropagator{}
+}
+
+func (propagator *constantPropagator) Process(entry ast.SourceEntry) {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	propagator.funcDef = funcDef
+	propagator.values = map[*ast.VariableDefinition]ast.Value{}
+	propagator.executableEdges = map[controlFlowEdge]struct{}{}
+	propagator.executableBlocks = map[*ast.Block]struct{}{}
+
+	for _, param := range funcDef.AllParameters() {
+		propagator.values[param] = nil
+	}
+
+	propagator.propagate()
+
+	propagator.resolveBranches()
+	propagator.removeUnreachableBlocks()
+	propagator.replaceConstantDefinitions()
+	propagator.removeSingleSourcePhis()
+}
+
+func (propagator *constantPropagator) isExecutable(block *ast.Block) bool {
+	_, ok := propagator.executableBlocks[block]
+	return ok
+}
+
+func (propagator *constantPropagator) propagate() {
+	propagator.edgeWorkList = append(
+		propagator.edgeWorkList,
+		controlFlowEdge{child: propagator.funcDef.Blocks[0]})
+
+	for len(propagator.edgeWorkList) > 0 || len(propagator.defWorkList) > 0 {
+		if len(propagator.edgeWorkList) > 0 {
+			edge := propagator.edgeWorkList[0]
+			propagator.edgeWorkList = propagator.edgeWorkList[1:]
+
+			_, ok := propagator.executableEdges[edge]
+			if ok {
+				continue
+			}
+			propagator.executableEdges[edge] = struct{}{}
+
+			block := edge.child
+			for _, phi := range block.Phis {
+				propagator.visitPhi(phi)
+			}
+
+			if propagator.isExecutable(block) {
+				continue
+			}
+			propagator.executableBlocks[block] = struct{}{}
+
+			for _, inst := range block.Instructions {
+				propagator.visitInstruction(inst)
+			}
+			propagator.visitBranch(block)
+			continue
+		}
+
+		def := propagator.defWorkList[0]
+		propagator.defWorkList = propagator.defWorkList[1:]
+
+		for ref := range def.DefUses {
+			inst := ref.ParentInstruction
+			if !propagator.isExecutable(inst.ParentBlock()) {
+				continue
+			}
+
+			switch use := inst.(type) {
+			case *ast.Phi:
+				propagator.visitPhi(use)
+			case *ast.ConditionalJump, *ast.Switch:
+				propagator.visitBranch(use.ParentBlock())
+			default:
+				propagator.visitInstruction(use)
+			}
+		}
+	}
+}
+
+// Returns the value's lattice value.  ok is false if the value is undefined.
+func (propagator *constantPropagator) lookup(
+	value ast.Value,
+) (
+	ast.Value,
+	bool,
+) {
+	ref, ok := value.(*ast.VariableReference)
+	if ok {
+		constant, ok := propagator.values[ref.UseDef]
+		return constant, ok
+	}
+
+	if isFoldableImmediate(value) {
+		return value, true
+	}
+	return nil, true // global label reference / zero immediate
+}
+
+// Lowers the definition's lattice value (undefined -> constant ->
+// overdefined).  The definition's uses are revisited whenever its lattice
+// value changes.
+func (propagator *constantPropagator) update(
+	def *ast.VariableDefinition,
+	value ast.Value,
+) {
+	if value != nil {
+		if isFoldableType(def.Type) {
+			value = convertImmediate(def.StartEnd(), value, def.Type)
+		} else {
+			value = nil
+		}
+	}
+
+	current, ok := propagator.values[def]
+	if ok {
+		if current == nil || // already overdefined
+			(value != nil && isSameImmediate(current, value)) {
+
+			return
+		}
+		value = nil
+	}
+
+	propagator.values[def] = value
+	propagator.defWorkList = append(propagator.defWorkList, def)
+}
+
+// Merges the lattice values of the phi's sources from executable edges.
+func (propagator *constantPropagator) visitPhi(phi *ast.Phi) {
+	var merged ast.Value
+	isDefined := false
+	for parent, src := range phi.Srcs {
+		edge := controlFlowEdge{parent, phi.ParentBlock()}
+		_, ok := propagator.executableEdges[edge]
+		if !ok {
+			continue
+		}
+
+		value, ok := propagator.lookup(src)
+		if !ok {
+			continue
+		}
+
+		if value == nil || (isDefined && !isSameImmediate(merged, value)) {
+			propagator.update(phi.Dest, nil)
+			return
+		}
+
+		merged = value
+		isDefined = true
+	}
+
+	if isDefined {
+		propagator.update(phi.Dest, merged)
+	}
+}
+
+func (propagator *constantPropagator) visitInstruction(inst ast.Instruction) {
+	dest := inst.Destination()
+	if dest == nil {
+		return
+	}
+
+	value, ok := propagator.evaluate(inst)
+	if ok {
+		propagator.update(dest, value)
+	}
+}
+
+// Returns the instruction's lattice value.  ok is false if the value is
+// undefined.
+func (propagator *constantPropagator) evaluate(
+	inst ast.Instruction,
+) (
+	ast.Value,
+	bool,
+) {
+	switch inst := inst.(type) {
+	case *ast.CopyOperation:
+		return propagator.lookup(inst.Src)
+	case *ast.UnaryOperation:
+		src, ok := propagator.lookup(inst.Src)
+		if !ok || src == nil {
+			return nil, ok
+		}
+		return foldUnaryOperation(inst, src), true
+	case *ast.BinaryOperation:
+		src1, ok1 := propagator.lookup(inst.Src1)
+		src2, ok2 := propagator.lookup(inst.Src2)
+		if (ok1 && src1 == nil) || (ok2 && src2 == nil) {
+			return nil, true
+		} else if !ok1 || !ok2 {
+			return nil, false
+		}
+		return foldBinaryOperation(inst, src1, src2), true
+	case *ast.SelectOperation:
+		cond, ok := propagator.lookup(inst.Cond)
+		if !ok {
+			return nil, false
+		}
+
+		if cond != nil {
+			if cond.(*ast.BoolImmediate).Value {
+				return propagator.lookup(inst.TrueValue)
+			}
+			return propagator.lookup(inst.FalseValue)
+		}
+
+		trueValue, ok1 := propagator.lookup(inst.TrueValue)
+		falseValue, ok2 := propagator.lookup(inst.FalseValue)
+		if !ok1 || !ok2 {
+			return nil, false
+		} else if trueValue == nil ||
+			falseValue == nil ||
+			!isSameImmediate(trueValue, falseValue) {
+
+			return nil, true
+		}
+		return trueValue, true
+	default: // calls, loads, aggregate operations, etc.
+		return nil, true
+	}
+}
+
+// Marks the block's out edges executable, based on the block's branch
+// instruction's lattice values.
+func (propagator *constantPropagator) visitBranch(block *ast.Block) {
+	children := propagator.executableChildren(block)
+	for _, child := range children {
+		propagator.edgeWorkList = append(
+			propagator.edgeWorkList,
+			controlFlowEdge{block, child})
+	}
+}
+
+// Returns the block's children reachable from the block, based on the
+// block's branch instruction's lattice values.  This returns nil if the
+// branch's sources are undefined.
+func (propagator *constantPropagator) executableChildren(
+	block *ast.Block,
+) []*ast.Block {
+	if len(block.Instructions) == 0 {
+		return block.Children
+	}
+
+	switch inst := block.Instructions[len(block.Instructions)-1].(type) {
+	case *ast.ConditionalJump:
+		src1, ok1 := propagator.lookup(inst.Src1)
+		src2, ok2 := propagator.lookup(inst.Src2)
+		if !ok1 || !ok2 {
+			return nil
+		} else if src1 == nil || src2 == nil {
+			return block.Children
+		}
+
+		// Both branches may share the same child, in which case there's only
+		// one child.  The jump child is always before the fallthrough child.
+		if foldConditionalJump(inst, src1, src2) {
+			return block.Children[:1]
+		}
+		return block.Children[len(block.Children)-1:]
+	case *ast.Switch:
+		src, ok := propagator.lookup(inst.Src)
+		if !ok {
+			return nil
+		} else if src == nil {
+			return block.Children
+		}
+
+		idx := foldSwitch(inst, src.(*ast.IntImmediate))
+		return block.Children[idx : idx+1]
+	default:
+		return block.Children
+	}
+}
+
+// Replaces conditional jumps / switches with constant sources by
+// unconditional jumps, and removes the non-executable edges.
+func (propagator *constantPropagator) resolveBranches() {
+	for _, block := range propagator.funcDef.Blocks {
+		if !propagator.isExecutable(block) ||
+			len(block.Instructions) == 0 ||
+			len(block.Children) == 0 {
+
+			continue
+		}
+
+		last := block.Instructions[len(block.Instructions)-1]
+		switch last.(type) {
+		case *ast.ConditionalJump, *ast.Switch:
+		default:
+			continue
+		}
+
+		children := propagator.executableChildren(block)
+		if len(children) == 0 { // sources are always defined at fixed point
+			panic("should never happen")
+		} else if len(children) != 1 {
+			continue
+		}
+
+		child := children[0]
+		for _, src := range last.Sources() {
+			src.Discard()
+		}
+
+		jump := &ast.Jump{
+			StartEndPos: last.StartEnd(),
+			Label:       child.Label,
+		}
+		jump.SetParentBlock(block)
+		block.Instructions[len(block.Instructions)-1] = jump
+
+		for _, other := range block.Children {
+			if other != child {
+				removeEdge(block, other)
+			}
+		}
+		block.Children = []*ast.Block{child}
+	}
+}
+
+// Removes the parent from the child's parents, and discards the child's
+// phi sources from the parent.  Note that the parent's children are not
+// modified.
+func removeEdge(parent *ast.Block, child *ast.Block) {
+	parents := make([]*ast.Block, 0, len(child.Parents))
+	for _, block := range child.Parents {
+		if block != parent {
+			parents = append(parents, block)
+		}
+	}
+	child.Parents = parents
+
+	for _, phi := range child.Phis {
+		src, ok := phi.Srcs[parent]
+		if !ok {
+			continue
+		}
+
+		src.Discard()
+		delete(phi.Srcs, parent)
+	}
+}
+
+func (propagator *constantPropagator) removeUnreachableBlocks() {
+	reachable := make([]*ast.Block, 0, len(propagator.funcDef.Blocks))
+	for _, block := range propagator.funcDef.Blocks {
+		if propagator.isExecutable(block) {
+			reachable = append(reachable, block)
+			continue
+		}
+
+		for _, child := range block.Children {
+			if propagator.isExecutable(child) {
+				removeEdge(block, child)
+			}
+		}
+
+		// Clear the block's references to the rest of the function.
+		for _, phi := range block.Phis {
+			for _, src := range phi.Srcs {
+				src.Discard()
+			}
+		}
+
+		for _, inst := range block.Instructions {
+			for _, src := range inst.Sources() {
+				src.Discard()
+			}
+		}
+	}
+
+	propagator.funcDef.Blocks = reachable
+}
+
+// Replaces the constant definitions' references by immediates, and removes
+// the definitions that are no longer used.  Phi sources are not replaced
+// since the register allocator expects phi sources to be variable
+// references; constant definitions that are still referenced by phis are
+// replaced by copy operations.
+func (propagator *constantPropagator) replaceConstantDefinitions() {
+	constants := map[*ast.VariableDefinition]ast.Value{}
+	for _, block := range propagator.funcDef.Blocks {
+		for _, phi := range block.Phis {
+			value := propagator.values[phi.Dest]
+			if value != nil {
+				constants[phi.Dest] = value
+			}
+		}
+
+		for idx, inst := range block.Instructions {
+			dest := inst.Destination()
+			if dest == nil {
+				continue
+			}
+
+			value := propagator.values[dest]
+			if value != nil {
+				constants[dest] = value
+				continue
+			}
+
+			sel, ok := inst.(*ast.SelectOperation)
+			if ok {
+				block.Instructions[idx] = propagator.maybeSimplifySelect(sel)
+			}
+		}
+	}
+
+	for def, value := range constants {
+		refs := make([]*ast.VariableReference, 0, len(def.DefUses))
+		for ref := range def.DefUses {
+			refs = append(refs, ref)
+		}
+
+		for _, ref := range refs {
+			if canReplaceWithConstant(ref, value) {
+				ref.ReplaceWith(value)
+			}
+		}
+	}
+
+	// Removing an unused phi may in turn make other constant definitions
+	// unused.
+	modified := true
+	for modified {
+		modified = false
+		for def := range constants {
+			if len(def.DefUses) > 0 {
+				continue
+			}
+
+			delete(constants, def)
+			modified = true
+
+			phi, ok := def.ParentInstruction.(*ast.Phi)
+			if ok {
+				phi.Discard()
+			} else {
+				removeInstruction(def.ParentInstruction)
+			}
+		}
+	}
+
+	for def, value := range constants {
+		inst := def.ParentInstruction
+		_, ok := inst.(*ast.Phi)
+		if ok {
+			continue
+		}
+
+		replaceInstruction(
+			inst,
+			&ast.CopyOperation{
+				StartEndPos: inst.StartEnd(),
+				Dest:        def,
+				Src:         value.Copy(inst.StartEnd()),
+			})
+	}
+}
+
+// A select with a constant condition is replaced by a copy of the selected
+// value.
+func (propagator *constantPropagator) maybeSimplifySelect(
+	sel *ast.SelectOperation,
+) ast.Instruction {
+	cond, ok := propagator.lookup(sel.Cond)
+	if !ok || cond == nil {
+		return sel
+	}
+
+	selected := sel.FalseValue
+	if cond.(*ast.BoolImmediate).Value {
+		selected = sel.TrueValue
+	}
+
+	copyOp := &ast.CopyOperation{
+		StartEndPos: sel.StartEnd(),
+		Dest:        sel.Dest,
+		Src:         selected.Copy(selected.StartEnd()),
+	}
+	copyOp.SetParentBlock(sel.ParentBlock())
+	copyOp.Src.SetParentInstruction(copyOp)
+	sel.Dest.ParentInstruction = copyOp
+
+	for _, src := range sel.Sources() {
+		src.Discard()
+	}
+	return copyOp
+}
+
+func canReplaceWithConstant(
+	ref *ast.VariableReference,
+	value ast.Value,
+) bool {
+	switch inst := ref.ParentInstruction.(type) {
+	case *ast.Phi:
+		return false
+	case *ast.GetElementOperation:
+		if inst.Index == ast.Value(ref) {
+			return isInBoundsIndex(inst.Src.Type(), value)
+		}
+	case *ast.SetElementOperation:
+		if inst.Index == ast.Value(ref) {
+			return isInBoundsIndex(inst.Dest.Type, value)
+		}
+	}
+	return true
+}
+
+// Constant array indices must be within the array's bounds (out of bound
+// accesses in reachable but never executed code are left as is).
+func isInBoundsIndex(valueType ast.Type, value ast.Value) bool {
+	arrayType := valueType.(*ast.ArrayType)
+	imm := value.(*ast.IntImmediate)
+	return !imm.IsNegative && imm.Value < uint64(arrayType.Length)
+}
+
+func removeInstruction(inst ast.Instruction) {
+	block := inst.ParentBlock()
+	for idx, other := range block.Instructions {
+		if other != inst {
+			continue
+		}
+
+		for _, src := range inst.Sources() {
+			src.Discard()
+		}
+
+		block.Instructions = append(
+			block.Instructions[:idx],
+			block.Instructions[idx+1:]...)
+		return
+	}
+	panic("should never happen")
+}
+
+func replaceInstruction(inst ast.Instruction, newInst ast.Instruction) {
+	block := inst.ParentBlock()
+	for idx, other := range block.Instructions {
+		if other != inst {
+			continue
+		}
+
+		for _, src := range inst.Sources() {
+			src.Discard()
+		}
+
+		newInst.SetParentBlock(block)
+		for _, src := range newInst.Sources() {
+			src.SetParentInstruction(newInst)
+		}
+
+		dest := newInst.Destination()
+		if dest != nil {
+			dest.ParentInstruction = newInst
+		}
+
+		block.Instructions[idx] = newInst
+		return
+	}
+	panic("should never happen")
+}
+
+// Phis in blocks with a single parent are replaced by their only source.
+func (propagator *constantPropagator) removeSingleSourcePhis() {
+	for _, block := range propagator.funcDef.Blocks {
+		if len(block.Parents) != 1 {
+			continue
+		}
+
+		for _, phi := range block.Phis {
+			src, ok := phi.Srcs[block.Parents[0]]
+			if !ok || len(phi.Srcs) != 1 {
+				panic("should never happen")
+			}
+
+			phi.Dest.ReplaceReferencesWith(src)
+			phi.Discard()
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package analyzer
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Returns the function's blocks in a compact textual form.  Phis are listed
+// with their parents' labels (phi sources share the phi's name), and
+// terminals are listed without their callee-saved sources.
+func formatBlocks(t *testing.T, entry ast.SourceEntry) string {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	expect.True(t, ok)
+
+	lines := []string{}
+	for _, block := range funcDef.Blocks {
+		lines = append(lines, ":"+block.Label)
+
+		phis := []string{}
+		for _, phi := range block.Phis {
+			parents := []string{}
+			for parent := range phi.Srcs {
+				parents = append(parents, ":"+parent.Label)
+			}
+			sort.Strings(parents)
+
+			phis = append(
+				phis,
+				"  "+phi.Dest.Name+" = phi "+strings.Join(parents, ", "))
+		}
+		sort.Strings(phis)
+		lines = append(lines, phis...)
+
+		for _, inst := range block.Instructions {
+			term, ok := inst.(*ast.Terminal)
+			if ok {
+				lines = append(lines, "  "+string(term.Kind)+" "+term.RetVal.String())
+			} else {
+				lines = append(lines, "  "+inst.String())
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func TestPropagateConstantsThroughPhis(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64) I64 {
+  %x I64 = 5
+  %debug Bool = false
+  jfalse :skip, %debug
+  %x = add %x, 100
+:skip
+  jlt :small, %a, 0
+  %y I64 = sub 10, %x
+  jmp :merge
+:small
+  %y I64 = add 2, 3
+:merge
+  %z = add %y, %x
+  %w = select %debug, %a, %z
+  ret %w
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %x I64 = 5
+  %debug Bool = false
+  jeq :skip, %debug, false
+::unlabelled-block-1
+  %x I64 = add %x 100
+:skip
+  x = phi ::unlabelled-block-0, ::unlabelled-block-1
+  jlt :small, %a, 0
+::unlabelled-block-2
+  %y I64 = sub 10 %x
+  jmp :merge
+:small
+  %y I64 = add 2 3
+:merge
+  y = phi ::unlabelled-block-2, :small
+  %z I64 = add %y %x
+  %w I64 = select %debug, %a, %z
+  ret %w`,
+		formatBlocks(t, entries[0]))
+
+	PropagateConstants().Process(entries[0])
+
+	// The jfalse is always taken.  Hence, the add 100 block is unreachable,
+	// and the :skip phi only merges %x = 5 from the executable edge.  Both %y
+	// definitions fold to 5, the :merge phi folds to 5, and %w folds to 10.
+	// All folded definitions are removed.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  jmp :skip
+:skip
+  jlt :small, %a, 0
+::unlabelled-block-2
+  jmp :merge
+:small
+:merge
+  ret 10`,
+		formatBlocks(t, entries[0]))
+}
+
+func TestPropagateConstantsThroughLoops(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%n I64) I64 {
+  %i I64 = 0
+  %k I64 = 1
+:loop
+  jge :done, %i, %n
+  %k = mul %k, 1
+  %i = add %i, %k
+  jmp :loop
+:done
+  %positive = gt %k, 0
+  jtrue :positive, %positive
+  ret -1
+:positive
+  ret %i
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %i I64 = 0
+  %k I64 = 1
+:loop
+  i = phi ::unlabelled-block-0, ::unlabelled-block-1
+  k = phi ::unlabelled-block-0, ::unlabelled-block-1
+  jge :done, %i, %n
+::unlabelled-block-1
+  %k I64 = mul %k 1
+  %i I64 = add %i %k
+  jmp :loop
+:done
+  %positive Bool = gt %k 0
+  jne :positive, %positive, false
+::unlabelled-block-2
+  ret -1
+:positive
+  ret %i`,
+		formatBlocks(t, entries[0]))
+
+	PropagateConstants().Process(entries[0])
+
+	// %k is 1 along both the entry edge and the back edge.  Hence, the %k phi
+	// is folded (optimistically, before the back edge is known), while the %i
+	// phi is overdefined (0 along the entry edge, but not along the back
+	// edge).  The %i = 0 definition is kept as a copy since it's used by the
+	// %i phi.  The jtrue is always taken, and the ret -1 block is removed.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %i I64 = 0
+:loop
+  i = phi ::unlabelled-block-0, ::unlabelled-block-1
+  jge :done, %i, %n
+::unlabelled-block-1
+  %i I64 = add %i 1
+  jmp :loop
+:done
+  jmp :positive
+:positive
+  ret %i`,
+		formatBlocks(t, entries[0]))
+}
+
+func TestPropagateConstantsThroughSwitches(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64) I64 {
+  %mode I64 = 2
+  switch %mode, :other, [0: :none, 1: :one, 2: :two]
+:none
+  ret 0
+:one
+  ret 1
+:two
+  %r = add %a, 200
+  ret %r
+:other
+  ret -1
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	PropagateConstants().Process(entries[0])
+
+	// Only the :two case is executable.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  jmp :two
+:two
+  %r I64 = add %a 200
+  ret %r`,
+		formatBlocks(t, entries[0]))
+
+	funcDef := entries[0].(*ast.FunctionDefinition)
+	two := funcDef.Blocks[2]
+	expect.Equal(t, 1, len(two.Parents))
+	expect.Same(t, funcDef.Blocks[1], two.Parents[0])
+}
@@ -43,6 +43,24 @@ func (initializer *controlFlowGraphInitializer) Process(
 		}
 	}
 
+	nextId = 0
+	for _, block := range def.Blocks {
+		for _, inst := range block.Instructions {
+			call, ok := inst.(*ast.FuncCall)
+			if !ok || call.Dest != nil {
+				continue
+			}
+
+			// The return value is unused.  Add an internal destination to simplify
+			// downstream analysis.
+			call.Dest = &ast.VariableDefinition{
+				StartEndPos: call.StartEndPos,
+				Name:        fmt.Sprintf("%%%%ignore-call-return-value-%d%%%%", nextId),
+			}
+			nextId++
+		}
+	}
+
 	for idx, block := range def.Blocks {
 		if idx == 0 {
 			// The entry block was inserted during call convention generation and
@@ -76,7 +94,20 @@ func (initializer *controlFlowGraphInitializer) Process(
 				child.Parents = append(child.Parents, block)
 				prevChild = child
 			}
-		case *ast.Terminal:
+		case *ast.Switch:
+			// The children are in the same order as the switch's targets.
+			for _, label := range jump.Targets() {
+				child, ok := labelled[label]
+				if !ok {
+					initializer.Emit(jump.Loc(), "undefined block label (%s)", label)
+					names[label] = struct{}{}
+				} else {
+					block.Children = append(block.Children, child)
+					child.Parents = append(child.Parents, block)
+				}
+			}
+			continue
+		case *ast.Terminal, *ast.TailCall:
 			continue
 		}
 
@@ -0,0 +1,156 @@
+package analyzer
+
+import (
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Removes side-effect-free operations and phis whose destinations are never
+// used.  Removing a definition may leave its sources' definitions unused, so
+// the elimination is repeated until no more definitions can be removed.
+//
+// Calls, syscalls, stores and control flow instructions are always kept.
+// Loads (which may fault on invalid addresses) are also kept.  Operations
+// which may fail at runtime (integer division / remainder, float to int
+// conversion, and array element access) are kept unless their immediate
+// sources are known to be safe (see mayTrap).
+//
+// The function definition must be in ssa form and type checked.
+type DeadCodeEliminator struct {
+	// The number of instructions (including phis) removed by the pass.  This
+	// is reported in the build's debug output (see allocator.Debug).
+	NumRemoved int
+}
+
+var _ util.Pass[ast.SourceEntry] = &DeadCodeEliminator{}
+
+func EliminateDeadCode() *DeadCodeEliminator {
+	return &DeadCodeEliminator{}
+}
+
+func (eliminator *DeadCodeEliminator) Process(entry ast.SourceEntry) {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	for {
+		numRemoved := 0
+		for _, block := range funcDef.Blocks {
+			numRemoved += eliminator.removeDeadPhis(block)
+			numRemoved += eliminator.removeDeadInstructions(block)
+		}
+
+		if numRemoved == 0 {
+			return
+		}
+
+		eliminator.NumRemoved += numRemoved
+	}
+}
+
+func (eliminator *DeadCodeEliminator) removeDeadPhis(block *ast.Block) int {
+	deadPhis := []*ast.Phi{}
+	for _, phi := range block.Phis {
+		if len(phi.Dest.DefUses) == 0 {
+			deadPhis = append(deadPhis, phi)
+		}
+	}
+
+	for _, phi := range deadPhis {
+		phi.Discard()
+	}
+
+	return len(deadPhis)
+}
+
+func (eliminator *DeadCodeEliminator) removeDeadInstructions(
+	block *ast.Block,
+) int {
+	// Iterate in reverse order so that a chain of dead definitions within the
+	// block is removed in a single sweep.
+	numRemoved := 0
+	for idx := len(block.Instructions) - 1; idx >= 0; idx-- {
+		inst := block.Instructions[idx]
+		if !isDeadInstruction(inst) {
+			continue
+		}
+
+		for _, src := range inst.Sources() {
+			src.Discard()
+		}
+
+		block.Instructions = append(
+			block.Instructions[:idx],
+			block.Instructions[idx+1:]...)
+		numRemoved++
+	}
+
+	return numRemoved
+}
+
+func isDeadInstruction(inst ast.Instruction) bool {
+	dest := inst.Destination()
+	if dest == nil || len(dest.DefUses) > 0 {
+		return false
+	}
+
+	switch inst.(type) {
+	case *ast.CopyOperation,
+		*ast.UnaryOperation,
+		*ast.BinaryOperation,
+		*ast.ExtractOperation,
+		*ast.InsertOperation,
+		*ast.GetElementOperation,
+		*ast.SetElementOperation,
+		*ast.SelectOperation:
+
+		return !mayTrap(inst)
+	default:
+		// FuncCall and LoadOperation
+		return false
+	}
+}
+
+// Returns true if the operation may fail at runtime.  The operation is
+// assumed to trap unless its (immediate) sources are known to be safe.
+func mayTrap(inst ast.Instruction) bool {
+	switch op := inst.(type) {
+	case *ast.UnaryOperation:
+		// Float to int conversion is undefined on NaN and out of range values.
+		// Native code does not trap, but the interpreter reports an error.
+		if !ast.IsIntSubType(op.Dest.Type) || !ast.IsFloatSubType(op.Src.Type()) {
+			return false
+		}
+
+		src, ok := op.Src.(*ast.FloatImmediate)
+		return !ok ||
+			truncateFloatImmediate(op.StartEnd(), op.Dest.Type, src.Value) == nil
+	case *ast.BinaryOperation:
+		// Integer division / remainder fails on division by zero and overflow.
+		if op.Kind != ast.Div && op.Kind != ast.Rem {
+			return false
+		}
+
+		if !ast.IsIntSubType(op.Dest.Type) {
+			return false
+		}
+
+		divisor, ok := op.Src2.(*ast.IntImmediate)
+		if !ok || divisor.Value == 0 {
+			return true
+		}
+
+		// MinInt / -1 overflows.
+		return divisor.IsNegative && divisor.Value == 1
+	case *ast.GetElementOperation:
+		// Array element access fails on out of bounds indices.
+		_, ok := op.Index.(*ast.IntImmediate)
+		return !ok || !isInBoundsIndex(op.Src.Type(), op.Index)
+	case *ast.SetElementOperation:
+		_, ok := op.Index.(*ast.IntImmediate)
+		return !ok || !isInBoundsIndex(op.Dest.Type, op.Index)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+// Runs the passes up to (and including) type checking, without optimizing.
+func checkSemantics(t *testing.T, source string) []ast.SourceEntry {
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(source)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	for _, entry := range entries {
+		util.Process(
+			entry,
+			[][]util.Pass[ast.SourceEntry]{
+				{ValidateAstSyntax(emitter)},
+				{GenerateFuncDefTypeAndConstraints(emitter, targetPlatform)},
+			},
+			nil)
+	}
+	expect.False(t, emitter.HasErrors())
+
+	signatures := CollectSignatures(entries, emitter)
+	expect.False(t, emitter.HasErrors())
+
+	for _, entry := range entries {
+		util.Process(
+			entry,
+			[][]util.Pass[ast.SourceEntry]{
+				{LowerTuples(emitter)},
+				{InitializeControlFlowGraph(emitter)},
+				{ModifyTerminals(targetPlatform)},
+				{BindGlobalLabelReferences(emitter, signatures)},
+				{ConstructSSA(emitter)},
+				{CheckTypes(emitter, targetPlatform)},
+			},
+			nil)
+	}
+	expect.False(t, emitter.HasErrors())
+
+	return entries
+}
+
+func TestEliminateDeadCode(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64, %b F64, %t [4]I64) I64 {
+  %unused = add %a, 1  // removed
+  %unused = mul %unused, 2  // removed
+  %c = toI32 1.5  // removed
+  %d = getelem %t, 3  // removed
+  %e = setelem %t, 0, %a  // removed
+
+  %f = div 10, %a  // may divide by zero
+  %g = rem %a, -1  // may overflow
+  %h = toI32 %b  // may be out of range
+  %i = toI8 1000.0  // out of range
+  %j = getelem %t, %a  // may be out of bounds
+  %k = setelem %t, %a, 0  // may be out of bounds
+
+  %call = call @f(%a, %b, %t)  // calls are never removed
+
+  ret %a
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	eliminator := EliminateDeadCode()
+	eliminator.Process(entries[0])
+	expect.Equal(t, 5, eliminator.NumRemoved)
+
+	funcDef, ok := entries[0].(*ast.FunctionDefinition)
+	expect.True(t, ok)
+
+	numKept := 0
+	for _, block := range funcDef.Blocks {
+		for _, inst := range block.Instructions {
+			switch inst.(type) {
+			case *ast.BinaryOperation,
+				*ast.UnaryOperation,
+				*ast.GetElementOperation,
+				*ast.SetElementOperation,
+				*ast.FuncCall:
+
+				numKept++
+			}
+		}
+	}
+	expect.Equal(t, 7, numKept)
+
+	// The second run has nothing left to remove.
+	eliminator = EliminateDeadCode()
+	eliminator.Process(entries[0])
+	expect.Equal(t, 0, eliminator.NumRemoved)
+}
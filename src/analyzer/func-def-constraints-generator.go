@@ -30,18 +30,43 @@ func GenerateFuncDefTypeAndConstraints(
 func (generator *funcDefTypeAndConstraintsGenerator) Process(
 	entry ast.SourceEntry,
 ) {
+	// Declared functions are defined elsewhere.  Only the type is needed.
+	funcDecl, ok := entry.(*ast.FunctionDeclaration)
+	if ok {
+		funcDecl.FuncType = generator.validateFunctionType(
+			funcDecl.StartEnd(),
+			funcDecl.CallConventionName,
+			funcDecl.ParameterTypes,
+			funcDecl.ReturnType)
+		return
+	}
+
 	funcDef, ok := entry.(*ast.FunctionDefinition)
 	if !ok {
 		return
 	}
 
-	callSpec := generator.platform.CallSpec(funcDef.CallConventionName)
-	funcType := generator.validateFunctionType(funcDef, callSpec)
-	if funcType == nil {
+	hasInvalidParameter := false
+	paramTypes := []ast.Type{}
+	for _, def := range funcDef.Parameters {
+		if def.Type == nil {
+			hasInvalidParameter = true
+			continue // error previously emitted
+		}
+		paramTypes = append(paramTypes, def.Type)
+	}
+
+	funcType := generator.validateFunctionType(
+		funcDef.StartEnd(),
+		funcDef.CallConventionName,
+		paramTypes,
+		funcDef.ReturnType)
+	if funcType == nil || hasInvalidParameter {
 		return
 	}
 
 	funcDef.FuncType = funcType
+	callSpec := generator.platform.CallSpec(funcDef.CallConventionName)
 	convention := callSpec.CallConvention(funcType)
 
 	// NOTE: convention temporarily stores callee-saved parameters to pseudo
@@ -68,7 +93,10 @@ func (generator *funcDefTypeAndConstraintsGenerator) Process(
 	for _, idx := range convention.CalleeSavedSourceIndices {
 		// Rename the callee-saved parameter to keep the value throughout the
 		// function, and copy the callee-saved parameter.
-		param := funcDef.Parameters[idx]
+		//
+		// NOTE: the first call source is the function value, which is not a
+		// function definition parameter.
+		param := funcDef.Parameters[idx-1]
 		calleeSavedParameters = append(calleeSavedParameters, param)
 
 		origParamName := param.Name
@@ -156,37 +184,32 @@ func (generator *funcDefTypeAndConstraintsGenerator) generatePseudoParameters(
 }
 
 func (generator *funcDefTypeAndConstraintsGenerator) validateFunctionType(
-	funcDef *ast.FunctionDefinition,
-	callSpec platform.CallSpec,
+	pos parseutil.StartEndPos,
+	callConventionName ast.CallConventionName,
+	paramTypes []ast.Type,
+	returnType ast.Type,
 ) *ast.FunctionType {
+	callSpec := generator.platform.CallSpec(callConventionName)
 	hasCallConventionError := false
 
-	paramTypes := []ast.Type{}
-	for _, def := range funcDef.Parameters {
-		if def.Type == nil {
-			hasCallConventionError = true
-			continue // error previously emitted
-		}
-
-		if !callSpec.IsValidArgType(def.Type) {
+	for _, paramType := range paramTypes {
+		if !callSpec.IsValidArgType(paramType) {
 			hasCallConventionError = true
 			generator.Emit(
-				def.Type.Loc(),
+				paramType.Loc(),
 				"%s call convention does not support %s argument type",
-				funcDef.CallConventionName,
-				def.Type)
+				callConventionName,
+				paramType)
 		}
-
-		paramTypes = append(paramTypes, def.Type)
 	}
 
-	if !callSpec.IsValidReturnType(funcDef.ReturnType) {
+	if !callSpec.IsValidReturnType(returnType) {
 		hasCallConventionError = true
 		generator.Emit(
-			funcDef.ReturnType.Loc(),
+			returnType.Loc(),
 			"%s call convention does not support %s return type",
-			funcDef.CallConventionName,
-			funcDef.ReturnType)
+			callConventionName,
+			returnType)
 	}
 
 	if hasCallConventionError {
@@ -194,8 +217,8 @@ func (generator *funcDefTypeAndConstraintsGenerator) validateFunctionType(
 	}
 
 	return ast.NewFunctionType(
-		funcDef.StartEnd(),
-		funcDef.CallConventionName,
-		funcDef.ReturnType,
+		pos,
+		callConventionName,
+		returnType,
 		paramTypes)
 }
@@ -0,0 +1,95 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+// Passes every argument in its own callee-saved general register.  None of
+// the x64 call specs have callee-saved argument registers.
+type calleeSavedArgumentsCallSpec struct {
+	platform.InternalCallTypeSpec
+}
+
+func (calleeSavedArgumentsCallSpec) Name() ast.CallConventionName {
+	return ast.InternalCallConvention
+}
+
+func (calleeSavedArgumentsCallSpec) CallConvention(
+	funcType *ast.FunctionType,
+) *architecture.CallConvention {
+	general := x64.RegisterSet.General
+
+	convention := architecture.NewCallConvention(true, general[1])
+	convention.SetFramePointerRegister(general[0])
+
+	for idx := range funcType.ParameterTypes {
+		convention.AddRegisterSource(false, general[idx+2])
+	}
+	convention.SetRegisterDestination(general[1])
+
+	return convention
+}
+
+type calleeSavedArgumentsPlatform struct {
+	platform.Platform
+}
+
+func (calleeSavedArgumentsPlatform) CallSpec(
+	ast.CallConventionName,
+) platform.CallSpec {
+	return calleeSavedArgumentsCallSpec{}
+}
+
+func TestCalleeSavedParameters(t *testing.T) {
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"callee-saved.chi",
+			[]byte(`
+define func @f(%a I64, %b I64, %c I64) I64 {
+  %d = add %a, %b
+  %d = add %d, %c
+  ret %d
+}
+`)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+	expect.Equal(t, 1, len(entries))
+
+	GenerateFuncDefTypeAndConstraints(
+		emitter,
+		calleeSavedArgumentsPlatform{x64.NewPlatform(platform.Linux)},
+	).Process(entries[0])
+	expect.False(t, emitter.HasErrors())
+
+	funcDef, ok := entries[0].(*ast.FunctionDefinition)
+	expect.True(t, ok)
+
+	// Every parameter is callee saved.  Note that the callee-saved call source
+	// indices are off by one since the first call source is the function value.
+	expect.Equal(t, 3, len(funcDef.Parameters))
+	expect.True(t, len(funcDef.CalleeSavedParameters) >= 3)
+
+	entryBlock := funcDef.Blocks[0]
+	expect.Equal(t, 3, len(entryBlock.Instructions))
+
+	for idx, name := range []string{"a", "b", "c"} {
+		param := funcDef.Parameters[idx]
+		expect.Same(t, param, funcDef.CalleeSavedParameters[idx])
+		expect.Equal(t, "%%"+name, param.Name)
+
+		copyOp, ok := entryBlock.Instructions[idx].(*ast.CopyOperation)
+		expect.True(t, ok)
+		expect.Equal(t, name, copyOp.Dest.Name)
+		expect.Equal(t, "%%"+name, copyOp.Src.(*ast.VariableReference).Name)
+	}
+}
@@ -24,6 +24,8 @@ func BindGlobalLabelReferences(
 }
 
 func (binder *globalLabelReferenceBinder) Process(entry ast.SourceEntry) {
+	// NOTE: data definitions' values are immediates, which never reference
+	// global labels.  References to data definitions are bound to pointers.
 	funcDef, ok := entry.(*ast.FunctionDefinition)
 	if !ok {
 		return
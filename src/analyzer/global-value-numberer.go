@@ -0,0 +1,307 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Dominator-based global value numbering.  Copy, unary and binary operations
+// that recompute a value already computed by a dominating definition are
+// removed, and their references are replaced by the dominating definition.
+// Commutative operations' operands are numbered in canonical order.  Phis
+// whose sources all have the same value number are numbered (and removed)
+// likewise.
+//
+// NOTE: The register allocator tracks values by name, and assumes that at
+// most one definition of each name is live at any given point, and that phi
+// sources share the phi's name.  Extending a dominating definition's live
+// range could break both assumptions.  Hence, a redundant definition is only
+// replaced by a dominating definition whose name is unique within the
+// function, a redundant definition used by phis is replaced by a copy of
+// the dominating definition instead, and a redundant phi used by phis is
+// kept as is.
+//
+// The function definition must be in ssa form and type checked.
+type globalValueNumberer struct {
+	// The number of definitions (including parameters and phis) per name.
+	nameCounts map[string]int
+
+	numValues         int
+	valueNumbers      map[*ast.VariableDefinition]int
+	expressionNumbers map[string]int
+
+	// The definitions which hold the value numbers at the current point of the
+	// dominator tree walk.
+	available map[int]*ast.VariableDefinition
+}
+
+func NumberGlobalValues() util.Pass[ast.SourceEntry] {
+	return &globalValueNumberer{
+		nameCounts:        map[string]int{},
+		valueNumbers:      map[*ast.VariableDefinition]int{},
+		expressionNumbers: map[string]int{},
+		available:         map[int]*ast.VariableDefinition{},
+	}
+}
+
+func (numberer *globalValueNumberer) Process(entry ast.SourceEntry) {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	for _, param := range funcDef.AllParameters() {
+		numberer.nameCounts[param.Name]++
+		numberer.newValue(param)
+	}
+
+	for _, block := range funcDef.Blocks {
+		for _, phi := range block.Phis {
+			numberer.nameCounts[phi.Dest.Name]++
+		}
+
+		for _, inst := range block.Instructions {
+			dest := inst.Destination()
+			if dest != nil {
+				numberer.nameCounts[dest.Name]++
+			}
+		}
+	}
+
+	dominators := util.NewDominatorTree(funcDef)
+	numberer.processBlock(dominators, dominators.Root)
+}
+
+func (numberer *globalValueNumberer) processBlock(
+	dominators *util.DominatorTree,
+	block *ast.Block,
+) {
+	// Definitions made available by this block, which are restored to their
+	// previous values once the block's dominator subtree is processed.
+	type shadowed struct {
+		number int
+		def    *ast.VariableDefinition
+	}
+	restore := []shadowed{}
+
+	makeAvailable := func(number int, def *ast.VariableDefinition) {
+		restore = append(restore, shadowed{number, numberer.available[number]})
+		numberer.available[number] = def
+	}
+
+	// NOTE: redundant phis are removed while iterating.
+	for _, phi := range block.Phis {
+		number, ok := numberer.phiNumber(phi)
+		if !ok {
+			makeAvailable(numberer.newValue(phi.Dest), phi.Dest)
+			continue
+		}
+
+		numberer.valueNumbers[phi.Dest] = number
+
+		def := numberer.available[number]
+		if def == nil {
+			makeAvailable(number, phi.Dest)
+		} else if numberer.isUniqueName(def) &&
+			def.Type.Equals(phi.Dest.Type) &&
+			!isUsedByPhi(phi.Dest) {
+
+			ref := def.NewRef(phi.StartEnd())
+			phi.Dest.ReplaceReferencesWith(ref)
+			ref.Discard()
+
+			phi.Discard()
+		} else if numberer.isUniqueName(phi.Dest) && !numberer.isUniqueName(def) {
+			makeAvailable(number, phi.Dest)
+		}
+	}
+
+	// NOTE: redundant instructions are removed while iterating.
+	insts := append([]ast.Instruction{}, block.Instructions...)
+	for _, inst := range insts {
+		dest := inst.Destination()
+		if dest == nil {
+			continue
+		}
+
+		number, ok := numberer.valueNumber(inst)
+		if !ok {
+			makeAvailable(numberer.newValue(dest), dest)
+			continue
+		}
+
+		numberer.valueNumbers[dest] = number
+
+		def := numberer.available[number]
+		if def == nil {
+			makeAvailable(number, dest)
+		} else if numberer.isUniqueName(def) && def.Type.Equals(dest.Type) {
+			numberer.replaceDefinition(inst, def)
+		} else if numberer.isUniqueName(dest) && !numberer.isUniqueName(def) {
+			makeAvailable(number, dest)
+		}
+	}
+
+	for _, child := range dominators.Children[block] {
+		numberer.processBlock(dominators, child)
+	}
+
+	for idx := len(restore) - 1; idx >= 0; idx-- {
+		entry := restore[idx]
+		if entry.def == nil {
+			delete(numberer.available, entry.number)
+		} else {
+			numberer.available[entry.number] = entry.def
+		}
+	}
+}
+
+func (numberer *globalValueNumberer) isUniqueName(
+	def *ast.VariableDefinition,
+) bool {
+	return numberer.nameCounts[def.Name] == 1
+}
+
+func (numberer *globalValueNumberer) newValue(
+	def *ast.VariableDefinition,
+) int {
+	number := numberer.numValues
+	numberer.numValues++
+	numberer.valueNumbers[def] = number
+	return number
+}
+
+// A phi whose sources all have the same value number shares its sources'
+// value number.  Returns false if the sources' value numbers differ, or if
+// some sources are not yet numbered (e.g., sources from back edges).  Hence,
+// loop header phis are always assigned new value numbers.
+func (numberer *globalValueNumberer) phiNumber(phi *ast.Phi) (int, bool) {
+	number := -1
+	for _, src := range phi.Srcs {
+		ref, ok := src.(*ast.VariableReference)
+		if !ok {
+			return 0, false
+		}
+
+		srcNumber, ok := numberer.valueNumbers[ref.UseDef]
+		if !ok || (number >= 0 && srcNumber != number) {
+			return 0, false
+		}
+		number = srcNumber
+	}
+
+	return number, number >= 0
+}
+
+// Returns false if the instruction's value cannot be numbered.
+func (numberer *globalValueNumberer) valueNumber(
+	in ast.Instruction,
+) (int, bool) {
+	var expression string
+	switch inst := in.(type) {
+	case *ast.CopyOperation:
+		ref, ok := inst.Src.(*ast.VariableReference)
+		if ok {
+			return numberer.definitionNumber(ref.UseDef), true
+		}
+
+		expression = fmt.Sprintf(
+			"copy %s %s",
+			inst.Dest.Type,
+			numberer.operand(inst.Src))
+	case *ast.UnaryOperation:
+		expression = fmt.Sprintf(
+			"%s %s %s",
+			inst.Kind,
+			inst.Dest.Type,
+			numberer.operand(inst.Src))
+	case *ast.BinaryOperation:
+		operands := []string{
+			numberer.operand(inst.Src1),
+			numberer.operand(inst.Src2),
+		}
+
+		switch inst.Kind {
+		case ast.Add, ast.Mul, ast.And, ast.Or, ast.Xor:
+			sort.Strings(operands)
+		}
+
+		expression = fmt.Sprintf(
+			"%s %s %s, %s",
+			inst.Kind,
+			inst.Dest.Type,
+			operands[0],
+			operands[1])
+	default:
+		return 0, false
+	}
+
+	number, ok := numberer.expressionNumbers[expression]
+	if !ok {
+		number = numberer.newValue(in.Destination())
+		numberer.expressionNumbers[expression] = number
+	}
+
+	return number, true
+}
+
+// The definition must dominate the current instruction, and hence is already
+// numbered.
+func (numberer *globalValueNumberer) definitionNumber(
+	def *ast.VariableDefinition,
+) int {
+	number, ok := numberer.valueNumbers[def]
+	if !ok {
+		panic("should never happen")
+	}
+	return number
+}
+
+func (numberer *globalValueNumberer) operand(value ast.Value) string {
+	ref, ok := value.(*ast.VariableReference)
+	if ok {
+		return fmt.Sprintf("#%d", numberer.definitionNumber(ref.UseDef))
+	}
+
+	return fmt.Sprintf("%s:%s", value, value.Type())
+}
+
+func (numberer *globalValueNumberer) replaceDefinition(
+	inst ast.Instruction,
+	def *ast.VariableDefinition,
+) {
+	dest := inst.Destination()
+
+	if isUsedByPhi(dest) {
+		_, ok := inst.(*ast.CopyOperation)
+		if !ok {
+			replaceInstruction(
+				inst,
+				&ast.CopyOperation{
+					StartEndPos: inst.StartEnd(),
+					Dest:        dest,
+					Src:         def.NewRef(inst.StartEnd()),
+				})
+		}
+		return
+	}
+
+	ref := def.NewRef(inst.StartEnd())
+	dest.ReplaceReferencesWith(ref)
+	ref.Discard()
+
+	removeInstruction(inst)
+}
+
+func isUsedByPhi(def *ast.VariableDefinition) bool {
+	for ref := range def.DefUses {
+		_, ok := ref.ParentInstruction.(*ast.Phi)
+		if ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+)
+
+func TestNumberGlobalValues(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64, %b I64) I64 {
+  %x = add %a, %b
+  jlt :else, %a, 0
+  %c = add %b, %a
+  %d = mul %c, 2
+  %e = mul %x, 2
+  %f = sub %d, %e
+  ret %f
+:else
+  %g = sub %a, %b
+  %h = sub %b, %a
+  %g = add %g, %h
+  ret %g
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	NumberGlobalValues().Process(entries[0])
+
+	// %c is the commutative equivalent of the dominating %x, and hence %e is
+	// equivalent to %d.  sub is not commutative, and %g is not unique.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %x I64 = add %a %b
+  jlt :else, %a, 0
+::unlabelled-block-1
+  %d I64 = mul %x 2
+  %f I64 = sub %d %d
+  ret %f
+:else
+  %g I64 = sub %a %b
+  %h I64 = sub %b %a
+  %g I64 = add %g %h
+  ret %g`,
+		formatBlocks(t, entries[0]))
+}
+
+func TestNumberGlobalValuesPhis(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64, %b I64) I64 {
+  %x = add %a, %b
+  jlt :else, %a, 0
+  %y = add %a, %b
+  jmp :merge
+:else
+  %y = add %b, %a
+:merge
+  %z = mul %y, 3
+  %w = mul %x, 3
+  %r = sub %z, %w
+  ret %r
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	NumberGlobalValues().Process(entries[0])
+
+	// Both %y definitions are redundant, but are used by the phi.  Hence,
+	// they are replaced by copies of the dominating %x.  The phi's sources
+	// share %x's value number, and so the phi is replaced by %x as well.  The
+	// (now unused) copies are left for dead code elimination.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %x I64 = add %a %b
+  jlt :else, %a, 0
+::unlabelled-block-1
+  %y I64 = %x
+  jmp :merge
+:else
+  %y I64 = %x
+:merge
+  %z I64 = mul %x 3
+  %r I64 = sub %z %z
+  ret %r`,
+		formatBlocks(t, entries[0]))
+}
+
+func TestNumberGlobalValuesNonUniquePhi(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64, %b I64) I64 {
+  jlt :else, %a, 0
+  %y = add %a, %b
+  jmp :merge
+:else
+  %y = add %b, %a
+:merge
+  %z = add %a, %b
+  %r = sub %z, %y
+  ret %r
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	expected := formatBlocks(t, entries[0])
+
+	NumberGlobalValues().Process(entries[0])
+
+	// %z has the same value number as the %y phi, but the phi shares its
+	// name with its sources.  Replacing %z by the phi could break the
+	// register allocator's naming assumptions, and so nothing is replaced.
+	expect.Equal(t, expected, formatBlocks(t, entries[0]))
+}
+
+func TestNumberGlobalValuesLoops(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func @f(%a I64, %n I64) I64 {
+  %i I64 = 0
+  %x = add %a, 1
+:loop
+  jge :done, %i, %n
+  %i = add %i, 1
+  jmp :loop
+:done
+  %y = add %a, 1
+  %r = add %i, %y
+  %r = sub %r, %x
+  ret %r
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	NumberGlobalValues().Process(entries[0])
+
+	// The loop header phi's back edge source is not numbered when the phi is
+	// visited, and so the phi is assigned a new value number.  %y is replaced
+	// by the dominating %x across the loop.
+	expect.Equal(
+		t,
+		`::entry-block
+::unlabelled-block-0
+  %i I64 = 0
+  %x I64 = add %a 1
+:loop
+  i = phi ::unlabelled-block-0, ::unlabelled-block-1
+  jge :done, %i, %n
+::unlabelled-block-1
+  %i I64 = add %i 1
+  jmp :loop
+:done
+  %r I64 = add %i %x
+  %r I64 = sub %r %x
+  ret %r`,
+		formatBlocks(t, entries[0]))
+}
@@ -18,22 +18,29 @@ func CollectSignatures(
 			continue
 		}
 
+		var label string
 		switch entry := source.(type) {
 		case *ast.FunctionDefinition:
-			prev, ok := result[entry.Label]
-			if ok {
-				emitter.Emit(
-					entry.Loc(),
-					"definition (%s) previously defined at (%s)",
-					entry.Label,
-					prev.Loc())
-				continue
-			}
-
-			result[entry.Label] = entry
+			label = entry.Label
+		case *ast.DataDefinition:
+			label = entry.Label
+		case *ast.FunctionDeclaration:
+			label = entry.Label
 		default:
 			panic(fmt.Sprintf("%s: unhandled SourceEntry", source.Loc()))
 		}
+
+		prev, ok := result[label]
+		if ok {
+			emitter.Emit(
+				source.Loc(),
+				"definition (%s) previously defined at (%s)",
+				label,
+				prev.Loc())
+			continue
+		}
+
+		result[label] = source
 	}
 
 	return result
@@ -0,0 +1,251 @@
+package analyzer
+
+import (
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+// Rewrites a call whose return value is immediately returned into a tail
+// call, when the callee's call convention is compatible with the function's
+// call convention (see architecture.CallConvention.CanTailCall).  The ret
+// either immediately follows the call, or is the only instruction of the
+// call block's only child (the call may be followed by a jump, and the return
+// value may flow through the child's phi).  In the latter case, the edge to
+// the child is removed, and the child is removed if it becomes unreachable.
+//
+// The function definition must be in ssa form and type checked.
+type tailCallOptimizer struct {
+	platform platform.Platform
+}
+
+func OptimizeTailCalls(
+	targetPlatform platform.Platform,
+) util.Pass[ast.SourceEntry] {
+	return &tailCallOptimizer{
+		platform: targetPlatform,
+	}
+}
+
+func (optimizer *tailCallOptimizer) Process(entry ast.SourceEntry) {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	modifiedChildren := map[*ast.Block]struct{}{}
+	for _, block := range funcDef.Blocks {
+		if len(block.Children) == 0 {
+			optimizer.optimizeRet(funcDef, block)
+			continue
+		}
+
+		child := optimizer.optimizeChildRet(funcDef, block)
+		if child != nil {
+			modifiedChildren[child] = struct{}{}
+		}
+	}
+
+	if len(modifiedChildren) == 0 {
+		return
+	}
+
+	blocks := make([]*ast.Block, 0, len(funcDef.Blocks))
+	for _, block := range funcDef.Blocks {
+		_, ok := modifiedChildren[block]
+		if !ok {
+			blocks = append(blocks, block)
+			continue
+		}
+
+		switch len(block.Parents) {
+		case 0: // unreachable
+			for _, phi := range block.Phis {
+				phi.Discard()
+			}
+
+			for _, inst := range block.Instructions {
+				for _, src := range inst.Sources() {
+					src.Discard()
+				}
+			}
+			continue
+		case 1:
+			// Phis are only needed by blocks with multiple parents.
+			for _, phi := range block.Phis {
+				phi.Dest.ReplaceReferencesWith(phi.Srcs[block.Parents[0]])
+				phi.Discard()
+			}
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	funcDef.Blocks = blocks
+}
+
+// Handles call immediately followed by ret.
+func (optimizer *tailCallOptimizer) optimizeRet(
+	funcDef *ast.FunctionDefinition,
+	block *ast.Block,
+) {
+	numInsts := len(block.Instructions)
+	if numInsts < 2 {
+		return
+	}
+
+	term, ok := block.Instructions[numInsts-1].(*ast.Terminal)
+	if !ok || term.Kind != ast.Ret {
+		return
+	}
+
+	call, ok := block.Instructions[numInsts-2].(*ast.FuncCall)
+	if !ok || !optimizer.canTailCall(funcDef, call, term.RetVal) {
+		return
+	}
+
+	tailCall := newTailCall(
+		parseutil.NewStartEndPos(call.Loc(), term.End()),
+		call,
+		term.CalleeSavedSources)
+
+	// The call's destination is only used by the discarded ret.
+	term.RetVal.Discard()
+
+	block.Instructions[numInsts-2] = tailCall
+	block.Instructions = block.Instructions[:numInsts-1]
+}
+
+// Handles call followed by an optional jump to a child block which only
+// returns the call's return value.  This returns the child if the call is
+// rewritten.
+func (optimizer *tailCallOptimizer) optimizeChildRet(
+	funcDef *ast.FunctionDefinition,
+	block *ast.Block,
+) *ast.Block {
+	if len(block.Children) != 1 {
+		return nil
+	}
+
+	child := block.Children[0]
+	if child == block || len(child.Instructions) != 1 {
+		return nil
+	}
+
+	term, ok := child.Instructions[0].(*ast.Terminal)
+	if !ok || term.Kind != ast.Ret {
+		return nil
+	}
+
+	numInsts := len(block.Instructions)
+	if numInsts > 0 {
+		_, ok := block.Instructions[numInsts-1].(*ast.Jump)
+		if ok {
+			numInsts--
+		}
+	}
+
+	if numInsts == 0 {
+		return nil
+	}
+
+	call, ok := block.Instructions[numInsts-1].(*ast.FuncCall)
+	if !ok ||
+		!optimizer.canTailCall(
+			funcDef,
+			call,
+			resolveChildValue(block, term.RetVal)) {
+
+		return nil
+	}
+
+	calleeSavedSources := make([]ast.Value, 0, len(term.CalleeSavedSources))
+	for _, src := range term.CalleeSavedSources {
+		calleeSavedSources = append(
+			calleeSavedSources,
+			resolveChildValue(block, src).Copy(call.StartEnd()))
+	}
+
+	tailCall := newTailCall(call.StartEnd(), call, calleeSavedSources)
+
+	block.Instructions[numInsts-1] = tailCall
+	block.Instructions = block.Instructions[:numInsts]
+
+	// This also discards the phi's reference to the call's destination.
+	removeEdge(block, child)
+	block.Children = nil
+
+	return child
+}
+
+// Returns the parent's value for the child's value, i.e., the child's phi is
+// resolved to the phi's source from the parent.
+func resolveChildValue(parent *ast.Block, value ast.Value) ast.Value {
+	ref, ok := value.(*ast.VariableReference)
+	if !ok {
+		return value
+	}
+
+	phi, ok := ref.UseDef.ParentInstruction.(*ast.Phi)
+	if !ok {
+		return value
+	}
+
+	src, ok := phi.Srcs[parent]
+	if !ok {
+		return value
+	}
+
+	return src
+}
+
+func newTailCall(
+	pos parseutil.StartEndPos,
+	call *ast.FuncCall,
+	calleeSavedSources []ast.Value,
+) *ast.TailCall {
+	tailCall := &ast.TailCall{
+		StartEndPos: pos,
+		Func:        call.Func,
+		Args:        call.Args,
+
+		CalleeSavedSources: calleeSavedSources,
+	}
+
+	tailCall.SetParentBlock(call.ParentBlock())
+	for _, src := range tailCall.Sources() {
+		src.SetParentInstruction(tailCall)
+	}
+
+	return tailCall
+}
+
+func (optimizer *tailCallOptimizer) canTailCall(
+	funcDef *ast.FunctionDefinition,
+	call *ast.FuncCall,
+	retVal ast.Value,
+) bool {
+	if call.Kind != ast.Call || call.IsExitTerminal {
+		return false
+	}
+
+	ref, ok := retVal.(*ast.VariableReference)
+	if !ok || ref.UseDef != call.Dest || len(call.Dest.DefUses) != 1 {
+		return false
+	}
+
+	// The call's return type must be identical to the function's return type
+	// since the return value is passed through without conversion.
+	if !call.Dest.Type.Equals(funcDef.ReturnType) {
+		return false
+	}
+
+	calleeType := call.Func.Type().(*ast.FunctionType)
+	_, isDirect := call.Func.(*ast.GlobalLabelReference)
+	convention := optimizer.platform.CallConvention(funcDef.FuncType)
+	return convention.CanTailCall(
+		optimizer.platform.CallConvention(calleeType),
+		isDirect)
+}
@@ -0,0 +1,93 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func TestOptimizeTailCallsThroughPhi(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func{internal} @f(%n I64, %acc I64) I64 {
+  jeq :done, %n, 0
+  %acc = add %acc, %n
+  %n = sub %n, 1
+  %acc = call @f(%n, %acc)
+:done
+  ret %acc
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	funcDef, ok := entries[0].(*ast.FunctionDefinition)
+	expect.True(t, ok)
+
+	numBlocks := len(funcDef.Blocks)
+	done := funcDef.Blocks[numBlocks-1]
+	expect.Equal(t, "done", done.Label)
+	expect.Equal(t, 2, len(done.Parents))
+	expect.Equal(t, 2, len(done.Phis)) // %n and %acc
+
+	OptimizeTailCalls(x64.NewPlatform(platform.Linux)).Process(entries[0])
+
+	expect.Equal(t, numBlocks, len(funcDef.Blocks))
+
+	body := funcDef.Blocks[numBlocks-2]
+	expect.Equal(t, 0, len(body.Children))
+
+	tailCall, ok := body.Instructions[len(body.Instructions)-1].(*ast.TailCall)
+	expect.True(t, ok)
+	expect.Equal(t, 2, len(tailCall.Args))
+
+	// The done block's only parent is the jeq block.  Hence, the phis are
+	// replaced by the parameters.
+	expect.Equal(t, 1, len(done.Parents))
+	expect.Equal(t, 0, len(done.Phis))
+
+	ret, ok := done.Instructions[0].(*ast.Terminal)
+	expect.True(t, ok)
+
+	retVal, ok := ret.RetVal.(*ast.VariableReference)
+	expect.True(t, ok)
+	expect.Same(t, funcDef.Parameters[1], retVal.UseDef)
+}
+
+func TestOptimizeTailCallsRemovesUnreachableChild(t *testing.T) {
+	entries := checkSemantics(
+		t,
+		`
+define func{internal} @f(%n I64) I64 {
+  %n = sub %n, 1
+  %r = call @f(%n)
+  jmp :done
+:done
+  ret %r
+}
+`)
+	expect.Equal(t, 1, len(entries))
+
+	funcDef, ok := entries[0].(*ast.FunctionDefinition)
+	expect.True(t, ok)
+
+	numBlocks := len(funcDef.Blocks)
+
+	OptimizeTailCalls(x64.NewPlatform(platform.Linux)).Process(entries[0])
+
+	expect.Equal(t, numBlocks-1, len(funcDef.Blocks))
+
+	last := funcDef.Blocks[len(funcDef.Blocks)-1]
+	expect.Equal(t, 0, len(last.Children))
+
+	_, ok = last.Instructions[len(last.Instructions)-1].(*ast.TailCall)
+	expect.True(t, ok)
+
+	for _, block := range funcDef.Blocks {
+		expect.True(t, block.Label != "done")
+	}
+}
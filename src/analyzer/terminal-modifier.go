@@ -34,7 +34,20 @@ func (modifier *terminalModifier) Process(
 			continue
 		}
 
-		term := block.Instructions[len(block.Instructions)-1].(*ast.Terminal)
+		last := block.Instructions[len(block.Instructions)-1]
+		tailCall, ok := last.(*ast.TailCall)
+		if ok {
+			for _, def := range funcDef.CalleeSavedParameters {
+				ref := def.NewRef(tailCall.StartEnd())
+				ref.SetParentInstruction(tailCall)
+				tailCall.CalleeSavedSources = append(
+					tailCall.CalleeSavedSources,
+					ref)
+			}
+			continue
+		}
+
+		term := last.(*ast.Terminal)
 		switch term.Kind {
 		case ast.Ret:
 			for _, def := range funcDef.CalleeSavedParameters {
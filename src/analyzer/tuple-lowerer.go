@@ -0,0 +1,144 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer/util"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Lowers multiple return values into tuple operations.  Call's tuple
+// destinations are unpacked from the returned tuple by extract operations, and
+// ret's tuple values are packed into the returned tuple by insert operations.
+type tupleLowerer struct {
+	*parseutil.Emitter
+
+	nextId int
+}
+
+func LowerTuples(
+	emitter *parseutil.Emitter,
+) util.Pass[ast.SourceEntry] {
+	return &tupleLowerer{
+		Emitter: emitter,
+	}
+}
+
+func (lowerer *tupleLowerer) Process(entry ast.SourceEntry) {
+	funcDef, ok := entry.(*ast.FunctionDefinition)
+	if !ok {
+		return
+	}
+
+	for _, block := range funcDef.Blocks {
+		instructions := make([]ast.Instruction, 0, len(block.Instructions))
+		for _, in := range block.Instructions {
+			switch inst := in.(type) {
+			case *ast.FuncCall:
+				if len(inst.TupleDests) > 0 {
+					instructions = append(instructions, lowerer.lowerCall(inst)...)
+					continue
+				}
+			case *ast.Terminal:
+				if len(inst.TupleRetVals) > 0 {
+					instructions = append(
+						instructions,
+						lowerer.lowerTerminal(funcDef, inst)...)
+					continue
+				}
+			}
+
+			instructions = append(instructions, in)
+		}
+		block.Instructions = instructions
+	}
+}
+
+func (lowerer *tupleLowerer) newName(kind string) string {
+	name := fmt.Sprintf("%%%%%s-tuple-%d%%%%", kind, lowerer.nextId)
+	lowerer.nextId++
+	return name
+}
+
+// NOTE: the callee's return type is unknown prior to type checking.  The type
+// checker verifies that the number of destinations matches the number of
+// returned tuple elements.
+func (lowerer *tupleLowerer) lowerCall(call *ast.FuncCall) []ast.Instruction {
+	tuple := &ast.VariableDefinition{
+		StartEndPos: call.StartEndPos,
+		Name:        lowerer.newName("call"),
+	}
+
+	result := []ast.Instruction{call}
+	for idx, dest := range call.TupleDests {
+		result = append(
+			result,
+			&ast.ExtractOperation{
+				StartEndPos: dest.StartEndPos,
+				Dest:        dest,
+				Src: &ast.VariableReference{
+					StartEndPos: dest.StartEndPos,
+					Name:        tuple.Name,
+				},
+				Field: ast.TupleFieldName(idx),
+			})
+	}
+
+	call.Dest = tuple
+	call.NumTupleDests = len(call.TupleDests)
+	call.TupleDests = nil
+	return result
+}
+
+func (lowerer *tupleLowerer) lowerTerminal(
+	funcDef *ast.FunctionDefinition,
+	term *ast.Terminal,
+) []ast.Instruction {
+	retType := funcDef.ReturnType
+	if ast.TupleSize(retType) != len(term.TupleRetVals) {
+		lowerer.Emit(
+			term.Loc(),
+			"cannot return %d values, expected %s",
+			len(term.TupleRetVals),
+			retType)
+		return []ast.Instruction{term}
+	}
+
+	name := lowerer.newName("ret")
+
+	result := []ast.Instruction{}
+	var tuple ast.Value = &ast.ZeroImmediate{
+		StartEndPos: term.StartEndPos,
+	}
+	for idx, val := range term.TupleRetVals {
+		dest := &ast.VariableDefinition{
+			StartEndPos: val.StartEnd(),
+			Name:        name,
+		}
+		if idx == 0 {
+			// Binds the zero source to the tuple type.
+			dest.Type = retType
+		}
+
+		result = append(
+			result,
+			&ast.InsertOperation{
+				StartEndPos: val.StartEnd(),
+				Dest:        dest,
+				Src:         tuple,
+				Field:       ast.TupleFieldName(idx),
+				Value:       val,
+			})
+
+		tuple = &ast.VariableReference{
+			StartEndPos: val.StartEnd(),
+			Name:        name,
+		}
+	}
+
+	term.RetVal = tuple
+	term.TupleRetVals = nil
+	return append(result, term)
+}
@@ -0,0 +1,93 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func analyzeSemanticsErrors(t *testing.T, source string) []error {
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(source)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	AnalyzeSemantics(entries, x64.NewPlatform(platform.Linux), emitter, false)
+	return emitter.Errors()
+}
+
+const pairSource = `
+define func @pair(%a I64) (I64, I64) {
+  ret %a, %a
+}
+`
+
+func TestLowerTupleCall(t *testing.T) {
+	errs := analyzeSemanticsErrors(
+		t,
+		pairSource+`
+define func @sum(%a I64) I64 {
+  %x, %y = call @pair(%a)
+  %x = add %x, %y
+  ret %x
+}
+`)
+	expect.Equal(t, 0, len(errs))
+}
+
+func TestLowerTupleCallTooFewDestinations(t *testing.T) {
+	errs := analyzeSemanticsErrors(
+		t,
+		pairSource+`
+define func @triple(%a I64) (I64, I64, I64) {
+  ret %a, %a, %a
+}
+
+define func @sum(%a I64) I64 {
+  %x, %y = call @triple(%a)
+  %x = add %x, %y
+  ret %x
+}
+`)
+	expect.Equal(t, 1, len(errs))
+	expect.Error(t, errs[0], "cannot unpack 2 values, expected struct{0 I64, 1 I64, 2 I64}")
+}
+
+func TestLowerTupleCallTooManyDestinations(t *testing.T) {
+	errs := analyzeSemanticsErrors(
+		t,
+		pairSource+`
+define func @sum(%a I64) I64 {
+  %x, %y, %z = call @pair(%a)
+  %x = add %x, %y
+  ret %x
+}
+`)
+	expect.Equal(t, 1, len(errs))
+	expect.Error(t, errs[0], "cannot unpack 3 values, expected struct{0 I64, 1 I64}")
+}
+
+func TestLowerTupleCallNonTupleReturn(t *testing.T) {
+	errs := analyzeSemanticsErrors(
+		t,
+		`
+define func @single(%a I64) I64 {
+  ret %a
+}
+
+define func @sum(%a I64) I64 {
+  %x, %y = call @single(%a)
+  ret %x
+}
+`)
+	expect.Equal(t, 1, len(errs))
+	expect.Error(t, errs[0], "cannot unpack 2 values, expected I64")
+}
@@ -135,10 +135,21 @@ func (checker *typeChecker) bindImmediateToType(
 			// TODO range check
 			imm.BindedType = floatType
 		}
+	case *ast.ZeroImmediate:
+		// NOTE: invalid usages are reported by the caller.
+		if imm.BindedType == nil && ast.IsAggregateType(realType) {
+			imm.BindedType = realType
+		}
 	}
 }
 
 func (checker *typeChecker) Process(entry ast.SourceEntry) {
+	dataDef, ok := entry.(*ast.DataDefinition)
+	if ok {
+		checker.checkDataDefinition(dataDef)
+		return
+	}
+
 	funcDef, ok := entry.(*ast.FunctionDefinition)
 	if !ok {
 		return
@@ -171,11 +182,29 @@ func (checker *typeChecker) Process(entry ast.SourceEntry) {
 			dest := inst.Destination()
 			if dest != nil {
 				checker.processDestination(dest, evalType)
-				if !ast.IsErrorType(dest.Type) {
+
+				// Note: load's address source, extract's struct source,
+				// getelem / setelem's index source, and comparison's sources do not
+				// share the destination's type.
+				shareType := true
+				switch op := inst.(type) {
+				case *ast.LoadOperation,
+					*ast.ExtractOperation,
+					*ast.GetElementOperation,
+					*ast.SetElementOperation:
+					shareType = false
+				case *ast.BinaryOperation:
+					shareType = !op.Kind.IsComparison()
+				}
+
+				if !ast.IsErrorType(dest.Type) && shareType {
 					for _, src := range inst.Sources() {
 						// Backfill copy/non-conversion unary/binary operation immediate
-						// sources' type.
-						checker.bindImmediateToType(src, dest.Type)
+						// sources' type.  Mismatched sources are reported by
+						// processDestination.
+						if src.Type().IsSubTypeOf(dest.Type) {
+							checker.bindImmediateToType(src, dest.Type)
+						}
 					}
 				}
 				checker.checkRedefinition(dest)
@@ -184,6 +213,27 @@ func (checker *typeChecker) Process(entry ast.SourceEntry) {
 	}
 }
 
+func (checker *typeChecker) checkDataDefinition(def *ast.DataDefinition) {
+	for _, value := range def.Values {
+		data, ok := value.(*ast.ImmediateData)
+		if !ok { // string data's element type is validated by the ast
+			continue
+		}
+
+		valueType := data.Value.Type()
+		if !valueType.IsSubTypeOf(def.ElementType) {
+			checker.Emit(
+				data.Loc(),
+				"cannot use %s value as %s data",
+				valueType,
+				def.ElementType)
+			continue
+		}
+
+		checker.bindImmediateToType(data.Value, def.ElementType)
+	}
+}
+
 func (checker *typeChecker) evaluateInstruction(
 	in ast.Instruction,
 ) ast.Type {
@@ -194,6 +244,21 @@ func (checker *typeChecker) evaluateInstruction(
 		return checker.evaluateUnaryOperation(inst)
 	case *ast.BinaryOperation:
 		return checker.evaluateBinaryOperation(inst)
+	case *ast.LoadOperation:
+		return checker.evaluateLoadOperation(inst)
+	case *ast.StoreOperation:
+		checker.evaluateStoreOperation(inst)
+		return nil
+	case *ast.ExtractOperation:
+		return checker.evaluateExtractOperation(inst)
+	case *ast.InsertOperation:
+		return checker.evaluateInsertOperation(inst)
+	case *ast.GetElementOperation:
+		return checker.evaluateGetElementOperation(inst)
+	case *ast.SetElementOperation:
+		return checker.evaluateSetElementOperation(inst)
+	case *ast.SelectOperation:
+		return checker.evaluateSelectOperation(inst)
 	case *ast.FuncCall:
 		switch inst.Kind {
 		case ast.SysCall:
@@ -208,6 +273,12 @@ func (checker *typeChecker) evaluateInstruction(
 	case *ast.ConditionalJump:
 		checker.evaluateConditionalJump(inst)
 		return nil
+	case *ast.Switch:
+		checker.evaluateSwitch(inst)
+		return nil
+	case *ast.TailCall:
+		checker.evaluateTailCall(inst)
+		return nil
 	case *ast.Terminal:
 		checker.evaluateTerminal(inst)
 		return nil
@@ -235,45 +306,48 @@ func (checker *typeChecker) evaluateUnaryOperation(
 		}
 	}
 
+	// Bool converts to 0 / 1 int values.
+	isIntConvertible := ast.IsNumberSubType(opType) || ast.IsBoolType(opType)
+
 	switch inst.Kind {
 	case ast.Neg:
 		if ast.IsSignedIntSubType(opType) {
 			return opType
 		}
 	case ast.Not:
-		if ast.IsIntSubType(opType) {
+		if ast.IsIntSubType(opType) || ast.IsBoolType(opType) {
 			return opType
 		}
 	case ast.ToI8:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewI8(inst.StartEnd())
 		}
 	case ast.ToI16:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewI16(inst.StartEnd())
 		}
 	case ast.ToI32:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewI32(inst.StartEnd())
 		}
 	case ast.ToI64:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewI64(inst.StartEnd())
 		}
 	case ast.ToU8:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewU8(inst.StartEnd())
 		}
 	case ast.ToU16:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewU16(inst.StartEnd())
 		}
 	case ast.ToU32:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewU32(inst.StartEnd())
 		}
 	case ast.ToU64:
-		if ast.IsNumberSubType(opType) {
+		if isIntConvertible {
 			return ast.NewU64(inst.StartEnd())
 		}
 	case ast.ToF32:
@@ -309,6 +383,14 @@ func (checker *typeChecker) evaluateBinaryOperation(
 		return type2
 	}
 
+	if inst.Kind.IsComparison() {
+		isOrdered := inst.Kind != ast.Eq && inst.Kind != ast.Ne
+		if !checker.checkComparison(inst, inst.Src1, inst.Src2, isOrdered) {
+			return ast.NewErrorType(type1.StartEnd())
+		}
+		return ast.NewBoolType(inst.StartEnd())
+	}
+
 	var opType ast.Type
 	allowFloat := false
 	switch inst.Kind {
@@ -356,6 +438,13 @@ func (checker *typeChecker) evaluateBinaryOperation(
 		return opType
 	}
 
+	if ast.IsBoolType(opType) {
+		switch inst.Kind {
+		case ast.Xor, ast.Or, ast.And:
+			return opType
+		}
+	}
+
 	checker.Emit(
 		opType.Loc(),
 		"cannot use type %s on binary operation (%s)",
@@ -364,6 +453,283 @@ func (checker *typeChecker) evaluateBinaryOperation(
 	return ast.NewErrorType(opType.StartEnd())
 }
 
+func (checker *typeChecker) evaluateLoadOperation(
+	inst *ast.LoadOperation,
+) ast.Type {
+	addressType := inst.Address.Type()
+	if ast.IsErrorType(addressType) {
+		return addressType
+	}
+
+	ptrType, ok := addressType.(*ast.PointerType)
+	if !ok {
+		checker.Emit(
+			inst.Address.Loc(),
+			"cannot load from non-pointer type %s",
+			addressType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	if ast.IsAggregateType(ptrType.ElementType) {
+		checker.Emit(
+			inst.Address.Loc(),
+			"cannot load aggregate value from %s address",
+			addressType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	return ptrType.ElementType
+}
+
+func (checker *typeChecker) evaluateStoreOperation(
+	inst *ast.StoreOperation,
+) {
+	addressType := inst.Address.Type()
+	srcType := inst.Src.Type()
+	if ast.IsErrorType(addressType) || ast.IsErrorType(srcType) {
+		// Source dependencies have type check error
+		return
+	}
+
+	ptrType, ok := addressType.(*ast.PointerType)
+	if !ok {
+		checker.Emit(
+			inst.Address.Loc(),
+			"cannot store to non-pointer type %s",
+			addressType)
+		return
+	}
+
+	if ast.IsAggregateType(ptrType.ElementType) {
+		checker.Emit(
+			inst.Address.Loc(),
+			"cannot store aggregate value to %s address",
+			addressType)
+		return
+	}
+
+	if !srcType.IsSubTypeOf(ptrType.ElementType) {
+		checker.Emit(
+			inst.Src.Loc(),
+			"cannot store %s value to %s address",
+			srcType,
+			addressType)
+		return
+	}
+
+	checker.bindImmediateToType(inst.Src, ptrType.ElementType)
+}
+
+// Returns the source's struct type and the named field, or nil if the source
+// is not a struct or the field does not exist (error emitted).
+func (checker *typeChecker) lookUpField(
+	src ast.Value,
+	fieldName string,
+) (
+	*ast.StructType,
+	*ast.StructField,
+) {
+	srcType := src.Type()
+	if ast.IsErrorType(srcType) {
+		return nil, nil
+	}
+
+	structType, ok := srcType.(*ast.StructType)
+	if !ok {
+		checker.Emit(
+			src.Loc(),
+			"cannot access field (%s) of non-struct type %s",
+			fieldName,
+			srcType)
+		return nil, nil
+	}
+
+	idx := structType.FieldIndex(fieldName)
+	if idx < 0 {
+		checker.Emit(
+			src.Loc(),
+			"struct type %s has no field (%s)",
+			structType,
+			fieldName)
+		return nil, nil
+	}
+
+	return structType, structType.Fields[idx]
+}
+
+func (checker *typeChecker) evaluateExtractOperation(
+	inst *ast.ExtractOperation,
+) ast.Type {
+	_, field := checker.lookUpField(inst.Src, inst.Field)
+	if field == nil {
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	return field.Type
+}
+
+func (checker *typeChecker) evaluateInsertOperation(
+	inst *ast.InsertOperation,
+) ast.Type {
+	// Allow zero struct source, e.g., %s struct{a I32} = insert zero, a, 1
+	if inst.Dest.Type != nil {
+		checker.bindImmediateToType(inst.Src, inst.Dest.Type)
+	}
+
+	structType, field := checker.lookUpField(inst.Src, inst.Field)
+	if field == nil {
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	valueType := inst.Value.Type()
+	if ast.IsErrorType(valueType) {
+		return valueType
+	}
+
+	if !valueType.IsSubTypeOf(field.Type) {
+		checker.Emit(
+			inst.Value.Loc(),
+			"cannot insert %s value into %s field (%s)",
+			valueType,
+			field.Type,
+			field.Name)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	checker.bindImmediateToType(inst.Value, field.Type)
+	return structType
+}
+
+// Returns the source's array type, or nil if the source is not an array or
+// the index is invalid (error emitted).  Constant indices are bounds checked.
+func (checker *typeChecker) checkArrayAccess(
+	src ast.Value,
+	index ast.Value,
+) *ast.ArrayType {
+	srcType := src.Type()
+	indexType := index.Type()
+	if ast.IsErrorType(srcType) || ast.IsErrorType(indexType) {
+		return nil
+	}
+
+	arrayType, ok := srcType.(*ast.ArrayType)
+	if !ok {
+		checker.Emit(src.Loc(), "cannot index non-array type %s", srcType)
+		return nil
+	}
+
+	if !ast.IsIntSubType(indexType) {
+		checker.Emit(
+			index.Loc(),
+			"cannot use %s value as array index",
+			indexType)
+		return nil
+	}
+
+	imm, ok := index.(*ast.IntImmediate)
+	if ok {
+		if imm.IsNegative || imm.Value >= uint64(arrayType.Length) {
+			checker.Emit(
+				index.Loc(),
+				"array index (%s) out of range [0, %d)",
+				imm,
+				arrayType.Length)
+			return nil
+		}
+
+		checker.bindImmediateToType(index, ast.NewU64(index.StartEnd()))
+	}
+
+	return arrayType
+}
+
+func (checker *typeChecker) evaluateGetElementOperation(
+	inst *ast.GetElementOperation,
+) ast.Type {
+	arrayType := checker.checkArrayAccess(inst.Src, inst.Index)
+	if arrayType == nil {
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	return arrayType.ElementType
+}
+
+func (checker *typeChecker) evaluateSetElementOperation(
+	inst *ast.SetElementOperation,
+) ast.Type {
+	// Allow zero array source, e.g., %a [4]I32 = setelem zero, 0, 1
+	if inst.Dest.Type != nil {
+		checker.bindImmediateToType(inst.Src, inst.Dest.Type)
+	}
+
+	arrayType := checker.checkArrayAccess(inst.Src, inst.Index)
+	if arrayType == nil {
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	valueType := inst.Value.Type()
+	if ast.IsErrorType(valueType) {
+		return valueType
+	}
+
+	if !valueType.IsSubTypeOf(arrayType.ElementType) {
+		checker.Emit(
+			inst.Value.Loc(),
+			"cannot set %s value as %s element",
+			valueType,
+			arrayType.ElementType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	checker.bindImmediateToType(inst.Value, arrayType.ElementType)
+	return arrayType
+}
+
+func (checker *typeChecker) evaluateSelectOperation(
+	inst *ast.SelectOperation,
+) ast.Type {
+	condType := inst.Cond.Type()
+	trueType := inst.TrueValue.Type()
+	falseType := inst.FalseValue.Type()
+	if ast.IsErrorType(condType) ||
+		ast.IsErrorType(trueType) ||
+		ast.IsErrorType(falseType) {
+
+		// Source dependencies have type check error
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	if !ast.IsBoolType(condType) {
+		checker.Emit(
+			inst.Cond.Loc(),
+			"select condition must be a Bool, found %s",
+			condType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	var selType ast.Type
+	if trueType.IsSubTypeOf(falseType) {
+		selType = falseType
+	} else if falseType.IsSubTypeOf(trueType) {
+		selType = trueType
+	} else {
+		checker.Emit(
+			inst.Loc(),
+			"select cannot operate on different types: %s vs %s",
+			trueType,
+			falseType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	_, isZero := selType.(*ast.ZeroLiteralType)
+	if isZero || ast.IsAggregateType(selType) {
+		checker.Emit(inst.Loc(), "cannot select aggregate %s value", selType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	return selType
+}
+
 func (checker *typeChecker) evaluateSysCall(
 	inst *ast.FuncCall,
 ) ast.Type {
@@ -415,7 +781,31 @@ func (checker *typeChecker) evaluateSysCall(
 func (checker *typeChecker) evaluateCall(
 	inst *ast.FuncCall,
 ) ast.Type {
-	fType := inst.Func.Type()
+	retType := checker.evaluateCallee(inst, inst.Func, inst.Args)
+	if inst.NumTupleDests == 0 || ast.IsErrorType(retType) {
+		return retType
+	}
+
+	if ast.TupleSize(retType) != inst.NumTupleDests {
+		checker.Emit(
+			inst.Loc(),
+			"cannot unpack %d values, expected %s",
+			inst.NumTupleDests,
+			retType)
+		return ast.NewErrorType(inst.StartEnd())
+	}
+
+	return retType
+}
+
+// Checks the arguments against the callee's function type.  Returns the
+// callee's return type, or an error type on error.
+func (checker *typeChecker) evaluateCallee(
+	inst ast.Instruction,
+	funcValue ast.Value,
+	args []ast.Value,
+) ast.Type {
+	fType := funcValue.Type()
 	if ast.IsErrorType(fType) {
 		return fType
 	}
@@ -429,7 +819,7 @@ func (checker *typeChecker) evaluateCall(
 		return ast.NewErrorType(inst.StartEnd())
 	}
 
-	if len(funcType.ParameterTypes) != len(inst.Args) {
+	if len(funcType.ParameterTypes) != len(args) {
 		checker.Emit(
 			inst.Loc(),
 			"invalid number of arguments pass to %s",
@@ -439,7 +829,7 @@ func (checker *typeChecker) evaluateCall(
 
 	foundError := false
 	for idx, paramType := range funcType.ParameterTypes {
-		arg := inst.Args[idx]
+		arg := args[idx]
 		argType := arg.Type()
 		if ast.IsErrorType(argType) {
 			foundError = true
@@ -465,14 +855,19 @@ func (checker *typeChecker) evaluateCall(
 	return funcType.ReturnType
 }
 
-func (checker *typeChecker) evaluateConditionalJump(
-	inst *ast.ConditionalJump,
-) {
-	type1 := inst.Src1.Type()
-	type2 := inst.Src2.Type()
+// Checks the comparison's sources have a common comparable (or ordered) type,
+// and binds immediate sources to the common type.  Returns false on error.
+func (checker *typeChecker) checkComparison(
+	inst ast.Instruction,
+	src1 ast.Value,
+	src2 ast.Value,
+	isOrdered bool,
+) bool {
+	type1 := src1.Type()
+	type2 := src2.Type()
 	if ast.IsErrorType(type1) || ast.IsErrorType(type2) {
 		// Source dependencies have type check error
-		return
+		return false
 	}
 
 	var cmpType ast.Type
@@ -483,36 +878,108 @@ func (checker *typeChecker) evaluateConditionalJump(
 	} else {
 		checker.Emit(
 			inst.Loc(),
-			"conditional jump cannot operate on different types: %s vs %s",
+			"comparison cannot operate on different types: %s vs %s",
 			type1,
 			type2)
-		return
+		return false
 	}
 
-	switch inst.Kind {
-	case ast.Jeq, ast.Jne:
-		if !ast.IsComparableType(cmpType) {
-			checker.Emit(
-				inst.Loc(),
-				"source type %s is not comparable",
-				cmpType)
-		}
-	case ast.Jlt, ast.Jge:
+	ok := true
+	if isOrdered {
 		if !ast.IsOrderedType(cmpType) {
 			checker.Emit(
 				inst.Loc(),
 				"source type %s is not ordered",
 				cmpType)
+			ok = false
 		}
+	} else if !ast.IsComparableType(cmpType) {
+		checker.Emit(
+			inst.Loc(),
+			"source type %s is not comparable",
+			cmpType)
+		ok = false
+	}
+
+	cmpType = checker.convertImmediateType(cmpType)
+	checker.bindImmediateToType(src1, cmpType)
+	checker.bindImmediateToType(src2, cmpType)
+
+	return ok
+}
+
+func (checker *typeChecker) evaluateConditionalJump(
+	inst *ast.ConditionalJump,
+) {
+	switch inst.Kind {
+	case ast.Jeq, ast.Jne:
+		checker.checkComparison(inst, inst.Src1, inst.Src2, false)
+	case ast.Jlt, ast.Jge:
+		checker.checkComparison(inst, inst.Src1, inst.Src2, true)
 	default:
 		panic(fmt.Sprintf("unhandled conditional jump kind (%s)", inst.Kind))
 	}
+}
 
-	cmpType = checker.convertImmediateType(cmpType)
-	checker.bindImmediateToType(inst.Src1, cmpType)
-	checker.bindImmediateToType(inst.Src2, cmpType)
+func (checker *typeChecker) evaluateSwitch(inst *ast.Switch) {
+	srcType := inst.Src.Type()
+	if ast.IsErrorType(srcType) {
+		return
+	}
 
-	return
+	if !ast.IsIntSubType(srcType) {
+		checker.Emit(
+			inst.Loc(),
+			"switch source must be an int type, found %s",
+			srcType)
+		return
+	}
+
+	srcType = checker.convertImmediateType(srcType)
+	checker.bindImmediateToType(inst.Src, srcType)
+
+	for _, switchCase := range inst.Cases {
+		caseType := switchCase.Value.Type()
+		if !caseType.IsSubTypeOf(srcType) {
+			checker.Emit(
+				switchCase.Loc(),
+				"invalid switch case value type %s, expected %s",
+				caseType,
+				srcType)
+			continue
+		}
+
+		checker.bindImmediateToType(switchCase.Value, srcType)
+	}
+}
+
+func (checker *typeChecker) evaluateTailCall(inst *ast.TailCall) {
+	retType := checker.evaluateCallee(inst, inst.Func, inst.Args)
+	if ast.IsErrorType(retType) {
+		return
+	}
+
+	funcDef := inst.ParentBlock().ParentFuncDef
+	if !retType.IsSubTypeOf(funcDef.ReturnType) {
+		checker.Emit(
+			inst.Loc(),
+			"invalid tail call return value type %s, expected %s",
+			retType,
+			funcDef.ReturnType)
+		return
+	}
+
+	calleeType := inst.Func.Type().(*ast.FunctionType)
+	callee := checker.platform.CallConvention(calleeType)
+	_, isDirect := inst.Func.(*ast.GlobalLabelReference)
+	convention := checker.platform.CallConvention(funcDef.FuncType)
+	if !convention.CanTailCall(callee, isDirect) {
+		checker.Emit(
+			inst.Loc(),
+			"cannot tail call %s from %s call convention function",
+			calleeType,
+			funcDef.FuncType.CallConventionName)
+	}
 }
 
 func (checker *typeChecker) evaluateTerminal(
@@ -584,6 +1051,13 @@ func (checker *typeChecker) processDestination(
 					"destination (%s) must be explicitly typed",
 				dest.Name)
 			dest.Type = ast.NewErrorType(evalType.StartEnd())
+		case *ast.ZeroLiteralType:
+			checker.Emit(
+				dest.Loc(),
+				"cannot infer register type from zero immediate, "+
+					"destination (%s) must be explicitly typed",
+				dest.Name)
+			dest.Type = ast.NewErrorType(evalType.StartEnd())
 		default: // including ast.ErrorType
 			dest.Type = evalType
 		}
@@ -615,6 +1089,8 @@ func (checker *typeChecker) checkRedefinition(
 		panic(fmt.Sprintf("should never happen (%s)", dest.Loc()))
 	case *ast.FloatLiteralType:
 		panic(fmt.Sprintf("should never happen (%s)", dest.Loc()))
+	case *ast.ZeroLiteralType:
+		panic(fmt.Sprintf("should never happen (%s)", dest.Loc()))
 	case *ast.ErrorType:
 		return
 	}
@@ -0,0 +1,172 @@
+package util
+
+import (
+	"github.com/pattyshack/chickadee/ast"
+)
+
+const (
+	// Ball and Larus's loop branch heuristic: a branch which could either
+	// stay in the loop or exit the loop stays in the loop 88% of the time.
+	loopBranchStayProbability = 0.88
+
+	// Caps the estimated number of iterations per loop entry, which would be
+	// infinite for loops without exits.
+	maxLoopCyclicProbability = 0.99
+)
+
+// Estimated execution frequency of each reachable block, per function
+// invocation.
+type BlockFrequencies map[*ast.Block]float64
+
+// Statically estimates the block execution frequencies using Wu and Larus's
+// "Static Branch Frequency and Program Profile Analysis" (simplified).
+//
+// Branch probabilities are estimated using the loop branch heuristic (see
+// loopBranchStayProbability); all other branches are assumed to be equally
+// likely.  Each loop's cyclic probability (i.e., the probability of taking a
+// back edge once the header is entered) is computed innermost loop first, and
+// the header's frequency is scaled by 1 / (1 - cyclic probability).
+//
+// TODO: use pgo statistics when available.
+func EstimateBlockFrequencies(
+	funcDef *ast.FunctionDefinition,
+	loops *LoopForest,
+) BlockFrequencies {
+	order := reversePostOrder(
+		funcDef.Blocks[0],
+		func(block *ast.Block) []*ast.Block { return block.Children })
+
+	orderIndex := make(map[*ast.Block]int, len(order))
+	for idx, block := range order {
+		orderIndex[block] = idx
+	}
+
+	estimator := &blockFrequencyEstimator{
+		loops:      loops,
+		order:      order,
+		orderIndex: orderIndex,
+		loopScales: map[*ast.Block]float64{},
+	}
+
+	return estimator.estimate()
+}
+
+type blockFrequencyEstimator struct {
+	loops *LoopForest
+
+	order      []*ast.Block // reverse post order
+	orderIndex map[*ast.Block]int
+
+	// 1 / (1 - cyclic probability), per loop header.
+	loopScales map[*ast.Block]float64
+}
+
+func (estimator *blockFrequencyEstimator) estimate() BlockFrequencies {
+	// Inner loops are processed before outer loops.
+	for idx := len(estimator.loops.Loops) - 1; idx >= 0; idx-- {
+		loop := estimator.loops.Loops[idx]
+
+		frequencies := estimator.propagate(loop.Header, loop.Contains)
+
+		cyclicProbability := 0.0
+		for _, edge := range loop.BackEdges {
+			cyclicProbability += frequencies[edge.Parent] *
+				estimator.edgeProbability(edge.Parent, edge.Child)
+		}
+
+		if cyclicProbability > maxLoopCyclicProbability {
+			cyclicProbability = maxLoopCyclicProbability
+		}
+
+		estimator.loopScales[loop.Header] = 1 / (1 - cyclicProbability)
+	}
+
+	return estimator.propagate(
+		estimator.order[0],
+		func(*ast.Block) bool { return true })
+}
+
+// Propagates frequencies along forward edges (back edges are ignored),
+// starting from the head block with frequency one.  Loop headers'
+// frequencies are scaled by their loop scales.  Note that while computing a
+// loop's cyclic probability, the loop's own scale is not yet known.
+func (estimator *blockFrequencyEstimator) propagate(
+	head *ast.Block,
+	contains func(*ast.Block) bool,
+) BlockFrequencies {
+	frequencies := BlockFrequencies{}
+	for _, block := range estimator.order[estimator.orderIndex[head]:] {
+		if !contains(block) {
+			continue
+		}
+
+		frequency := 0.0
+		if block == head {
+			frequency = 1
+		} else {
+			for _, parent := range block.Parents {
+				parentFrequency, ok := frequencies[parent]
+				if !ok ||
+					estimator.orderIndex[parent] >= estimator.orderIndex[block] {
+
+					continue
+				}
+
+				frequency += parentFrequency *
+					estimator.edgeProbability(parent, block)
+			}
+		}
+
+		scale, ok := estimator.loopScales[block]
+		if ok {
+			frequency *= scale
+		}
+
+		frequencies[block] = frequency
+	}
+
+	return frequencies
+}
+
+// Returns the probability of taking the edge from parent to child.  Note that
+// a parent could have multiple edges to the same child (e.g., switch cases).
+func (estimator *blockFrequencyEstimator) edgeProbability(
+	parent *ast.Block,
+	child *ast.Block,
+) float64 {
+	numEdges := 0
+	numExitEdges := 0
+	numChildEdges := 0
+	isExitEdge := false
+
+	loop := estimator.loops.BlockLoops[parent]
+	for _, other := range parent.Children {
+		numEdges++
+
+		otherIsExit := loop != nil && loop.IsExitEdge(parent, other)
+		if otherIsExit {
+			numExitEdges++
+		}
+
+		if other == child {
+			numChildEdges++
+			isExitEdge = otherIsExit
+		}
+	}
+
+	if numChildEdges == 0 {
+		return 0
+	}
+
+	if numExitEdges == 0 || numExitEdges == numEdges {
+		return float64(numChildEdges) / float64(numEdges)
+	}
+
+	if isExitEdge {
+		return (1 - loopBranchStayProbability) *
+			float64(numChildEdges) / float64(numExitEdges)
+	}
+
+	return loopBranchStayProbability *
+		float64(numChildEdges) / float64(numEdges-numExitEdges)
+}
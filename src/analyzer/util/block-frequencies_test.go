@@ -0,0 +1,111 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+func estimateFrequencies(funcDef *ast.FunctionDefinition) BlockFrequencies {
+	return EstimateBlockFrequencies(
+		funcDef,
+		NewLoopForest(funcDef, NewDominatorTree(funcDef)))
+}
+
+func expectFrequencies(
+	t *testing.T,
+	funcDef *ast.FunctionDefinition,
+	expected map[string]float64,
+	actual BlockFrequencies,
+) {
+	expect.Equal(t, len(expected), len(actual))
+
+	blocks := testBlocks(funcDef)
+	for label, frequency := range expected {
+		block := blocks[label]
+		_, ok := actual[block]
+		expect.True(t, ok, "%s has no frequency", label)
+		expect.True(
+			t,
+			math.Abs(actual[block]-frequency) < 1e-9,
+			"%s: expected %v, actual %v",
+			label,
+			frequency,
+			actual[block])
+	}
+}
+
+func TestNestedLoopsFrequencies(t *testing.T) {
+	funcDef := newNestedLoopsFunction()
+
+	// Each loop stays in the loop 88% of the time, hence each loop header is
+	// scaled by 1 / (1 - 0.88) = 8.33.  The inner loop is entered 88% of the
+	// time from the outer header.
+	scale := 1 / (1 - loopBranchStayProbability)
+	outer := scale
+	inner := outer * loopBranchStayProbability * scale
+	expectFrequencies(
+		t,
+		funcDef,
+		map[string]float64{
+			"entry": 1,
+			"outer": outer, // ~8.33
+			"inner": inner, // ~61.1
+			"latch": inner * (1 - loopBranchStayProbability),
+			"exit":  1,
+		},
+		estimateFrequencies(funcDef))
+}
+
+func TestMultipleExitsFrequencies(t *testing.T) {
+	funcDef := newMultipleExitsFunction()
+
+	// Unreachable blocks have no frequency.
+	expectFrequencies(
+		t,
+		funcDef,
+		map[string]float64{
+			"entry": 1,
+			"a":     0.5,
+			"b":     0.5,
+			"c":     0.25,
+			"d":     0.25,
+		},
+		estimateFrequencies(funcDef))
+}
+
+func TestIrreducibleFrequencies(t *testing.T) {
+	funcDef := newIrreducibleFunction()
+
+	// The irreducible cycle is not a natural loop, hence not scaled.  The
+	// cycle's retreating edge (x -> y in reverse post order) is ignored.
+	expectFrequencies(
+		t,
+		funcDef,
+		map[string]float64{
+			"entry": 1,
+			"x":     1,
+			"y":     0.5,
+			"exit":  0.5,
+		},
+		estimateFrequencies(funcDef))
+}
+
+func TestInfiniteLoopFrequencies(t *testing.T) {
+	funcDef := newTestFunction(
+		testBlock{"entry", []string{"loop"}},
+		testBlock{"loop", []string{"loop"}})
+
+	// The loop without exit is capped by maxLoopCyclicProbability.
+	expectFrequencies(
+		t,
+		funcDef,
+		map[string]float64{
+			"entry": 1,
+			"loop":  1 / (1 - maxLoopCyclicProbability),
+		},
+		estimateFrequencies(funcDef))
+}
@@ -0,0 +1,304 @@
+package util
+
+import (
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Dominator (or post dominator) tree of a function definition's control flow
+// graph, computed using Cooper, Harvey and Kennedy's "A Simple, Fast
+// Dominance Algorithm".
+//
+// Blocks unreachable from the root are not part of the tree.
+type DominatorTree struct {
+	// The function's entry block for the dominator tree, or a virtual exit
+	// block for the post dominator tree.
+	Root *ast.Block
+
+	// The root block has no immediate dominator.
+	ImmediateDominators map[*ast.Block]*ast.Block
+
+	// Children are in reverse post order.
+	Children map[*ast.Block][]*ast.Block
+
+	// Dominator tree pre / post order numbering, used for dominance queries.
+	preOrder  map[*ast.Block]int
+	postOrder map[*ast.Block]int
+
+	// Control flow graph predecessors (children for the post dominator tree).
+	predecessors func(*ast.Block) []*ast.Block
+}
+
+func NewDominatorTree(funcDef *ast.FunctionDefinition) *DominatorTree {
+	return newDominatorTree(
+		funcDef.Blocks[0],
+		func(block *ast.Block) []*ast.Block { return block.Parents },
+		func(block *ast.Block) []*ast.Block { return block.Children })
+}
+
+// The post dominator tree is the dominator tree of the reversed control flow
+// graph.  Since a function could have multiple exit blocks (i.e., blocks
+// without children), the tree is rooted at a virtual exit block, which is not
+// part of the function definition.  The virtual exit block is the only parent
+// of every exit block in the reversed graph.
+//
+// NOTE: Blocks which cannot reach any exit block (i.e., infinite loops) are
+// not part of the tree.
+func NewPostDominatorTree(funcDef *ast.FunctionDefinition) *DominatorTree {
+	exit := &ast.Block{
+		StartEndPos:   funcDef.StartEndPos,
+		Label:         ":virtual-exit",
+		ParentFuncDef: funcDef,
+	}
+
+	for _, block := range funcDef.Blocks {
+		if len(block.Children) == 0 {
+			exit.Parents = append(exit.Parents, block)
+		}
+	}
+
+	return newDominatorTree(
+		exit,
+		func(block *ast.Block) []*ast.Block {
+			if block != exit && len(block.Children) == 0 {
+				return []*ast.Block{exit}
+			}
+			return block.Children
+		},
+		func(block *ast.Block) []*ast.Block { return block.Parents })
+}
+
+func newDominatorTree(
+	root *ast.Block,
+	predecessors func(*ast.Block) []*ast.Block,
+	successors func(*ast.Block) []*ast.Block,
+) *DominatorTree {
+	order := reversePostOrder(root, successors)
+
+	orderIndex := make(map[*ast.Block]int, len(order))
+	for idx, block := range order {
+		orderIndex[block] = idx
+	}
+
+	intersect := func(
+		idoms map[*ast.Block]*ast.Block,
+		block1 *ast.Block,
+		block2 *ast.Block,
+	) *ast.Block {
+		for block1 != block2 {
+			for orderIndex[block1] > orderIndex[block2] {
+				block1 = idoms[block1]
+			}
+			for orderIndex[block2] > orderIndex[block1] {
+				block2 = idoms[block2]
+			}
+		}
+		return block1
+	}
+
+	// NOTE: the root temporarily dominates itself to simplify intersection.
+	idoms := map[*ast.Block]*ast.Block{root: root}
+	modified := true
+	for modified {
+		modified = false
+		for _, block := range order[1:] {
+			var idom *ast.Block
+			for _, pred := range predecessors(block) {
+				_, ok := idoms[pred] // skip unprocessed / unreachable predecessors
+				if !ok {
+					continue
+				}
+
+				if idom == nil {
+					idom = pred
+				} else {
+					idom = intersect(idoms, pred, idom)
+				}
+			}
+
+			if idoms[block] != idom {
+				idoms[block] = idom
+				modified = true
+			}
+		}
+	}
+	delete(idoms, root)
+
+	children := make(map[*ast.Block][]*ast.Block, len(order))
+	for _, block := range order[1:] {
+		idom := idoms[block]
+		children[idom] = append(children[idom], block)
+	}
+
+	tree := &DominatorTree{
+		Root:                root,
+		ImmediateDominators: idoms,
+		Children:            children,
+		preOrder:            make(map[*ast.Block]int, len(order)),
+		postOrder:           make(map[*ast.Block]int, len(order)),
+		predecessors:        predecessors,
+	}
+	tree.number(root)
+
+	return tree
+}
+
+func (tree *DominatorTree) number(root *ast.Block) {
+	type entry struct {
+		block   *ast.Block
+		visited bool
+	}
+
+	stack := []entry{{block: root}}
+	for len(stack) > 0 {
+		idx := len(stack) - 1
+		top := stack[idx]
+		stack = stack[:idx]
+
+		if top.visited {
+			tree.postOrder[top.block] = len(tree.postOrder)
+			continue
+		}
+
+		tree.preOrder[top.block] = len(tree.preOrder)
+		stack = append(stack, entry{block: top.block, visited: true})
+
+		children := tree.Children[top.block]
+		for idx := len(children) - 1; idx >= 0; idx-- {
+			stack = append(stack, entry{block: children[idx]})
+		}
+	}
+}
+
+// Returns true if the block is reachable (i.e., is part of the tree).
+func (tree *DominatorTree) Contains(block *ast.Block) bool {
+	_, ok := tree.preOrder[block]
+	return ok
+}
+
+// Returns true if every path from the root to the block passes through the
+// dominator (for the post dominator tree, every path from the block to the
+// exit passes through the post dominator).  Note that a block dominates
+// itself.
+func (tree *DominatorTree) Dominates(
+	dominator *ast.Block,
+	block *ast.Block,
+) bool {
+	dominatorPre, ok := tree.preOrder[dominator]
+	if !ok {
+		return false
+	}
+
+	blockPre, ok := tree.preOrder[block]
+	if !ok {
+		return false
+	}
+
+	return dominatorPre <= blockPre &&
+		tree.postOrder[block] <= tree.postOrder[dominator]
+}
+
+func (tree *DominatorTree) StrictlyDominates(
+	dominator *ast.Block,
+	block *ast.Block,
+) bool {
+	return dominator != block && tree.Dominates(dominator, block)
+}
+
+// Returns the blocks in dominator tree pre order, i.e., a block is always
+// visited before all the blocks it dominates.
+func (tree *DominatorTree) PreOrder() []*ast.Block {
+	order := make([]*ast.Block, len(tree.preOrder))
+	for block, idx := range tree.preOrder {
+		order[idx] = block
+	}
+	return order
+}
+
+// Returns the dominance frontier of each block in the tree, i.e., the set of
+// blocks where the block's dominance ends.  A block's frontier includes every
+// block which is not strictly dominated by the block, but has a predecessor
+// dominated by the block.  Blocks with empty frontiers are omitted.
+//
+// For the post dominator tree, these are the reverse dominance frontiers,
+// i.e., the blocks which the block is control dependent on.
+func (tree *DominatorTree) DominanceFrontiers() map[*ast.Block][]*ast.Block {
+	frontiers := map[*ast.Block][]*ast.Block{}
+	added := map[*ast.Block]map[*ast.Block]struct{}{}
+
+	for _, block := range tree.PreOrder() {
+		preds := tree.predecessors(block)
+		if len(preds) < 2 {
+			continue
+		}
+
+		idom := tree.ImmediateDominators[block]
+		for _, pred := range preds {
+			if !tree.Contains(pred) {
+				continue
+			}
+
+			for runner := pred; runner != idom; {
+				set, ok := added[runner]
+				if !ok {
+					set = map[*ast.Block]struct{}{}
+					added[runner] = set
+				}
+
+				_, ok = set[block]
+				if !ok {
+					set[block] = struct{}{}
+					frontiers[runner] = append(frontiers[runner], block)
+				}
+
+				runner = tree.ImmediateDominators[runner]
+			}
+		}
+	}
+
+	return frontiers
+}
+
+func reversePostOrder(
+	root *ast.Block,
+	successors func(*ast.Block) []*ast.Block,
+) []*ast.Block {
+	type entry struct {
+		block   *ast.Block
+		visited bool
+	}
+
+	postOrder := []*ast.Block{}
+	visited := map[*ast.Block]struct{}{}
+	stack := []entry{{block: root}}
+	for len(stack) > 0 {
+		idx := len(stack) - 1
+		top := stack[idx]
+		stack = stack[:idx]
+
+		if top.visited {
+			postOrder = append(postOrder, top.block)
+			continue
+		}
+
+		_, ok := visited[top.block]
+		if ok {
+			continue
+		}
+		visited[top.block] = struct{}{}
+
+		stack = append(stack, entry{block: top.block, visited: true})
+		for _, succ := range successors(top.block) {
+			_, ok := visited[succ]
+			if !ok {
+				stack = append(stack, entry{block: succ})
+			}
+		}
+	}
+
+	order := make([]*ast.Block, 0, len(postOrder))
+	for idx := len(postOrder) - 1; idx >= 0; idx-- {
+		order = append(order, postOrder[idx])
+	}
+
+	return order
+}
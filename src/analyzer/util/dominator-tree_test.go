@@ -0,0 +1,253 @@
+package util
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+type testBlock struct {
+	label    string
+	children []string
+}
+
+// Builds a function definition whose control flow graph is specified by the
+// test blocks (in function definition order).  The first block is the entry
+// block.
+func newTestFunction(blocks ...testBlock) *ast.FunctionDefinition {
+	funcDef := &ast.FunctionDefinition{}
+	labelled := map[string]*ast.Block{}
+	for _, entry := range blocks {
+		block := &ast.Block{
+			Label:         entry.label,
+			ParentFuncDef: funcDef,
+		}
+		labelled[entry.label] = block
+		funcDef.Blocks = append(funcDef.Blocks, block)
+	}
+
+	for _, entry := range blocks {
+		parent := labelled[entry.label]
+		for _, label := range entry.children {
+			child := labelled[label]
+			parent.Children = append(parent.Children, child)
+			child.Parents = append(child.Parents, parent)
+		}
+	}
+
+	return funcDef
+}
+
+func testBlocks(funcDef *ast.FunctionDefinition) map[string]*ast.Block {
+	blocks := map[string]*ast.Block{}
+	for _, block := range funcDef.Blocks {
+		blocks[block.Label] = block
+	}
+	return blocks
+}
+
+// Returns the (immediate) dominator's label of each block in the tree.
+func idomLabels(tree *DominatorTree) map[string]string {
+	labels := map[string]string{}
+	for block, idom := range tree.ImmediateDominators {
+		labels[block.Label] = idom.Label
+	}
+	return labels
+}
+
+// Returns the sorted frontier labels of each block with non-empty frontier.
+func frontierLabels(tree *DominatorTree) map[string][]string {
+	labels := map[string][]string{}
+	for block, frontier := range tree.DominanceFrontiers() {
+		for _, other := range frontier {
+			labels[block.Label] = append(labels[block.Label], other.Label)
+		}
+		sort.Strings(labels[block.Label])
+	}
+	return labels
+}
+
+func newNestedLoopsFunction() *ast.FunctionDefinition {
+	return newTestFunction(
+		testBlock{"entry", []string{"outer"}},
+		testBlock{"outer", []string{"inner", "exit"}},
+		testBlock{"inner", []string{"inner", "latch"}},
+		testBlock{"latch", []string{"outer"}},
+		testBlock{"exit", nil})
+}
+
+func newMultipleExitsFunction() *ast.FunctionDefinition {
+	return newTestFunction(
+		testBlock{"entry", []string{"a", "b"}},
+		testBlock{"a", nil},
+		testBlock{"b", []string{"c", "d"}},
+		testBlock{"c", nil},
+		testBlock{"unreachable", []string{"d"}},
+		testBlock{"d", nil})
+}
+
+// The x / y cycle has two entries, and neither dominates the other.
+func newIrreducibleFunction() *ast.FunctionDefinition {
+	return newTestFunction(
+		testBlock{"entry", []string{"x", "y"}},
+		testBlock{"x", []string{"y", "exit"}},
+		testBlock{"y", []string{"x"}},
+		testBlock{"exit", nil})
+}
+
+func TestNestedLoopsDominators(t *testing.T) {
+	funcDef := newNestedLoopsFunction()
+	blocks := testBlocks(funcDef)
+
+	tree := NewDominatorTree(funcDef)
+	expect.Same(t, blocks["entry"], tree.Root)
+	expect.Equal(
+		t,
+		map[string]string{
+			"outer": "entry",
+			"inner": "outer",
+			"latch": "inner",
+			"exit":  "outer",
+		},
+		idomLabels(tree))
+	expect.Equal(
+		t,
+		map[string][]string{
+			"outer": {"outer"},
+			"inner": {"inner", "outer"},
+			"latch": {"outer"},
+		},
+		frontierLabels(tree))
+
+	expect.True(t, tree.Dominates(blocks["outer"], blocks["latch"]))
+	expect.True(t, tree.Dominates(blocks["inner"], blocks["inner"]))
+	expect.False(t, tree.StrictlyDominates(blocks["inner"], blocks["inner"]))
+	expect.False(t, tree.Dominates(blocks["inner"], blocks["exit"]))
+	expect.False(t, tree.Dominates(blocks["latch"], blocks["outer"]))
+
+	order := tree.PreOrder()
+	expect.Equal(t, 5, len(order))
+	expect.Same(t, blocks["entry"], order[0])
+
+	position := map[*ast.Block]int{}
+	for idx, block := range order {
+		position[block] = idx
+	}
+	for _, block := range order[1:] {
+		idom := tree.ImmediateDominators[block]
+		expect.True(t, position[idom] < position[block])
+	}
+
+	postTree := NewPostDominatorTree(funcDef)
+	expect.Equal(t, ":virtual-exit", postTree.Root.Label)
+	expect.Equal(
+		t,
+		map[string]string{
+			"entry": "outer",
+			"outer": "exit",
+			"inner": "latch",
+			"latch": "outer",
+			"exit":  ":virtual-exit",
+		},
+		idomLabels(postTree))
+
+	// i.e., the control dependences.
+	expect.Equal(
+		t,
+		map[string][]string{
+			"outer": {"outer"},
+			"inner": {"inner", "outer"},
+			"latch": {"outer"},
+		},
+		frontierLabels(postTree))
+}
+
+func TestMultipleExitsDominators(t *testing.T) {
+	funcDef := newMultipleExitsFunction()
+	blocks := testBlocks(funcDef)
+
+	tree := NewDominatorTree(funcDef)
+	expect.Equal(
+		t,
+		map[string]string{
+			"a": "entry",
+			"b": "entry",
+			"c": "b",
+			"d": "b",
+		},
+		idomLabels(tree))
+	expect.Equal(t, map[string][]string{}, frontierLabels(tree))
+
+	expect.False(t, tree.Contains(blocks["unreachable"]))
+	expect.False(t, tree.Dominates(blocks["unreachable"], blocks["d"]))
+	expect.False(t, tree.Dominates(blocks["entry"], blocks["unreachable"]))
+
+	// The unreachable block can still reach an exit block.
+	postTree := NewPostDominatorTree(funcDef)
+	expect.Equal(
+		t,
+		map[string]string{
+			"entry":       ":virtual-exit",
+			"a":           ":virtual-exit",
+			"b":           ":virtual-exit",
+			"c":           ":virtual-exit",
+			"d":           ":virtual-exit",
+			"unreachable": "d",
+		},
+		idomLabels(postTree))
+	expect.Equal(
+		t,
+		map[string][]string{
+			"a": {"entry"},
+			"b": {"entry"},
+			"c": {"b"},
+			"d": {"b"},
+		},
+		frontierLabels(postTree))
+}
+
+func TestIrreducibleDominators(t *testing.T) {
+	funcDef := newIrreducibleFunction()
+	blocks := testBlocks(funcDef)
+
+	tree := NewDominatorTree(funcDef)
+	expect.Equal(
+		t,
+		map[string]string{
+			"x":    "entry",
+			"y":    "entry",
+			"exit": "x",
+		},
+		idomLabels(tree))
+	expect.Equal(
+		t,
+		map[string][]string{
+			"x": {"y"},
+			"y": {"x"},
+		},
+		frontierLabels(tree))
+
+	expect.False(t, tree.Dominates(blocks["x"], blocks["y"]))
+	expect.False(t, tree.Dominates(blocks["y"], blocks["x"]))
+
+	postTree := NewPostDominatorTree(funcDef)
+	expect.Equal(
+		t,
+		map[string]string{
+			"entry": "x",
+			"x":     "exit",
+			"y":     "x",
+			"exit":  ":virtual-exit",
+		},
+		idomLabels(postTree))
+	expect.Equal(
+		t,
+		map[string][]string{
+			"x": {"x"},
+			"y": {"entry", "x"},
+		},
+		frontierLabels(postTree))
+}
@@ -0,0 +1,180 @@
+package util
+
+import (
+	"sort"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+type ControlFlowEdge struct {
+	Parent *ast.Block
+	Child  *ast.Block
+}
+
+// A natural loop, identified by its header.  The header dominates every block
+// in the loop, and every back edge's child is the header.  Natural loops
+// sharing the same header are merged into a single loop.
+type Loop struct {
+	Header *ast.Block
+
+	BackEdges []ControlFlowEdge
+
+	// Every block in the loop (including the header and the nested loops'
+	// blocks), in function definition order.
+	Blocks []*ast.Block
+
+	// Nil for top level loops.
+	Parent *Loop
+
+	// The immediately nested loops.
+	Children []*Loop
+
+	// Top level loops have depth one.
+	Depth int
+
+	contains map[*ast.Block]struct{}
+}
+
+func (loop *Loop) Contains(block *ast.Block) bool {
+	_, ok := loop.contains[block]
+	return ok
+}
+
+// Returns true if the edge leaves the loop.
+func (loop *Loop) IsExitEdge(parent *ast.Block, child *ast.Block) bool {
+	return loop.Contains(parent) && !loop.Contains(child)
+}
+
+// The loop nesting forest of a function definition's natural loops.
+//
+// NOTE: Cycles without a dominating header (i.e., irreducible control flow)
+// are not natural loops, and are not part of the forest.
+type LoopForest struct {
+	// Every loop in the function definition, outer loops before inner loops.
+	Loops []*Loop
+
+	// Loops which are not nested in other loops.
+	TopLevelLoops []*Loop
+
+	// The innermost loop containing each block.  Blocks outside of loops are
+	// not in the map.
+	BlockLoops map[*ast.Block]*Loop
+}
+
+func NewLoopForest(
+	funcDef *ast.FunctionDefinition,
+	dominators *DominatorTree,
+) *LoopForest {
+	loops := []*Loop{}
+	headerLoops := map[*ast.Block]*Loop{}
+	for _, block := range funcDef.Blocks {
+		if !dominators.Contains(block) {
+			continue
+		}
+
+		for _, child := range block.Children {
+			if !dominators.Dominates(child, block) {
+				continue
+			}
+
+			loop, ok := headerLoops[child]
+			if !ok {
+				loop = &Loop{
+					Header: child,
+					contains: map[*ast.Block]struct{}{
+						child: struct{}{},
+					},
+				}
+				headerLoops[child] = loop
+				loops = append(loops, loop)
+			}
+
+			loop.BackEdges = append(
+				loop.BackEdges,
+				ControlFlowEdge{
+					Parent: block,
+					Child:  child,
+				})
+
+			populateLoopBlocks(dominators, loop, block)
+		}
+	}
+
+	for _, loop := range loops {
+		for _, block := range funcDef.Blocks {
+			if loop.Contains(block) {
+				loop.Blocks = append(loop.Blocks, block)
+			}
+		}
+	}
+
+	// Natural loops with different headers are either disjoint or nested.
+	// Hence, the outer loop always has more blocks than the inner loop.
+	sort.SliceStable(
+		loops,
+		func(i int, j int) bool {
+			return len(loops[i].Blocks) > len(loops[j].Blocks)
+		})
+
+	forest := &LoopForest{
+		Loops:      loops,
+		BlockLoops: map[*ast.Block]*Loop{},
+	}
+
+	for _, loop := range loops {
+		parent, ok := forest.BlockLoops[loop.Header]
+		if ok {
+			loop.Parent = parent
+			loop.Depth = parent.Depth + 1
+			parent.Children = append(parent.Children, loop)
+		} else {
+			loop.Depth = 1
+			forest.TopLevelLoops = append(forest.TopLevelLoops, loop)
+		}
+
+		for _, block := range loop.Blocks {
+			forest.BlockLoops[block] = loop
+		}
+	}
+
+	return forest
+}
+
+// Adds every block which can reach the back edge's parent without going
+// through the header.
+func populateLoopBlocks(
+	dominators *DominatorTree,
+	loop *Loop,
+	backEdgeParent *ast.Block,
+) {
+	stack := []*ast.Block{backEdgeParent}
+	for len(stack) > 0 {
+		idx := len(stack) - 1
+		top := stack[idx]
+		stack = stack[:idx]
+
+		_, ok := loop.contains[top]
+		if ok || !dominators.Contains(top) { // skip unreachable blocks
+			continue
+		}
+		loop.contains[top] = struct{}{}
+
+		stack = append(stack, top.Parents...)
+	}
+}
+
+// Returns the block's loop nesting depth.  Blocks outside of loops have depth
+// zero.
+func (forest *LoopForest) Depth(block *ast.Block) int {
+	loop, ok := forest.BlockLoops[block]
+	if !ok {
+		return 0
+	}
+	return loop.Depth
+}
+
+// Returns true if the block is a loop header.
+func (forest *LoopForest) IsHeader(block *ast.Block) bool {
+	loop, ok := forest.BlockLoops[block]
+	return ok && loop.Header == block
+}
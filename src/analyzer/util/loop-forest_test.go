@@ -0,0 +1,102 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+func blockLabels(blocks []*ast.Block) []string {
+	labels := []string{}
+	for _, block := range blocks {
+		labels = append(labels, block.Label)
+	}
+	return labels
+}
+
+func TestNestedLoopsForest(t *testing.T) {
+	funcDef := newNestedLoopsFunction()
+	blocks := testBlocks(funcDef)
+
+	forest := NewLoopForest(funcDef, NewDominatorTree(funcDef))
+	expect.Equal(t, 2, len(forest.Loops))
+	expect.Equal(t, 1, len(forest.TopLevelLoops))
+
+	outer := forest.Loops[0]
+	expect.Same(t, outer, forest.TopLevelLoops[0])
+	expect.Same(t, blocks["outer"], outer.Header)
+	expect.Equal(
+		t,
+		[]string{"outer", "inner", "latch"},
+		blockLabels(outer.Blocks))
+	expect.Equal(
+		t,
+		[]ControlFlowEdge{{blocks["latch"], blocks["outer"]}},
+		outer.BackEdges)
+	expect.Nil(t, outer.Parent)
+	expect.Equal(t, 1, outer.Depth)
+	expect.True(t, outer.IsExitEdge(blocks["outer"], blocks["exit"]))
+	expect.False(t, outer.IsExitEdge(blocks["inner"], blocks["latch"]))
+
+	inner := forest.Loops[1]
+	expect.Equal(t, []*Loop{inner}, outer.Children)
+	expect.Same(t, blocks["inner"], inner.Header)
+	expect.Equal(t, []string{"inner"}, blockLabels(inner.Blocks))
+	expect.Equal(
+		t,
+		[]ControlFlowEdge{{blocks["inner"], blocks["inner"]}},
+		inner.BackEdges)
+	expect.Same(t, outer, inner.Parent)
+	expect.Equal(t, 2, inner.Depth)
+	expect.True(t, inner.IsExitEdge(blocks["inner"], blocks["latch"]))
+
+	expected := map[string]int{
+		"entry": 0,
+		"outer": 1,
+		"inner": 2,
+		"latch": 1,
+		"exit":  0,
+	}
+	for label, depth := range expected {
+		expect.Equal(t, depth, forest.Depth(blocks[label]), "%s", label)
+	}
+
+	expect.True(t, forest.IsHeader(blocks["outer"]))
+	expect.True(t, forest.IsHeader(blocks["inner"]))
+	expect.False(t, forest.IsHeader(blocks["latch"]))
+	expect.False(t, forest.IsHeader(blocks["entry"]))
+}
+
+func TestLoopForestWithUnreachableBlock(t *testing.T) {
+	// The unreachable block's edge to the header is not a back edge.
+	funcDef := newTestFunction(
+		testBlock{"entry", []string{"header"}},
+		testBlock{"header", []string{"header", "exit"}},
+		testBlock{"unreachable", []string{"header"}},
+		testBlock{"exit", nil})
+	blocks := testBlocks(funcDef)
+
+	forest := NewLoopForest(funcDef, NewDominatorTree(funcDef))
+	expect.Equal(t, 1, len(forest.Loops))
+
+	loop := forest.Loops[0]
+	expect.Same(t, blocks["header"], loop.Header)
+	expect.Equal(t, []string{"header"}, blockLabels(loop.Blocks))
+	expect.Equal(t, 1, len(loop.BackEdges))
+	expect.Equal(t, 0, forest.Depth(blocks["unreachable"]))
+}
+
+func TestIrreducibleLoopForest(t *testing.T) {
+	funcDef := newIrreducibleFunction()
+
+	forest := NewLoopForest(funcDef, NewDominatorTree(funcDef))
+	expect.Equal(t, 0, len(forest.Loops))
+	expect.Equal(t, 0, len(forest.TopLevelLoops))
+
+	for _, block := range funcDef.Blocks {
+		expect.Equal(t, 0, forest.Depth(block))
+		expect.False(t, forest.IsHeader(block))
+	}
+}
@@ -47,6 +47,11 @@ type LocationConstraint struct {
 	// When true, the data must be on stack
 	RequireOnStack bool
 
+	// When true (RequireOnStack must also be true), the source value is read
+	// directly from its fixed stack location rather than from a temp stack
+	// copy.  The instruction does not modify the source value.
+	ReadInPlace bool
+
 	// The value is stored in an "array" formed by a list of registers.  The list
 	// could be empty to indicate a zero-sized type (e.g., empty struct).
 	//
@@ -62,8 +67,10 @@ type LocationConstraint struct {
 }
 
 func (loc *LocationConstraint) ClobberedByInstruction() bool {
-	return loc.RequireOnStack ||
-		(loc.NumRegisters > 0 && loc.Registers[0].Clobbered)
+	if loc.RequireOnStack {
+		return !loc.ReadInPlace
+	}
+	return loc.NumRegisters > 0 && loc.Registers[0].Clobbered
 }
 
 // TODO Add option to allow register source reuse
@@ -235,6 +242,18 @@ func (constraints *InstructionConstraints) AddStackSource(
 	constraints.Sources = append(constraints.Sources, loc)
 }
 
+// The source value is read in place from its fixed stack location.
+func (constraints *InstructionConstraints) AddInPlaceStackSource(
+	valueType ast.Type,
+) {
+	loc := &LocationConstraint{
+		NumRegisters:   NumRegisters(valueType),
+		RequireOnStack: true,
+		ReadInPlace:    true,
+	}
+	constraints.Sources = append(constraints.Sources, loc)
+}
+
 func (constraints *InstructionConstraints) SetFramePointerRegister(
 	register *Register,
 ) {
@@ -384,3 +403,149 @@ func (con *CallConvention) CalleeSaved(registers ...*Register) {
 		con.CallConstraints.Require(false, reg)
 	}
 }
+
+// Returns the number of register sized stack chunks occupied by the call's
+// stack sources (this excludes the stack destination).
+func (con *CallConvention) NumStackSourceChunks() int {
+	total := 0
+	for _, src := range con.CallConstraints.Sources {
+		if src.RequireOnStack {
+			total += src.NumRegisters
+		}
+	}
+	return total
+}
+
+// Returns true if a function using this call convention could tail call the
+// callee.  The tail call reuses the function's caller allocated stack
+// argument area and destination, and restores the function's callee-saved
+// registers prior to jumping to the callee.  Hence,
+//  1. the callee must not have callee-saved register arguments (these must be
+//     restored by the callee rather than by the function),
+//  2. both call conventions must share the same destination location,
+//  3. the callee's stack arguments must fit in the function's stack argument
+//     area (and must occupy the entire area when the destination is on stack
+//     since the destination is located right after the arguments),
+//  4. the callee must preserve all of the function's callee-saved registers,
+//     which includes the frame pointer register, and
+//  5. the callee's register sources must not overlap with the function's
+//     callee-saved registers, and at least one caller-saved register is not
+//     used by the tail call's sources (the allocator needs a scratch
+//     register).  The func value
+//     does not occupy any register when it's encoded as part of the jump
+//     instruction (i.e., hasEncodedFuncValue is true).
+func (con *CallConvention) CanTailCall(
+	callee *CallConvention,
+	hasEncodedFuncValue bool,
+) bool {
+	if len(callee.CalleeSavedSourceIndices) > 0 {
+		return false
+	}
+
+	dest := con.CallConstraints.Destination
+	calleeDest := callee.CallConstraints.Destination
+	if dest.RequireOnStack != calleeDest.RequireOnStack ||
+		dest.NumRegisters != calleeDest.NumRegisters {
+		return false
+	}
+
+	if !dest.RequireOnStack {
+		for idx, reg := range dest.Registers {
+			if reg.Require != calleeDest.Registers[idx].Require {
+				return false
+			}
+		}
+	}
+
+	numChunks := con.NumStackSourceChunks()
+	calleeNumChunks := callee.NumStackSourceChunks()
+	if calleeNumChunks > numChunks ||
+		(dest.RequireOnStack && calleeNumChunks != numChunks) {
+		return false
+	}
+
+	if con.CallConstraints.FramePointerRegister !=
+		callee.CallConstraints.FramePointerRegister {
+		return false
+	}
+
+	for reg, clobbered := range con.CallConstraints.RequiredRegisters {
+		if clobbered {
+			continue
+		}
+
+		calleeClobbered, ok := callee.CallConstraints.RequiredRegisters[reg]
+		if !ok || calleeClobbered {
+			return false
+		}
+	}
+
+	sourceRegisters := map[*Register]struct{}{}
+	for idx, src := range callee.CallConstraints.Sources {
+		if idx == 0 && hasEncodedFuncValue {
+			continue
+		}
+
+		for _, reg := range src.Registers {
+			// The register can't hold both the source and the function's
+			// callee-saved value.
+			clobbered, ok := con.CallConstraints.RequiredRegisters[reg.Require]
+			if ok && !clobbered {
+				return false
+			}
+
+			sourceRegisters[reg.Require] = struct{}{}
+		}
+	}
+
+	for reg, clobbered := range callee.CallConstraints.RequiredRegisters {
+		_, ok := sourceRegisters[reg]
+		if clobbered && !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the tail call instruction's constraints.  The sources are the
+// callee's call sources, followed by this function's callee-saved sources
+// (i.e., the ret sources minus the return value).
+//
+// Only the sources' registers are required.  Unlike call, the instruction
+// does not clobber any register from the function's perspective since the
+// function never resumes.  The func value may be encoded as part of the jump
+// instruction.
+//
+// The frame pointer hidden argument is not set to the current frame.  The
+// function's previous frame pointer is restored instead since the current
+// frame is deallocated prior to jumping to the callee.
+//
+// Note that the stack sources are placed on the temp stack, and are copied
+// into the caller allocated stack argument area (see
+// StackFrame.StackArgumentsOffset) after all sources are in place.
+func (con *CallConvention) TailCallConstraints(
+	callee *CallConvention,
+) *InstructionConstraints {
+	constraints := NewInstructionConstraints()
+
+	funcValue := callee.CallConstraints.Sources[0]
+	constraints.AddRegisterSource(
+		true,
+		constraints.Require(false, funcValue.Registers[0].Require))
+
+	constraints.Sources = append(
+		constraints.Sources,
+		callee.CallConstraints.Sources[1:]...)
+	constraints.Sources = append(
+		constraints.Sources,
+		con.RetConstraints.Sources[1:]...)
+
+	for _, src := range constraints.Sources {
+		for _, reg := range src.Registers {
+			constraints.RequiredRegisters[reg.Require] = reg.Clobbered
+		}
+	}
+
+	return constraints
+}
@@ -33,6 +33,10 @@ func ByteSize(valType ast.Type) int {
 		panic("negative int literal type has no size")
 	case *ast.FloatLiteralType:
 		panic("float literal type has no size")
+	case *ast.ZeroLiteralType:
+		panic("zero literal type has no size")
+	case *ast.BoolType:
+		return 1
 	case *ast.SignedIntType:
 		switch valueType.Kind {
 		case ast.I8:
@@ -70,6 +74,16 @@ func ByteSize(valType ast.Type) int {
 		}
 	case *ast.FunctionType:
 		return AddressByteSize
+	case *ast.PointerType:
+		return AddressByteSize
+	case *ast.StructType:
+		size := 0
+		for _, field := range valueType.Fields {
+			size += AlignedSize(field.Type)
+		}
+		return size
+	case *ast.ArrayType:
+		return valueType.Length * ElementStride(valueType)
 	default:
 		panic("unhandled type")
 	}
@@ -83,3 +97,24 @@ func NumRegisters(valType ast.Type) int {
 func AlignedSize(valType ast.Type) int {
 	return NumRegisters(valType) * RegisterByteSize
 }
+
+// Returns the byte distance between adjacent array elements.  Every element is
+// register aligned, hence the i-th element occupies the array's
+// (i * ElementStride / RegisterByteSize)-th to
+// ((i + 1) * ElementStride / RegisterByteSize - 1)-th register sized chunks.
+func ElementStride(arrayType *ast.ArrayType) int {
+	return AlignedSize(arrayType.ElementType)
+}
+
+// Returns the struct field's byte offset relative to the start of the struct.
+// Every field is register aligned, hence the field occupies the struct's
+// (offset / RegisterByteSize)-th to
+// (offset / RegisterByteSize + NumRegisters(field type) - 1)-th register
+// sized chunks.
+func FieldOffset(structType *ast.StructType, fieldIdx int) int {
+	offset := 0
+	for _, field := range structType.Fields[:fieldIdx] {
+		offset += AlignedSize(field.Type)
+	}
+	return offset
+}
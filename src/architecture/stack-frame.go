@@ -115,7 +115,7 @@ type StackFrame struct {
 	MaxTempSize int // This respects register alignment (but not frame alignment)
 
 	// Computed by FinalizeFrame()
-	TotalFrameSize int             // This respects stack frame alignment
+	TotalFrameSize int             // TotalFrameSize + ret address is aligned
 	Layout         []*DataLocation // from bottom to top
 }
 
@@ -206,9 +206,13 @@ func (frame *StackFrame) FinalizeFrame() {
 			return cmp < 0
 		})
 
-	totalFrameSize := fixedSize + frame.MaxTempSize
+	// The stack pointer is stack frame aligned right before the call
+	// instruction pushes the return address.  Hence, the frame is padded such
+	// that the return address plus the frame is stack frame aligned, i.e., the
+	// stack pointer is stack frame aligned when this function calls others.
+	totalFrameSize := fixedSize + frame.MaxTempSize + RegisterByteSize
 	roundUp := (totalFrameSize + StackFrameAlignment - 1) / StackFrameAlignment
-	frame.TotalFrameSize = roundUp * StackFrameAlignment
+	frame.TotalFrameSize = roundUp*StackFrameAlignment - RegisterByteSize
 
 	layout := make(
 		[]*DataLocation,
@@ -233,3 +237,14 @@ func (frame *StackFrame) FinalizeFrame() {
 		currentOffset += entry.AlignedSize
 	}
 }
+
+// Returns the (finalized) offset of the caller allocated stack argument area,
+// i.e., the first stack argument's offset.  Tail calls reuse the area for the
+// callee's stack arguments, which are laid out in the same order as call's
+// stack sources (the destination remains at the same location).
+func (frame *StackFrame) StackArgumentsOffset() int {
+	if frame.Layout == nil {
+		panic("FinalizeFrame not called")
+	}
+	return frame.ReturnAddress.Offset + frame.ReturnAddress.AlignedSize
+}
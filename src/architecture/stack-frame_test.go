@@ -0,0 +1,48 @@
+package architecture
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+func TestFinalizeFrameAlignment(t *testing.T) {
+	for numLocals := 0; numLocals < 5; numLocals++ {
+		for maxTempSize := 0; maxTempSize <= 32; maxTempSize += 8 {
+			frame := NewStackFrame()
+			frame.SetDestination(ast.NewI64(parseutil.StartEndPos{}))
+			frame.StartCurrentFrame()
+			for idx := 0; idx < numLocals; idx++ {
+				frame.MaybeAddLocalVariable(
+					fmt.Sprintf("v%d", idx),
+					ast.NewU8(parseutil.StartEndPos{}))
+			}
+			frame.UpdateMaxTempSize(maxTempSize)
+
+			frame.FinalizeFrame()
+
+			// The stack pointer is stack frame aligned on entry (before the
+			// return address is pushed), and must be stack frame aligned at calls.
+			expect.Equal(
+				t,
+				0,
+				(frame.TotalFrameSize+RegisterByteSize)%StackFrameAlignment)
+
+			fixedSize := numLocals * RegisterByteSize
+			expect.True(t, frame.TotalFrameSize >= fixedSize+maxTempSize)
+			expect.True(
+				t,
+				frame.TotalFrameSize < fixedSize+maxTempSize+StackFrameAlignment)
+
+			expect.Equal(t, frame.TotalFrameSize, frame.ReturnAddress.Offset)
+			expect.Equal(
+				t,
+				frame.TotalFrameSize+RegisterByteSize,
+				frame.Destination.Offset)
+		}
+	}
+}
@@ -137,6 +137,145 @@ func (jump *ConditionalJump) String() string {
 		jump.Src2)
 }
 
+// A switch case of the form: <int immediate>: <label>
+type SwitchCase struct {
+	parseutil.StartEndPos
+
+	Value *IntImmediate
+	Label string
+}
+
+// Multi-way branch instruction of the form:
+//
+//	switch <src>, <default label>, [<value>: <label>, ...]
+//
+// The block's children are ordered by Targets().
+type Switch struct {
+	controlFlowInstruction
+
+	parseutil.StartEndPos
+
+	Src          Value
+	DefaultLabel string
+	Cases        []*SwitchCase
+}
+
+var _ Instruction = &Switch{}
+var _ Validator = &Switch{}
+
+func (inst *Switch) replaceSource(oldSrc Value, newSrc Value) {
+	if inst.Src != oldSrc {
+		panic("should never happen")
+	}
+	inst.Src = newSrc
+}
+
+func (inst *Switch) Sources() []Value {
+	return []Value{inst.Src}
+}
+
+func (Switch) Destination() *VariableDefinition {
+	return nil
+}
+
+func (inst *Switch) Walk(visitor Visitor) {
+	visitor.Enter(inst)
+	inst.Src.Walk(visitor)
+	for _, switchCase := range inst.Cases {
+		switchCase.Value.Walk(visitor)
+	}
+	visitor.Exit(inst)
+}
+
+// Returns the unique jump target labels, in case order, followed by the
+// default label (unless one of the cases also jumps to the default label).
+func (inst *Switch) Targets() []string {
+	result := make([]string, 0, len(inst.Cases)+1)
+	seen := make(map[string]struct{}, len(inst.Cases)+1)
+	add := func(label string) {
+		_, ok := seen[label]
+		if !ok {
+			seen[label] = struct{}{}
+			result = append(result, label)
+		}
+	}
+
+	for _, switchCase := range inst.Cases {
+		add(switchCase.Label)
+	}
+	add(inst.DefaultLabel)
+
+	return result
+}
+
+// Replaces the jump targets.  The labels are in the same order as Targets().
+func (inst *Switch) ReplaceTargets(labels []string) {
+	targets := inst.Targets()
+	if len(targets) != len(labels) {
+		panic("should never happen")
+	}
+
+	mapping := make(map[string]string, len(targets))
+	for idx, target := range targets {
+		mapping[target] = labels[idx]
+	}
+
+	for _, switchCase := range inst.Cases {
+		switchCase.Label = mapping[switchCase.Label]
+	}
+	inst.DefaultLabel = mapping[inst.DefaultLabel]
+}
+
+func (inst *Switch) Validate(emitter *parseutil.Emitter) {
+	if strings.HasPrefix(inst.DefaultLabel, ":") {
+		emitter.Emit(inst.Loc(), ":-prefixed label is reserved for internal use")
+	}
+
+	type caseValue struct {
+		value      uint64
+		isNegative bool
+	}
+
+	values := make(map[caseValue]struct{}, len(inst.Cases))
+	for _, switchCase := range inst.Cases {
+		if strings.HasPrefix(switchCase.Label, ":") {
+			emitter.Emit(
+				switchCase.Loc(),
+				":-prefixed label is reserved for internal use")
+		}
+
+		value := caseValue{
+			value:      switchCase.Value.Value,
+			isNegative: switchCase.Value.IsNegative && switchCase.Value.Value != 0,
+		}
+		_, ok := values[value]
+		if ok {
+			emitter.Emit(
+				switchCase.Loc(),
+				"duplicate switch case value (%s)",
+				switchCase.Value)
+			continue
+		}
+		values[value] = struct{}{}
+	}
+}
+
+func (inst *Switch) String() string {
+	cases := ""
+	for idx, switchCase := range inst.Cases {
+		if idx > 0 {
+			cases += ", "
+		}
+		cases += fmt.Sprintf("%s: :%s", switchCase.Value, switchCase.Label)
+	}
+
+	return fmt.Sprintf(
+		"switch %s, :%s, [%s]",
+		inst.Src,
+		inst.DefaultLabel,
+		cases)
+}
+
 type TerminalKind string
 
 const (
@@ -145,11 +284,12 @@ const (
 )
 
 // This returns true if the instruction is either
-//  1. a *Terminal, or
-//  2. a *FuncCall with IsExitTerminal set.
+//  1. a *Terminal,
+//  2. a *TailCall, or
+//  3. a *FuncCall with IsExitTerminal set.
 func IsTerminal(inst Instruction) bool {
-	_, ok := inst.(*Terminal)
-	if ok {
+	switch inst.(type) {
+	case *Terminal, *TailCall:
 		return true
 	}
 
@@ -174,6 +314,11 @@ type Terminal struct {
 
 	RetVal Value
 
+	// Multiple return values, e.g., ret %q, %r.  The values are packed into a
+	// tuple by insert operations.  RetVal is unset until the tuple return
+	// values are lowered.
+	TupleRetVals []Value
+
 	// Internal
 
 	// Only used by ret instruction.
@@ -215,7 +360,12 @@ func (Terminal) Destination() *VariableDefinition {
 
 func (term *Terminal) Walk(visitor Visitor) {
 	visitor.Enter(term)
-	term.RetVal.Walk(visitor)
+	if term.RetVal != nil {
+		term.RetVal.Walk(visitor)
+	}
+	for _, val := range term.TupleRetVals {
+		val.Walk(visitor)
+	}
 	for _, src := range term.CalleeSavedSources {
 		src.Walk(visitor)
 	}
@@ -224,7 +374,11 @@ func (term *Terminal) Walk(visitor Visitor) {
 
 func (term *Terminal) Validate(emitter *parseutil.Emitter) {
 	switch term.Kind {
-	case Ret, Exit: // ok
+	case Ret: // ok
+	case Exit:
+		if len(term.TupleRetVals) > 0 {
+			emitter.Emit(term.Loc(), "exit does not accept multiple values")
+		}
 	default:
 		emitter.Emit(term.Loc(), "unexpected terminate kind (%s)", term.Kind)
 	}
@@ -239,9 +393,117 @@ func (term *Terminal) String() string {
 		calleeSavedParameters += val.String()
 	}
 
+	retVal := ""
+	if term.RetVal != nil {
+		retVal = term.RetVal.String()
+	} else {
+		for idx, val := range term.TupleRetVals {
+			if idx > 0 {
+				retVal += ", "
+			}
+			retVal += val.String()
+		}
+	}
+
 	return fmt.Sprintf(
 		"%s %s [%s]",
 		term.Kind,
-		term.RetVal,
+		retVal,
+		calleeSavedParameters)
+}
+
+// Tail call instruction of the form: tailcall <func>( [srcs,]* )
+//
+// The callee's return value is returned directly to the caller's caller.  The
+// callee's return type must be compatible with the function's return type,
+// and the callee's call convention must be compatible with the function's
+// call convention (see architecture.CallConvention.CanTailCall).
+type TailCall struct {
+	controlFlowInstruction
+
+	parseutil.StartEndPos
+
+	Func Value
+	Args []Value
+
+	// Internal
+
+	// Similar to ret, callee-saved register values must be restored to their
+	// original register before jumping to the callee.
+	CalleeSavedSources []Value
+}
+
+var _ Instruction = &TailCall{}
+
+func (call *TailCall) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if call.Func == oldVal {
+		call.Func = newVal
+		replaceCount++
+	}
+
+	for idx, src := range call.Args {
+		if src == oldVal {
+			call.Args[idx] = newVal
+			replaceCount++
+		}
+	}
+
+	for idx, src := range call.CalleeSavedSources {
+		if src == oldVal {
+			call.CalleeSavedSources[idx] = newVal
+			replaceCount++
+		}
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (call *TailCall) Sources() []Value {
+	result := make([]Value, 0, 1+len(call.Args)+len(call.CalleeSavedSources))
+	result = append(result, call.Func)
+	result = append(result, call.Args...)
+	return append(result, call.CalleeSavedSources...)
+}
+
+func (TailCall) Destination() *VariableDefinition {
+	return nil
+}
+
+func (call *TailCall) Walk(visitor Visitor) {
+	visitor.Enter(call)
+	call.Func.Walk(visitor)
+	for _, src := range call.Args {
+		src.Walk(visitor)
+	}
+	for _, src := range call.CalleeSavedSources {
+		src.Walk(visitor)
+	}
+	visitor.Exit(call)
+}
+
+func (call *TailCall) String() string {
+	args := ""
+	for idx, arg := range call.Args {
+		if idx > 0 {
+			args += ", "
+		}
+		args += arg.String()
+	}
+
+	calleeSavedParameters := ""
+	for idx, val := range call.CalleeSavedSources {
+		if idx > 0 {
+			calleeSavedParameters += ", "
+		}
+		calleeSavedParameters += val.String()
+	}
+
+	return fmt.Sprintf(
+		"tailcall %s(%s) [%s]",
+		call.Func,
+		args,
 		calleeSavedParameters)
 }
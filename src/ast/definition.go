@@ -1,6 +1,8 @@
 package ast
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pattyshack/gt/parseutil"
@@ -57,7 +59,6 @@ type FunctionDefinition struct {
 
 	parseutil.StartEndPos
 
-	// TODO: add option to specify call convention via lr grammar
 	CallConventionName
 
 	Label      string
@@ -150,6 +151,200 @@ func (def *FunctionDefinition) Type() Type {
 	return def.FuncType
 }
 
+// An external function declaration (e.g., a libc function).  The function
+// is defined outside of the build (e.g., by another object file), and the
+// declaration only provides the function's signature.
+type FunctionDeclaration struct {
+	sourceEntry
+
+	parseutil.StartEndPos
+
+	CallConventionName
+
+	Label          string
+	ParameterTypes []Type
+	ReturnType     Type
+
+	// Internal
+
+	FuncType *FunctionType
+}
+
+var _ SourceEntry = &FunctionDeclaration{}
+var _ Validator = &FunctionDeclaration{}
+
+func (decl *FunctionDeclaration) Walk(visitor Visitor) {
+	visitor.Enter(decl)
+	for _, paramType := range decl.ParameterTypes {
+		paramType.Walk(visitor)
+	}
+	decl.ReturnType.Walk(visitor)
+	visitor.Exit(decl)
+}
+
+func (decl *FunctionDeclaration) Validate(emitter *parseutil.Emitter) {
+	if decl.Label == "" {
+		emitter.Emit(decl.Loc(), "empty function declaration label string")
+	}
+
+	if !decl.CallConventionName.isValid() {
+		emitter.Emit(
+			decl.Loc(),
+			"unsupported call convention (%s)",
+			decl.CallConventionName)
+	}
+
+	for _, paramType := range decl.ParameterTypes {
+		validateUsableType(paramType, emitter)
+	}
+
+	validateUsableType(decl.ReturnType, emitter)
+}
+
+func (decl *FunctionDeclaration) Type() Type {
+	return decl.FuncType
+}
+
+// A global data definition.  The data is a continuous sequence of
+// ElementType values, and the definition's label refers to (i.e., is a
+// pointer to) the first element.
+//
+// Read-only data is placed in .rodata.  Mutable data is placed in .data, or in
+// .bss when all values are zeros.
+type DataDefinition struct {
+	sourceEntry
+
+	parseutil.StartEndPos
+
+	IsMutable bool
+
+	Label       string
+	ElementType Type
+	Values      []DataValue
+}
+
+var _ SourceEntry = &DataDefinition{}
+var _ Validator = &DataDefinition{}
+
+func (def *DataDefinition) Walk(visitor Visitor) {
+	visitor.Enter(def)
+	def.ElementType.Walk(visitor)
+	for _, value := range def.Values {
+		value.Walk(visitor)
+	}
+	visitor.Exit(def)
+}
+
+func (def *DataDefinition) Validate(emitter *parseutil.Emitter) {
+	if def.Label == "" {
+		emitter.Emit(def.Loc(), "empty data definition label string")
+	}
+
+	if !IsNumberSubType(def.ElementType) && !IsBoolType(def.ElementType) {
+		emitter.Emit(
+			def.ElementType.Loc(),
+			"data element type must be an int, float, or bool type, found %s",
+			def.ElementType)
+	}
+
+	if def.NumElements() < 1 {
+		emitter.Emit(def.Loc(), "data definition must have at least one element")
+	}
+
+	for _, value := range def.Values {
+		_, ok := value.(*StringData)
+		if ok && !IsU8SubType(def.ElementType) {
+			emitter.Emit(
+				value.Loc(),
+				"string data requires U8 element type, found %s",
+				def.ElementType)
+		}
+	}
+}
+
+func (def *DataDefinition) Type() Type {
+	return NewPointerType(def.StartEndPos, def.ElementType)
+}
+
+// The total number of data elements.
+func (def *DataDefinition) NumElements() int {
+	count := 0
+	for _, value := range def.Values {
+		count += value.NumElements()
+	}
+	return count
+}
+
+// Immediate or string data
+type DataValue interface {
+	Node
+	isDataValue()
+
+	NumElements() int
+}
+
+// An immediate value, repeated Count times (e.g., `0 * 4096`).  Count is 1
+// when the value is not repeated.
+type ImmediateData struct {
+	parseutil.StartEndPos
+
+	Value Value // int, float, or bool immediate
+	Count int
+}
+
+var _ DataValue = &ImmediateData{}
+var _ Validator = &ImmediateData{}
+
+func (ImmediateData) isDataValue() {}
+
+func (data *ImmediateData) NumElements() int {
+	return data.Count
+}
+
+func (data *ImmediateData) Walk(visitor Visitor) {
+	visitor.Enter(data)
+	data.Value.Walk(visitor)
+	visitor.Exit(data)
+}
+
+func (data *ImmediateData) Validate(emitter *parseutil.Emitter) {
+	if data.Count < 1 {
+		emitter.Emit(data.Loc(), "data repeat count must be positive")
+	}
+}
+
+func (data *ImmediateData) String() string {
+	if data.Count == 1 {
+		return data.Value.String()
+	}
+	return fmt.Sprintf("%s * %d", data.Value, data.Count)
+}
+
+// A string literal's bytes, one U8 element per byte.  Note that the string is
+// not implicitly null terminated.
+type StringData struct {
+	parseutil.StartEndPos
+
+	Value string
+}
+
+var _ DataValue = &StringData{}
+
+func (StringData) isDataValue() {}
+
+func (data *StringData) NumElements() int {
+	return len(data.Value)
+}
+
+func (data *StringData) Walk(visitor Visitor) {
+	visitor.Enter(data)
+	visitor.Exit(data)
+}
+
+func (data *StringData) String() string {
+	return strconv.Quote(data.Value)
+}
+
 // A straight-line / basic block
 type Block struct {
 	parseutil.StartEndPos
@@ -137,7 +137,8 @@ type Value interface {
 	// What this reference refers to.  For now:
 	// - local variable reference returns a *VariableDefinition
 	// - global label reference returns a string
-	// - immediate returns an int / float
+	// - immediate returns an int / float / bool
+	// - zero immediate returns nil
 	Definition() interface{}
 
 	// NOTE: A copy of newVal, not newVal itself, is used to replace the
@@ -403,3 +404,93 @@ func (imm *FloatImmediate) Type() Type {
 func (imm *FloatImmediate) String() string {
 	return fmt.Sprintf("%g", imm.Value)
 }
+
+type BoolImmediate struct {
+	value
+	parseutil.StartEndPos
+
+	Value bool
+}
+
+var _ Value = &BoolImmediate{}
+
+func (BoolImmediate) isValue() {}
+
+func (imm *BoolImmediate) Definition() interface{} {
+	return imm.Value
+}
+
+func (imm *BoolImmediate) ReplaceWith(newVal Value) {
+	newVal = newVal.Copy(imm.StartEnd())
+	newVal.SetParentInstruction(imm.ParentInstruction)
+	imm.ParentInstruction.replaceSource(imm, newVal)
+	imm.Discard()
+}
+
+func (imm *BoolImmediate) Copy(pos parseutil.StartEndPos) Value {
+	copied := *imm
+	copied.StartEndPos = pos
+	return &copied
+}
+
+func (imm *BoolImmediate) Walk(visitor Visitor) {
+	visitor.Enter(imm)
+	visitor.Exit(imm)
+}
+
+func (imm *BoolImmediate) Type() Type {
+	return NewBoolType(imm.StartEndPos)
+}
+
+func (imm *BoolImmediate) String() string {
+	if imm.Value {
+		return "true"
+	}
+	return "false"
+}
+
+// The zero value of an aggregate type (e.g., struct), with all bits cleared.
+type ZeroImmediate struct {
+	value
+	parseutil.StartEndPos
+
+	// Internal
+	BindedType Type // set by type checker
+}
+
+var _ Value = &ZeroImmediate{}
+
+func (ZeroImmediate) isValue() {}
+
+func (imm *ZeroImmediate) Definition() interface{} {
+	return nil
+}
+
+func (imm *ZeroImmediate) ReplaceWith(newVal Value) {
+	newVal = newVal.Copy(imm.StartEnd())
+	newVal.SetParentInstruction(imm.ParentInstruction)
+	imm.ParentInstruction.replaceSource(imm, newVal)
+	imm.Discard()
+}
+
+func (imm *ZeroImmediate) Copy(pos parseutil.StartEndPos) Value {
+	copied := *imm
+	copied.StartEndPos = pos
+	return &copied
+}
+
+func (imm *ZeroImmediate) Walk(visitor Visitor) {
+	visitor.Enter(imm)
+	visitor.Exit(imm)
+}
+
+func (imm *ZeroImmediate) Type() Type {
+	if imm.BindedType != nil {
+		return imm.BindedType
+	}
+	return NewZeroLiteralType(imm.StartEndPos)
+}
+
+func (imm *ZeroImmediate) String() string {
+	return "zero"
+}
@@ -160,8 +160,25 @@ const (
 	Shl = BinaryOperationKind("shl")
 	// uint uses logical shift shr, int uses arithmetic shift sar
 	Shr = BinaryOperationKind("shr")
+
+	// Comparisons produce Bool values.
+	Eq = BinaryOperationKind("eq")
+	Ne = BinaryOperationKind("ne")
+	Lt = BinaryOperationKind("lt")
+	Le = BinaryOperationKind("le")
+	Gt = BinaryOperationKind("gt")
+	Ge = BinaryOperationKind("ge")
 )
 
+func (kind BinaryOperationKind) IsComparison() bool {
+	switch kind {
+	case Eq, Ne, Lt, Le, Gt, Ge:
+		return true
+	default:
+		return false
+	}
+}
+
 // Instructions of the form: <dest> = <type> <src1>, <src2>
 type BinaryOperation struct {
 	instruction
@@ -213,6 +230,7 @@ func (binary *BinaryOperation) Walk(visitor Visitor) {
 func (binary *BinaryOperation) Validate(emitter *parseutil.Emitter) {
 	switch binary.Kind {
 	case Add, Sub, Mul, Div, Rem, Xor, Or, And, Shl, Shr: // ok
+	case Eq, Ne, Lt, Le, Gt, Ge: // ok
 	default:
 		emitter.Emit(binary.Loc(), "unexpected binary operation (%s)", binary.Kind)
 	}
@@ -237,7 +255,9 @@ const (
 // Call of the form: [dests]* = <op> <func/sysno> ( [srcs,]* )
 //
 // The number of return values and arguments must match the function/syscall's
-// signature.
+// signature.  The destination may be omitted if the return value is unused,
+// in which case an internal destination is assigned during control flow graph
+// initialization.
 type FuncCall struct {
 	instruction
 
@@ -249,8 +269,19 @@ type FuncCall struct {
 	Func Value
 	Args []Value
 
+	// Destinations for multiple return values, e.g., %q, %r = call @f().  The
+	// returned tuple is unpacked into these destinations by extract operations
+	// (the number of destinations must match the number of tuple elements).
+	// Dest is unset until the tuple destinations are lowered.
+	TupleDests []*VariableDefinition
+
 	// Internal
 	IsExitTerminal bool
+
+	// The number of tuple destinations prior to lowering (zero when the call
+	// has no tuple destinations).  Checked against the callee's return type
+	// during type checking.
+	NumTupleDests int
 }
 
 var _ Instruction = &FuncCall{}
@@ -258,6 +289,11 @@ var _ Validator = &FuncCall{}
 
 func (call *FuncCall) replaceSource(oldVal Value, newVal Value) {
 	replaceCount := 0
+	if call.Func == oldVal {
+		call.Func = newVal
+		replaceCount++
+	}
+
 	for idx, src := range call.Args {
 		if src == oldVal {
 			call.Args[idx] = newVal
@@ -280,7 +316,12 @@ func (call *FuncCall) Destination() *VariableDefinition {
 
 func (call *FuncCall) Walk(visitor Visitor) {
 	visitor.Enter(call)
-	call.Dest.Walk(visitor)
+	if call.Dest != nil {
+		call.Dest.Walk(visitor)
+	}
+	for _, dest := range call.TupleDests {
+		dest.Walk(visitor)
+	}
 	call.Func.Walk(visitor)
 	for _, src := range call.Args {
 		src.Walk(visitor)
@@ -290,7 +331,11 @@ func (call *FuncCall) Walk(visitor Visitor) {
 
 func (call *FuncCall) Validate(emitter *parseutil.Emitter) {
 	switch call.Kind {
-	case Call, SysCall: // ok
+	case Call: // ok
+	case SysCall:
+		if len(call.TupleDests) > 0 {
+			emitter.Emit(call.Loc(), "syscall does not return multiple values")
+		}
 	default:
 		emitter.Emit(call.Loc(), "unexpected call operation (%s)", call.Kind)
 	}
@@ -304,10 +349,407 @@ func (call *FuncCall) String() string {
 		}
 		args += arg.String()
 	}
+
+	result := fmt.Sprintf("%s %s(%s)", call.Kind, call.Func, args)
+	if call.Dest != nil {
+		result = fmt.Sprintf("%s = %s", call.Dest, result)
+	} else if len(call.TupleDests) > 0 {
+		dests := ""
+		for idx, dest := range call.TupleDests {
+			if idx > 0 {
+				dests += ", "
+			}
+			dests += dest.String()
+		}
+		result = fmt.Sprintf("%s = %s", dests, result)
+	}
+	return result
+}
+
+// Instructions of the form: <dest> = load <address>
+//
+// Loads the destination type's value from the memory referenced by the
+// address.
+type LoadOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest    *VariableDefinition
+	Address Value
+}
+
+var _ Instruction = &LoadOperation{}
+
+func (load *LoadOperation) replaceSource(oldVal Value, newVal Value) {
+	if load.Address != oldVal {
+		panic("should never happen")
+	}
+
+	load.Address = newVal
+}
+
+func (load *LoadOperation) Sources() []Value {
+	return []Value{load.Address}
+}
+
+func (load *LoadOperation) Destination() *VariableDefinition {
+	return load.Dest
+}
+
+func (load *LoadOperation) Walk(visitor Visitor) {
+	visitor.Enter(load)
+	load.Dest.Walk(visitor)
+	load.Address.Walk(visitor)
+	visitor.Exit(load)
+}
+
+func (load *LoadOperation) String() string {
+	return fmt.Sprintf("%s = load %s", load.Dest, load.Address)
+}
+
+// Instructions of the form: store <address>, <src>
+//
+// Stores the source value into the memory referenced by the address.
+type StoreOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Address Value
+	Src     Value
+}
+
+var _ Instruction = &StoreOperation{}
+
+func (store *StoreOperation) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if store.Address == oldVal {
+		store.Address = newVal
+		replaceCount++
+	}
+	if store.Src == oldVal {
+		store.Src = newVal
+		replaceCount++
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (store *StoreOperation) Sources() []Value {
+	return []Value{store.Address, store.Src}
+}
+
+func (store *StoreOperation) Destination() *VariableDefinition {
+	return nil
+}
+
+func (store *StoreOperation) Walk(visitor Visitor) {
+	visitor.Enter(store)
+	store.Address.Walk(visitor)
+	store.Src.Walk(visitor)
+	visitor.Exit(store)
+}
+
+func (store *StoreOperation) String() string {
+	return fmt.Sprintf("store %s, %s", store.Address, store.Src)
+}
+
+// Instructions of the form: <dest> = extract <src>, <field>
+//
+// Copies the named field's value out of the source struct.
+type ExtractOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest  *VariableDefinition
+	Src   Value
+	Field string
+}
+
+var _ Instruction = &ExtractOperation{}
+var _ Validator = &ExtractOperation{}
+
+func (extract *ExtractOperation) replaceSource(oldVal Value, newVal Value) {
+	if extract.Src != oldVal {
+		panic("should never happen")
+	}
+
+	extract.Src = newVal
+}
+
+func (extract *ExtractOperation) Sources() []Value {
+	return []Value{extract.Src}
+}
+
+func (extract *ExtractOperation) Destination() *VariableDefinition {
+	return extract.Dest
+}
+
+func (extract *ExtractOperation) Walk(visitor Visitor) {
+	visitor.Enter(extract)
+	extract.Dest.Walk(visitor)
+	extract.Src.Walk(visitor)
+	visitor.Exit(extract)
+}
+
+func (extract *ExtractOperation) Validate(emitter *parseutil.Emitter) {
+	if extract.Field == "" {
+		emitter.Emit(extract.Loc(), "empty struct field name")
+	}
+}
+
+func (extract *ExtractOperation) String() string {
+	return fmt.Sprintf(
+		"%s = extract %s, %s",
+		extract.Dest,
+		extract.Src,
+		extract.Field)
+}
+
+// Instructions of the form: <dest> = insert <src>, <field>, <value>
+//
+// The destination is a copy of the source struct, with the named field
+// replaced by the value.
+type InsertOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest  *VariableDefinition
+	Src   Value
+	Field string
+	Value Value
+}
+
+var _ Instruction = &InsertOperation{}
+var _ Validator = &InsertOperation{}
+
+func (insert *InsertOperation) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if insert.Src == oldVal {
+		insert.Src = newVal
+		replaceCount++
+	}
+	if insert.Value == oldVal {
+		insert.Value = newVal
+		replaceCount++
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (insert *InsertOperation) Sources() []Value {
+	return []Value{insert.Src, insert.Value}
+}
+
+func (insert *InsertOperation) Destination() *VariableDefinition {
+	return insert.Dest
+}
+
+func (insert *InsertOperation) Walk(visitor Visitor) {
+	visitor.Enter(insert)
+	insert.Dest.Walk(visitor)
+	insert.Src.Walk(visitor)
+	insert.Value.Walk(visitor)
+	visitor.Exit(insert)
+}
+
+func (insert *InsertOperation) Validate(emitter *parseutil.Emitter) {
+	if insert.Field == "" {
+		emitter.Emit(insert.Loc(), "empty struct field name")
+	}
+}
+
+func (insert *InsertOperation) String() string {
+	return fmt.Sprintf(
+		"%s = insert %s, %s, %s",
+		insert.Dest,
+		insert.Src,
+		insert.Field,
+		insert.Value)
+}
+
+// Instructions of the form: <dest> = getelem <src>, <index>
+//
+// The index must be within the source array's bounds.  Out of bound access is
+// an interpreter error, and traps (raises an invalid opcode exception) in
+// generated code.
+type GetElementOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest  *VariableDefinition
+	Src   Value
+	Index Value
+}
+
+var _ Instruction = &GetElementOperation{}
+
+func (get *GetElementOperation) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if get.Src == oldVal {
+		get.Src = newVal
+		replaceCount++
+	}
+	if get.Index == oldVal {
+		get.Index = newVal
+		replaceCount++
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (get *GetElementOperation) Sources() []Value {
+	return []Value{get.Src, get.Index}
+}
+
+func (get *GetElementOperation) Destination() *VariableDefinition {
+	return get.Dest
+}
+
+func (get *GetElementOperation) Walk(visitor Visitor) {
+	visitor.Enter(get)
+	get.Dest.Walk(visitor)
+	get.Src.Walk(visitor)
+	get.Index.Walk(visitor)
+	visitor.Exit(get)
+}
+
+func (get *GetElementOperation) String() string {
+	return fmt.Sprintf("%s = getelem %s, %s", get.Dest, get.Src, get.Index)
+}
+
+// Instructions of the form: <dest> = setelem <src>, <index>, <value>
+//
+// The destination is a copy of the source array, with the indexed element
+// replaced by the value.  Bound checking is the same as getelem's.
+type SetElementOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest  *VariableDefinition
+	Src   Value
+	Index Value
+	Value Value
+}
+
+var _ Instruction = &SetElementOperation{}
+
+func (set *SetElementOperation) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if set.Src == oldVal {
+		set.Src = newVal
+		replaceCount++
+	}
+	if set.Index == oldVal {
+		set.Index = newVal
+		replaceCount++
+	}
+	if set.Value == oldVal {
+		set.Value = newVal
+		replaceCount++
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (set *SetElementOperation) Sources() []Value {
+	return []Value{set.Src, set.Index, set.Value}
+}
+
+func (set *SetElementOperation) Destination() *VariableDefinition {
+	return set.Dest
+}
+
+func (set *SetElementOperation) Walk(visitor Visitor) {
+	visitor.Enter(set)
+	set.Dest.Walk(visitor)
+	set.Src.Walk(visitor)
+	set.Index.Walk(visitor)
+	set.Value.Walk(visitor)
+	visitor.Exit(set)
+}
+
+func (set *SetElementOperation) String() string {
+	return fmt.Sprintf(
+		"%s = setelem %s, %s, %s",
+		set.Dest,
+		set.Src,
+		set.Index,
+		set.Value)
+}
+
+// Instructions of the form: <dest> = select <cond>, <true value>, <false value>
+//
+// The destination is the true value if the bool condition is true, otherwise
+// the destination is the false value.
+type SelectOperation struct {
+	instruction
+
+	parseutil.StartEndPos
+
+	Dest       *VariableDefinition
+	Cond       Value
+	TrueValue  Value
+	FalseValue Value
+}
+
+var _ Instruction = &SelectOperation{}
+
+func (sel *SelectOperation) replaceSource(oldVal Value, newVal Value) {
+	replaceCount := 0
+	if sel.Cond == oldVal {
+		sel.Cond = newVal
+		replaceCount++
+	}
+	if sel.TrueValue == oldVal {
+		sel.TrueValue = newVal
+		replaceCount++
+	}
+	if sel.FalseValue == oldVal {
+		sel.FalseValue = newVal
+		replaceCount++
+	}
+
+	if replaceCount != 1 {
+		panic("should never happen")
+	}
+}
+
+func (sel *SelectOperation) Sources() []Value {
+	return []Value{sel.Cond, sel.TrueValue, sel.FalseValue}
+}
+
+func (sel *SelectOperation) Destination() *VariableDefinition {
+	return sel.Dest
+}
+
+func (sel *SelectOperation) Walk(visitor Visitor) {
+	visitor.Enter(sel)
+	sel.Dest.Walk(visitor)
+	sel.Cond.Walk(visitor)
+	sel.TrueValue.Walk(visitor)
+	sel.FalseValue.Walk(visitor)
+	visitor.Exit(sel)
+}
+
+func (sel *SelectOperation) String() string {
 	return fmt.Sprintf(
-		"%s = %s %s(%s)",
-		call.Dest,
-		call.Kind,
-		call.Func,
-		args)
+		"%s = select %s, %s, %s",
+		sel.Dest,
+		sel.Cond,
+		sel.TrueValue,
+		sel.FalseValue)
 }
@@ -161,6 +161,10 @@ func (printer *treePrinter) Enter(n Node) {
 		printer.write("[IntImmediate: Value=%s%d]", sign, node.Value)
 	case *FloatImmediate:
 		printer.write("[FloatImmediate: Value=%e]", node.Value)
+	case *BoolImmediate:
+		printer.write("[BoolImmediate: Value=%t]", node.Value)
+	case *ZeroImmediate:
+		printer.write("[ZeroImmediate]")
 
 	case *CopyOperation:
 		printer.write("[CopyOperation:")
@@ -171,7 +175,37 @@ func (printer *treePrinter) Enter(n Node) {
 	case *BinaryOperation:
 		printer.write("[BinaryOperation: Kind=%s", node.Kind)
 		printer.push("Dest=", "Src1=", "Src2=")
+	case *LoadOperation:
+		printer.write("[LoadOperation:")
+		printer.push("Dest=", "Address=")
+	case *StoreOperation:
+		printer.write("[StoreOperation:")
+		printer.push("Address=", "Src=")
+	case *ExtractOperation:
+		printer.write("[ExtractOperation: Field=%s", node.Field)
+		printer.push("Dest=", "Src=")
+	case *InsertOperation:
+		printer.write("[InsertOperation: Field=%s", node.Field)
+		printer.push("Dest=", "Src=", "Value=")
+	case *GetElementOperation:
+		printer.write("[GetElementOperation:")
+		printer.push("Dest=", "Src=", "Index=")
+	case *SetElementOperation:
+		printer.write("[SetElementOperation:")
+		printer.push("Dest=", "Src=", "Index=", "Value=")
+	case *SelectOperation:
+		printer.write("[SelectOperation:")
+		printer.push("Dest=", "Cond=", "TrueValue=", "FalseValue=")
 	case *FuncCall:
+		fields := []string{}
+		if node.Dest != nil {
+			fields = append(fields, "Dest=")
+		}
+		for idx, _ := range node.TupleDests {
+			fields = append(fields, fmt.Sprintf("TupleDest%d=", idx))
+		}
+		fields = append(fields, "Func=")
+
 		printer.list(
 			fmt.Sprintf(
 				"[FuncCall: Kind=%s IsExitTerminal=%v",
@@ -179,20 +213,44 @@ func (printer *treePrinter) Enter(n Node) {
 				node.IsExitTerminal),
 			"Argument",
 			len(node.Args),
-			"Dest=",
-			"Func=")
+			fields...)
 
 	case *Jump:
 		printer.write("[Jump: Label=%s]", node.Label)
 	case *ConditionalJump:
 		printer.write("[ConditionalJump: Kind=%s Label=%s", node.Kind, node.Label)
 		printer.push("Src1=", "Src2=")
+	case *Switch:
+		printer.write("[Switch: DefaultLabel=%s", node.DefaultLabel)
+		labels := []string{"Src="}
+		for idx, switchCase := range node.Cases {
+			labels = append(labels, fmt.Sprintf("Case%d(%s)=", idx, switchCase.Label))
+		}
+		printer.push(labels...)
+	case *TailCall:
+		printer.write("[TailCall:")
+		labels := []string{"Func="}
+		for idx, _ := range node.Args {
+			labels = append(labels, fmt.Sprintf("Argument%d=", idx))
+		}
+		for idx, _ := range node.CalleeSavedSources {
+			labels = append(labels, fmt.Sprintf("CalleeSavedSource%d=", idx))
+		}
+		printer.push(labels...)
 	case *Terminal:
+		fields := []string{}
+		if node.RetVal != nil {
+			fields = append(fields, "RetVal=")
+		}
+		for idx, _ := range node.TupleRetVals {
+			fields = append(fields, fmt.Sprintf("TupleRetVal%d=", idx))
+		}
+
 		printer.list(
 			fmt.Sprintf("[Terminal: Kind=%s", node.Kind),
 			"CalleeSavedSource",
 			len(node.CalleeSavedSources),
-			"RetVal=")
+			fields...)
 
 	case *ErrorType:
 		printer.write("[ErrorType]")
@@ -202,6 +260,10 @@ func (printer *treePrinter) Enter(n Node) {
 		printer.write("[NegativeIntLiteralType]")
 	case *FloatLiteralType:
 		printer.write("[FloatLiteralType]")
+	case *ZeroLiteralType:
+		printer.write("[ZeroLiteralType]")
+	case *BoolType:
+		printer.write("[BoolType]")
 	case *SignedIntType:
 		printer.write("[SignedIntType: Kind=%s]", node.Kind)
 	case *UnsignedIntType:
@@ -216,6 +278,17 @@ func (printer *treePrinter) Enter(n Node) {
 			"Parameter",
 			len(node.ParameterTypes),
 			"ReturnType=")
+	case *PointerType:
+		printer.write("[PointerType:")
+		printer.push("ElementType=")
+	case *StructType:
+		printer.list("[StructType:", "Field", len(node.Fields))
+	case *StructField:
+		printer.write("[StructField: Name=%s", node.Name)
+		printer.push("Type=")
+	case *ArrayType:
+		printer.write("[ArrayType: Length=%d", node.Length)
+		printer.push("ElementType=")
 
 	case *FunctionDefinition:
 		printer.write(
@@ -234,6 +307,31 @@ func (printer *treePrinter) Enter(n Node) {
 			labels = append(labels, fmt.Sprintf("Block%d=", idx))
 		}
 		printer.push(labels...)
+	case *FunctionDeclaration:
+		printer.write(
+			"[FunctionDeclaration: Label=%s CallConventionName=%s",
+			node.Label,
+			node.CallConventionName)
+		labels := []string{}
+		for idx, _ := range node.ParameterTypes {
+			labels = append(labels, fmt.Sprintf("Parameter%d=", idx))
+		}
+		labels = append(labels, "ReturnType=")
+		printer.push(labels...)
+	case *DataDefinition:
+		printer.list(
+			fmt.Sprintf(
+				"[DataDefinition: Label=%s IsMutable=%v",
+				node.Label,
+				node.IsMutable),
+			"Value",
+			len(node.Values),
+			"ElementType=")
+	case *ImmediateData:
+		printer.write("[ImmediateData: Count=%d", node.Count)
+		printer.push("Value=")
+	case *StringData:
+		printer.write("[StringData: Value=%q]", node.Value)
 	case *Block:
 		labels := []string{}
 		for i := 0; i < len(node.Phis); i++ {
@@ -266,19 +364,51 @@ func (printer *treePrinter) Exit(n Node) {
 		printer.endNode()
 	case *BinaryOperation:
 		printer.endNode()
+	case *LoadOperation:
+		printer.endNode()
+	case *StoreOperation:
+		printer.endNode()
+	case *ExtractOperation:
+		printer.endNode()
+	case *InsertOperation:
+		printer.endNode()
+	case *GetElementOperation:
+		printer.endNode()
+	case *SetElementOperation:
+		printer.endNode()
+	case *SelectOperation:
+		printer.endNode()
 	case *FuncCall:
 		printer.endList(len(node.Args))
 
 	case *ConditionalJump:
 		printer.endNode()
+	case *Switch:
+		printer.endNode()
+	case *TailCall:
+		printer.endNode()
 	case *Terminal:
 		printer.endNode()
 
 	case *FunctionType:
 		printer.endList(len(node.ParameterTypes))
+	case *PointerType:
+		printer.endNode()
+	case *StructType:
+		printer.endList(len(node.Fields))
+	case *StructField:
+		printer.endNode()
+	case *ArrayType:
+		printer.endNode()
 
 	case *FunctionDefinition:
 		printer.endNode()
+	case *FunctionDeclaration:
+		printer.endNode()
+	case *DataDefinition:
+		printer.endNode()
+	case *ImmediateData:
+		printer.endNode()
 	case *Block:
 		printer.endNode()
 	case *Phi:
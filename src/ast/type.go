@@ -1,6 +1,9 @@
 package ast
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/pattyshack/gt/parseutil"
 )
 
@@ -82,15 +85,63 @@ func IsNumberSubType(t Type) bool {
 	return IsIntSubType(t) || IsFloatSubType(t)
 }
 
+func IsBoolType(t Type) bool {
+	_, ok := t.(*BoolType)
+	return ok
+}
+
 func IsFunctionType(t Type) bool {
 	_, ok := t.(*FunctionType)
 	return ok
 }
 
+func IsPointerType(t Type) bool {
+	_, ok := t.(*PointerType)
+	return ok
+}
+
+func IsStructType(t Type) bool {
+	_, ok := t.(*StructType)
+	return ok
+}
+
+func IsArrayType(t Type) bool {
+	_, ok := t.(*ArrayType)
+	return ok
+}
+
+// Struct and array types
+func IsAggregateType(t Type) bool {
+	return IsStructType(t) || IsArrayType(t)
+}
+
+// The unit type is the empty struct type.
+func IsUnitType(t Type) bool {
+	structType, ok := t.(*StructType)
+	return ok && len(structType.Fields) == 0
+}
+
+// Returns the number of tuple elements, or -1 if the type is not a tuple.
+func TupleSize(t Type) int {
+	structType, ok := t.(*StructType)
+	if !ok {
+		return -1
+	}
+
+	for idx, field := range structType.Fields {
+		if field.Name != TupleFieldName(idx) {
+			return -1
+		}
+	}
+	return len(structType.Fields)
+}
+
 // == and !=
 // NOTE: float is not comparable
 func IsComparableType(t Type) bool {
 	switch t.(type) {
+	case *BoolType:
+		return true
 	case *PositiveIntLiteralType:
 		return true
 	case *NegativeIntLiteralType:
@@ -101,6 +152,8 @@ func IsComparableType(t Type) bool {
 		return true
 	case *FunctionType:
 		return true
+	case *PointerType:
+		return true
 	default:
 		return false
 	}
@@ -279,6 +332,47 @@ func (*FloatLiteralType) IsSubTypeOf(other Type) bool {
 	}
 }
 
+// Internal use only. Compatible with all aggregate (struct / array) types.
+type ZeroLiteralType struct {
+	isType
+	parseutil.StartEndPos
+}
+
+var _ Type = &ZeroLiteralType{}
+
+func NewZeroLiteralType(pos parseutil.StartEndPos) Type {
+	return &ZeroLiteralType{
+		StartEndPos: pos,
+	}
+}
+
+func (t *ZeroLiteralType) Walk(visitor Visitor) {
+	visitor.Enter(t)
+	visitor.Exit(t)
+}
+
+func (*ZeroLiteralType) String() string {
+	return "ZeroLiteralType"
+}
+
+func (*ZeroLiteralType) Equals(other Type) bool {
+	_, ok := other.(*ZeroLiteralType)
+	return ok
+}
+
+func (*ZeroLiteralType) IsSubTypeOf(other Type) bool {
+	switch other.(type) {
+	case *ZeroLiteralType:
+		return true
+	case *StructType:
+		return true
+	case *ArrayType:
+		return true
+	default:
+		return false
+	}
+}
+
 func validateUsableType(typeExpr Type, emitter *parseutil.Emitter) {
 	switch typeExpr.(type) {
 	case *ErrorType:
@@ -293,10 +387,43 @@ func validateUsableType(typeExpr Type, emitter *parseutil.Emitter) {
 			"cannot use NegativeIntLiteralType as return type")
 	case *FloatLiteralType:
 		emitter.Emit(typeExpr.Loc(), "cannot use FloatLiteralType as return type")
+	case *ZeroLiteralType:
+		emitter.Emit(typeExpr.Loc(), "cannot use ZeroLiteralType as return type")
 	default: // ok
 	}
 }
 
+type BoolType struct {
+	isType
+	parseutil.StartEndPos
+}
+
+var _ Type = &BoolType{}
+
+func NewBoolType(pos parseutil.StartEndPos) Type {
+	return &BoolType{
+		StartEndPos: pos,
+	}
+}
+
+func (t *BoolType) Walk(visitor Visitor) {
+	visitor.Enter(t)
+	visitor.Exit(t)
+}
+
+func (*BoolType) String() string {
+	return "Bool"
+}
+
+func (*BoolType) Equals(other Type) bool {
+	_, ok := other.(*BoolType)
+	return ok
+}
+
+func (t *BoolType) IsSubTypeOf(other Type) bool {
+	return t.Equals(other)
+}
+
 type SignedIntTypeKind string
 
 const (
@@ -608,3 +735,266 @@ func (funcType *FunctionType) Equals(other Type) bool {
 func (funcType *FunctionType) IsSubTypeOf(other Type) bool {
 	return funcType.Equals(other)
 }
+
+type PointerType struct {
+	isType
+	parseutil.StartEndPos
+
+	ElementType Type
+}
+
+var _ Type = &PointerType{}
+var _ Validator = &PointerType{}
+
+func NewPointerType(
+	pos parseutil.StartEndPos,
+	elementType Type,
+) *PointerType {
+	return &PointerType{
+		StartEndPos: pos,
+		ElementType: elementType,
+	}
+}
+
+func (ptrType *PointerType) Walk(visitor Visitor) {
+	visitor.Enter(ptrType)
+	ptrType.ElementType.Walk(visitor)
+	visitor.Exit(ptrType)
+}
+
+func (ptrType *PointerType) Validate(emitter *parseutil.Emitter) {
+	validateUsableType(ptrType.ElementType, emitter)
+}
+
+func (ptrType *PointerType) String() string {
+	return "*" + ptrType.ElementType.String()
+}
+
+func (ptrType *PointerType) Equals(other Type) bool {
+	otherPtrType, ok := other.(*PointerType)
+	if !ok {
+		return false
+	}
+
+	return ptrType.ElementType.Equals(otherPtrType.ElementType)
+}
+
+func (ptrType *PointerType) IsSubTypeOf(other Type) bool {
+	// Pointer element types must match exactly.
+	return ptrType.Equals(other)
+}
+
+type StructField struct {
+	parseutil.StartEndPos
+
+	Name string
+	Type Type
+}
+
+func (field *StructField) Walk(visitor Visitor) {
+	visitor.Enter(field)
+	field.Type.Walk(visitor)
+	visitor.Exit(field)
+}
+
+func (field *StructField) String() string {
+	return field.Name + " " + field.Type.String()
+}
+
+// Fields are laid out in declaration order.  Each field is register aligned
+// (see architecture.FieldOffset).
+type StructType struct {
+	isType
+	parseutil.StartEndPos
+
+	Fields []*StructField
+}
+
+var _ Type = &StructType{}
+var _ Validator = &StructType{}
+
+// The unit type is the zero-sized empty struct type.  Functions without a
+// return value return unit.
+func NewUnitType(pos parseutil.StartEndPos) *StructType {
+	return NewStructType(pos, []*StructField{})
+}
+
+// A tuple is a struct type whose fields are named by their positions, i.e.,
+// "0", "1", etc.  Functions with multiple return values return a tuple.
+func NewTupleType(
+	pos parseutil.StartEndPos,
+	elementTypes []Type,
+) *StructType {
+	fields := make([]*StructField, 0, len(elementTypes))
+	for idx, elementType := range elementTypes {
+		fields = append(
+			fields,
+			&StructField{
+				StartEndPos: elementType.StartEnd(),
+				Name:        TupleFieldName(idx),
+				Type:        elementType,
+			})
+	}
+	return NewStructType(pos, fields)
+}
+
+func TupleFieldName(idx int) string {
+	return strconv.Itoa(idx)
+}
+
+func NewStructType(
+	pos parseutil.StartEndPos,
+	fields []*StructField,
+) *StructType {
+	return &StructType{
+		StartEndPos: pos,
+		Fields:      fields,
+	}
+}
+
+// Returns the named field's index, or -1 if the field does not exist.
+func (structType *StructType) FieldIndex(name string) int {
+	for idx, field := range structType.Fields {
+		if field.Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (structType *StructType) Walk(visitor Visitor) {
+	visitor.Enter(structType)
+	for _, field := range structType.Fields {
+		field.Walk(visitor)
+	}
+	visitor.Exit(structType)
+}
+
+func (structType *StructType) Validate(emitter *parseutil.Emitter) {
+	names := map[string]*StructField{}
+	for _, field := range structType.Fields {
+		if field.Name == "" {
+			emitter.Emit(field.Loc(), "empty struct field name")
+		} else {
+			prev, ok := names[field.Name]
+			if ok {
+				emitter.Emit(
+					field.Loc(),
+					"duplicate struct field (%s), previously defined at (%s)",
+					field.Name,
+					prev.Loc())
+			} else {
+				names[field.Name] = field
+			}
+		}
+
+		validateUsableType(field.Type, emitter)
+	}
+}
+
+func (structType *StructType) String() string {
+	result := "struct{"
+	for idx, field := range structType.Fields {
+		if idx == 0 {
+			result += field.String()
+		} else {
+			result += ", " + field.String()
+		}
+	}
+	return result + "}"
+}
+
+func (structType *StructType) Equals(other Type) bool {
+	otherStructType, ok := other.(*StructType)
+	if !ok {
+		return false
+	}
+
+	if len(structType.Fields) != len(otherStructType.Fields) {
+		return false
+	}
+
+	for idx, field := range structType.Fields {
+		otherField := otherStructType.Fields[idx]
+		if field.Name != otherField.Name || !field.Type.Equals(otherField.Type) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (structType *StructType) IsSubTypeOf(other Type) bool {
+	// Struct types are structurally typed, but the fields must match exactly.
+	return structType.Equals(other)
+}
+
+// Elements are laid out in index order.  Each element is register aligned
+// (i.e., the element at index i occupies the i-th register sized chunk).
+type ArrayType struct {
+	isType
+	parseutil.StartEndPos
+
+	Length      int
+	ElementType Type
+}
+
+var _ Type = &ArrayType{}
+var _ Validator = &ArrayType{}
+
+func NewArrayType(
+	pos parseutil.StartEndPos,
+	length int,
+	elementType Type,
+) *ArrayType {
+	return &ArrayType{
+		StartEndPos: pos,
+		Length:      length,
+		ElementType: elementType,
+	}
+}
+
+func (arrayType *ArrayType) Walk(visitor Visitor) {
+	visitor.Enter(arrayType)
+	arrayType.ElementType.Walk(visitor)
+	visitor.Exit(arrayType)
+}
+
+func (arrayType *ArrayType) Validate(emitter *parseutil.Emitter) {
+	if arrayType.Length < 1 {
+		emitter.Emit(
+			arrayType.Loc(),
+			"array must have at least one element (%d)",
+			arrayType.Length)
+	}
+
+	elementType := arrayType.ElementType
+	if !IsNumberSubType(elementType) &&
+		!IsPointerType(elementType) &&
+		!IsFunctionType(elementType) {
+
+		emitter.Emit(
+			elementType.Loc(),
+			"cannot use %s as array element type",
+			elementType)
+	}
+}
+
+func (arrayType *ArrayType) String() string {
+	return fmt.Sprintf("[%d]%s", arrayType.Length, arrayType.ElementType)
+}
+
+func (arrayType *ArrayType) Equals(other Type) bool {
+	otherArrayType, ok := other.(*ArrayType)
+	if !ok {
+		return false
+	}
+
+	return arrayType.Length == otherArrayType.Length &&
+		arrayType.ElementType.Equals(otherArrayType.ElementType)
+}
+
+func (arrayType *ArrayType) IsSubTypeOf(other Type) bool {
+	// Array element types must match exactly.
+	return arrayType.Equals(other)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable/elf"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func main() {
+	output := flag.String("o", "a.out", "output file name")
+	writeObject := flag.Bool(
+		"c",
+		false,
+		"write a relocatable object file rather than an executable")
+	entryLabel := flag.String(
+		"entry",
+		"",
+		"entry function label (required for executable)")
+	argsString := flag.String(
+		"args",
+		"",
+		"comma separated constant int arguments passed to the entry function")
+	flag.Parse()
+
+	if (!*writeObject && *entryLabel == "") || flag.NArg() == 0 {
+		fmt.Println(
+			"Usage: build -entry <label> [-args <int>,...] [-o <output>] " +
+				"<file> ...")
+		fmt.Println("       build -c [-o <output>] <file> ...")
+		os.Exit(1)
+	}
+
+	args := []int64{}
+	if *argsString != "" {
+		for _, arg := range strings.Split(*argsString, ",") {
+			value, err := strconv.ParseInt(strings.TrimSpace(arg), 0, 64)
+			if err != nil {
+				fmt.Println("Invalid argument:", err)
+				os.Exit(1)
+			}
+			args = append(args, value)
+		}
+	}
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	emitter := &parseutil.Emitter{}
+	entries := []ast.SourceEntry{}
+	for _, fileName := range flag.Args() {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			fmt.Println("ReadFile error:", err)
+			os.Exit(1)
+		}
+
+		entries = append(
+			entries,
+			parser.Parse(
+				parseutil.NewBufferedByteLocationReaderFromSlice(
+					fileName,
+					content),
+				emitter)...)
+	}
+
+	segments := analyzer.Analyze(entries, targetPlatform, emitter)
+
+	errs := emitter.Errors()
+	if len(errs) > 0 {
+		fmt.Println("Found", len(errs), "errors:")
+		for idx, err := range errs {
+			fmt.Printf("error %d: %s\n", idx, err)
+		}
+		os.Exit(1)
+	}
+
+	if *writeObject {
+		writeFile(
+			*output,
+			0644,
+			func(file *os.File) error {
+				return elf.WriteObject(file, targetPlatform, segments)
+			})
+		return
+	}
+
+	var entryType *ast.FunctionType
+	for _, entry := range entries {
+		funcDef, ok := entry.(*ast.FunctionDefinition)
+		if ok && funcDef.Label == *entryLabel {
+			entryType = funcDef.FuncType
+			break
+		}
+	}
+
+	if entryType == nil {
+		fmt.Println("Entry function not found:", *entryLabel)
+		os.Exit(1)
+	}
+
+	startStub, err := targetPlatform.GenerateStartStub(
+		*entryLabel,
+		entryType,
+		args)
+	if err != nil {
+		fmt.Println("Failed to generate start stub:", err)
+		os.Exit(1)
+	}
+
+	writeFile(
+		*output,
+		0755,
+		func(file *os.File) error {
+			return elf.WriteExecutable(
+				file,
+				targetPlatform,
+				startStub.Label,
+				append(segments, startStub))
+		})
+}
+
+func writeFile(
+	fileName string,
+	mode os.FileMode,
+	write func(*os.File) error,
+) {
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		fmt.Println("OpenFile error:", err)
+		os.Exit(1)
+	}
+
+	err = write(file)
+	file.Close()
+	if err != nil {
+		fmt.Println("Failed to write", fileName+":", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/interpreter"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func main() {
+	numTrials := flag.Int(
+		"trials",
+		100,
+		"number of random argument vectors per function")
+	seed := flag.Int64("seed", 1, "random seed")
+	optimize := flag.Bool(
+		"optimize",
+		true,
+		"allocate the optimized ssa (as compiled by build)")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Println(
+			"Usage: check-allocation [-trials <n>] [-seed <seed>] " +
+				"[-optimize=<bool>] <file> ...")
+		os.Exit(1)
+	}
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	emitter := &parseutil.Emitter{}
+	entries := []ast.SourceEntry{}
+	for _, fileName := range flag.Args() {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			fmt.Println("ReadFile error:", err)
+			os.Exit(1)
+		}
+
+		entries = append(
+			entries,
+			parser.Parse(
+				parseutil.NewBufferedByteLocationReaderFromSlice(
+					fileName,
+					content),
+				emitter)...)
+	}
+
+	result := interpreter.CheckAllocation(
+		entries,
+		targetPlatform,
+		emitter,
+		*optimize,
+		*numTrials,
+		rand.New(rand.NewSource(*seed)))
+
+	errs := emitter.Errors()
+	if len(errs) > 0 {
+		fmt.Println("Found", len(errs), "errors:")
+		for idx, err := range errs {
+			fmt.Printf("error %d: %s\n", idx, err)
+		}
+		os.Exit(1)
+	}
+
+	for _, label := range result.Skipped {
+		fmt.Printf("Skipped @%s (unsupported parameter / return types)\n", label)
+	}
+
+	for idx, mismatch := range result.Mismatches {
+		fmt.Printf("mismatch %d: %s\n", idx, mismatch)
+	}
+
+	fmt.Printf(
+		"Checked %d trials: %d mismatches\n",
+		result.NumTrials,
+		len(result.Mismatches))
+
+	if len(result.Mismatches) > 0 {
+		os.Exit(1)
+	}
+}
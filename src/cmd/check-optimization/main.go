@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/interpreter"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func main() {
+	numTrials := flag.Int(
+		"trials",
+		100,
+		"number of random argument vectors per function")
+	seed := flag.Int64("seed", 1, "random seed")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Println(
+			"Usage: check-optimization [-trials <n>] [-seed <seed>] <file> ...")
+		os.Exit(1)
+	}
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	// The analyzer modifies the function definitions.  Hence, the unoptimized
+	// and optimized runs use separately parsed entries.
+	emitter := &parseutil.Emitter{}
+	unoptimized := []ast.SourceEntry{}
+	optimized := []ast.SourceEntry{}
+	for _, fileName := range flag.Args() {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			fmt.Println("ReadFile error:", err)
+			os.Exit(1)
+		}
+
+		unoptimized = append(
+			unoptimized,
+			parser.Parse(
+				parseutil.NewBufferedByteLocationReaderFromSlice(
+					fileName,
+					content),
+				emitter)...)
+
+		optimized = append(
+			optimized,
+			parser.Parse(
+				parseutil.NewBufferedByteLocationReaderFromSlice(
+					fileName,
+					content),
+				&parseutil.Emitter{})...)
+	}
+
+	result := interpreter.CheckOptimization(
+		unoptimized,
+		optimized,
+		targetPlatform,
+		emitter,
+		*numTrials,
+		rand.New(rand.NewSource(*seed)))
+
+	errs := emitter.Errors()
+	if len(errs) > 0 {
+		fmt.Println("Found", len(errs), "errors:")
+		for idx, err := range errs {
+			fmt.Printf("error %d: %s\n", idx, err)
+		}
+		os.Exit(1)
+	}
+
+	for _, label := range result.Skipped {
+		fmt.Printf("Skipped @%s (unsupported parameter / return types)\n", label)
+	}
+
+	for idx, mismatch := range result.Mismatches {
+		fmt.Printf("mismatch %d: %s\n", idx, mismatch)
+	}
+
+	fmt.Printf(
+		"Checked %d trials: %d mismatches\n",
+		result.NumTrials,
+		len(result.Mismatches))
+
+	if len(result.Mismatches) > 0 {
+		os.Exit(1)
+	}
+}
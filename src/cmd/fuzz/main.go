@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/fuzz"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func main() {
+	seed := flag.Int64("seed", 0, "random seed of the first generated program")
+	numPrograms := flag.Int(
+		"programs",
+		1000,
+		"number of generated programs (0 means unlimited)")
+	conventions := flag.String(
+		"conventions",
+		"",
+		"comma separated call conventions used by the generated programs "+
+			"(empty means all)")
+	crashFile := flag.String(
+		"crash-file",
+		"fuzz-crash.chi",
+		"the program being compiled is written to this file, and is removed "+
+			"once all programs compile")
+	printOnly := flag.Bool(
+		"print",
+		false,
+		"print the program generated from -seed without compiling")
+	flag.Parse()
+
+	newGenerator := func(seed int64) *fuzz.ProgramGenerator {
+		generator := fuzz.NewProgramGenerator(rand.New(rand.NewSource(seed)))
+		if *conventions != "" {
+			generator.CallConventions = nil
+			for _, name := range strings.Split(*conventions, ",") {
+				generator.CallConventions = append(
+					generator.CallConventions,
+					ast.CallConventionName(strings.TrimSpace(name)))
+			}
+		}
+		return generator
+	}
+
+	if *printOnly {
+		fmt.Print(fuzz.FormatSource(newGenerator(*seed).Generate()))
+		return
+	}
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+	for i := 0; *numPrograms == 0 || i < *numPrograms; i++ {
+		programSeed := *seed + int64(i)
+		entries := newGenerator(programSeed).Generate()
+
+		// Passes may panic in non-main goroutines, which can't be recovered.
+		// Write out the program prior to compiling to preserve the crashing
+		// program.
+		source := fmt.Sprintf(
+			"// seed: %d\n%s",
+			programSeed,
+			fuzz.FormatSource(entries))
+		err := os.WriteFile(*crashFile, []byte(source), 0644)
+		if err != nil {
+			fmt.Println("WriteFile error:", err)
+			os.Exit(1)
+		}
+
+		emitter := &parseutil.Emitter{}
+		fuzz.Compile(entries, targetPlatform, emitter)
+
+		errs := emitter.Errors()
+		if len(errs) > 0 {
+			fmt.Printf(
+				"Generated program (seed %d) has %d errors:\n",
+				programSeed,
+				len(errs))
+			for idx, err := range errs {
+				fmt.Printf("error %d: %s\n", idx, err)
+			}
+			fmt.Print(source)
+			os.Exit(1)
+		}
+	}
+
+	os.Remove(*crashFile)
+	fmt.Println("Compiled", *numPrograms, "programs")
+}
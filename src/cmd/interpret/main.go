@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/interpreter"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func main() {
+	entryLabel := flag.String("entry", "", "entry function label")
+	argsString := flag.String(
+		"args",
+		"",
+		"comma separated constant int / float / bool arguments passed to the "+
+			"entry function")
+	maxSteps := flag.Int(
+		"max-steps",
+		0,
+		"maximum number of executed instructions (0 means unlimited)")
+	optimize := flag.Bool(
+		"optimize",
+		false,
+		"interpret the optimized (instead of the unoptimized) ssa")
+	flag.Parse()
+
+	if *entryLabel == "" || flag.NArg() == 0 {
+		fmt.Println(
+			"Usage: interpret -entry <label> [-args <value>,...] " +
+				"[-max-steps <n>] [-optimize] <file> ...")
+		os.Exit(1)
+	}
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	emitter := &parseutil.Emitter{}
+	entries := []ast.SourceEntry{}
+	for _, fileName := range flag.Args() {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			fmt.Println("ReadFile error:", err)
+			os.Exit(1)
+		}
+
+		entries = append(
+			entries,
+			parser.Parse(
+				parseutil.NewBufferedByteLocationReaderFromSlice(
+					fileName,
+					content),
+				emitter)...)
+	}
+
+	analyzer.AnalyzeSemantics(entries, targetPlatform, emitter, *optimize)
+
+	errs := emitter.Errors()
+	if len(errs) > 0 {
+		fmt.Println("Found", len(errs), "errors:")
+		for idx, err := range errs {
+			fmt.Printf("error %d: %s\n", idx, err)
+		}
+		os.Exit(1)
+	}
+
+	args := []interface{}{}
+	if *argsString != "" {
+		for _, arg := range strings.Split(*argsString, ",") {
+			args = append(args, parseArg(strings.TrimSpace(arg)))
+		}
+	}
+
+	interp := interpreter.NewInterpreter(targetPlatform, entries)
+	interp.MaxSteps = *maxSteps
+
+	result, err := interp.Call(*entryLabel, args...)
+
+	for _, record := range interp.SysCalls {
+		fmt.Printf("syscall %d %v = %d\n", record.Number, record.Args, record.Result)
+	}
+
+	exitErr := &interpreter.ExitError{}
+	if errors.As(err, &exitErr) {
+		fmt.Println("Exit:", exitErr.Status)
+		os.Exit(int(exitErr.Status) & 0xff)
+	} else if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Result:", result)
+}
+
+// Ints are parsed as int64 (or uint64 if the value is too large), floats
+// are parsed as float64, and true / false are parsed as bool.
+func parseArg(arg string) interface{} {
+	intValue, err := strconv.ParseInt(arg, 0, 64)
+	if err == nil {
+		return intValue
+	}
+
+	uintValue, err := strconv.ParseUint(arg, 0, 64)
+	if err == nil {
+		return uintValue
+	}
+
+	floatValue, err := strconv.ParseFloat(arg, 64)
+	if err == nil {
+		return floatValue
+	}
+
+	boolValue, err := strconv.ParseBool(arg)
+	if err == nil {
+		return boolValue
+	}
+
+	fmt.Println("Invalid argument:", arg)
+	os.Exit(1)
+	return nil
+}
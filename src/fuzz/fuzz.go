@@ -0,0 +1,136 @@
+package fuzz
+
+import (
+	"math/rand"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/analyzer/allocator"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/interpreter"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+const (
+	numSeedPrograms = 32
+
+	numOptimizationTrials = 10
+)
+
+// Runs the sources through all analyzer passes, including register / stack
+// allocation, allocation verification and code generation (but excluding the
+// debug passes).  Errors are reported to the emitter.
+//
+// NOTE: Passes are executed in parallel goroutines.  Hence, a pass panic
+// crashes the process, and cannot be recovered by the caller.
+func Compile(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+	emitter *parseutil.Emitter,
+) {
+	analyzer.AnalyzeSemantics(sources, targetPlatform, emitter, true)
+	if emitter.HasErrors() {
+		return
+	}
+
+	funcDefs := []ast.SourceEntry{}
+	for _, entry := range sources {
+		_, ok := entry.(*ast.FunctionDefinition)
+		if ok {
+			funcDefs = append(funcDefs, entry)
+		}
+	}
+
+	allocators := analyzer.AllocateRegisters(sources, targetPlatform)
+	for idx, registerStackAllocator := range allocators {
+		allocator.VerifyAllocation(
+			registerStackAllocator,
+			emitter).Process(funcDefs[idx])
+
+		analyzer.GenerateCode(
+			registerStackAllocator,
+			&[]executable.LabelledSegment{}).Process(funcDefs[idx])
+	}
+}
+
+// Parses and compiles the source.  Invalid sources are not compiled.  This
+// returns the parse / analysis errors.
+func CompileSource(source []byte) []error {
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice("fuzz.chi", source),
+		emitter)
+	if emitter.HasErrors() {
+		return emitter.Errors()
+	}
+
+	Compile(entries, x64.NewPlatform(platform.Linux), emitter)
+	return emitter.Errors()
+}
+
+// Generates and compiles a random program.  This returns the generated
+// program's formatted source and the analysis errors.  Since generated
+// programs are always well-formed, any error indicates a generator bug.
+func CompileGeneratedProgram(
+	generator *ProgramGenerator,
+) (
+	string,
+	[]error,
+) {
+	entries := generator.Generate()
+
+	// The analyzer modifies the function definitions.  Format the source before
+	// compiling.
+	source := FormatSource(entries)
+
+	emitter := &parseutil.Emitter{}
+	Compile(entries, x64.NewPlatform(platform.Linux), emitter)
+	return source, emitter.Errors()
+}
+
+// Generates the same random program twice (from the same seed), and
+// cross-checks the optimized program against the unoptimized program (see
+// interpreter.CheckOptimization).  This returns the generated program's
+// formatted source and the check result, or the analysis errors.  Since
+// generated programs are always well-formed, any error or mismatch indicates a
+// bug.
+func CheckGeneratedProgramOptimization(
+	seed int64,
+) (
+	string,
+	*interpreter.CheckResult,
+	[]error,
+) {
+	unoptimized := NewProgramGenerator(rand.New(rand.NewSource(seed))).Generate()
+	optimized := NewProgramGenerator(rand.New(rand.NewSource(seed))).Generate()
+
+	// The analyzer modifies the function definitions.  Format the source before
+	// checking.
+	source := FormatSource(unoptimized)
+
+	emitter := &parseutil.Emitter{}
+	result := interpreter.CheckOptimization(
+		unoptimized,
+		optimized,
+		x64.NewPlatform(platform.Linux),
+		emitter,
+		numOptimizationTrials,
+		rand.New(rand.NewSource(seed)))
+	return source, result, emitter.Errors()
+}
+
+// Returns the formatted source of a few generated programs.  The generated
+// programs use all call conventions.
+func SeedSources() [][]byte {
+	result := make([][]byte, 0, numSeedPrograms)
+	for seed := int64(0); seed < numSeedPrograms; seed++ {
+		generator := NewProgramGenerator(rand.New(rand.NewSource(seed)))
+		result = append(result, []byte(FormatSource(generator.Generate())))
+	}
+
+	return result
+}
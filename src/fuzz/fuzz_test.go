@@ -0,0 +1,90 @@
+package fuzz
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Generated program seeds which previously crashed the allocator (the program
+// generator is restricted to the listed call conventions).
+var regressionSeeds = []struct {
+	seed        int64
+	conventions []ast.CallConventionName
+}{
+	{-96, allCallConventions},
+	{158, []ast.CallConventionName{ast.InternalCallerSavedCallConvention}},
+	{212, []ast.CallConventionName{ast.InternalCalleeSavedCallConvention}},
+}
+
+func TestRegressionSeeds(t *testing.T) {
+	for _, regression := range regressionSeeds {
+		generator := NewProgramGenerator(
+			rand.New(rand.NewSource(regression.seed)))
+		generator.CallConventions = regression.conventions
+
+		source, errs := CompileGeneratedProgram(generator)
+		if len(errs) > 0 {
+			t.Fatalf(
+				"generated program (seed %d) has errors: %v\n%s",
+				regression.seed,
+				errs,
+				source)
+		}
+	}
+}
+
+// Native go fuzz target for the parser and the analyzer.  Mutated sources are
+// mostly invalid, and are only compiled when they parse and type check.
+func FuzzSource(f *testing.F) {
+	for _, source := range SeedSources() {
+		f.Add(source)
+	}
+
+	f.Fuzz(func(t *testing.T, source []byte) {
+		CompileSource(source)
+	})
+}
+
+// Native go fuzz target for the analyzer.  The fuzz input is the program
+// generator's random seed.  Generated programs use all call conventions.
+func FuzzGeneratedProgram(f *testing.F) {
+	for seed := int64(0); seed < numSeedPrograms; seed++ {
+		f.Add(seed)
+	}
+	for _, regression := range regressionSeeds {
+		f.Add(regression.seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		generator := NewProgramGenerator(rand.New(rand.NewSource(seed)))
+		source, errs := CompileGeneratedProgram(generator)
+		if len(errs) > 0 {
+			t.Fatalf("generated program has errors: %v\n%s", errs, source)
+		}
+	})
+}
+
+// Native go fuzz target for the optimization passes.  The fuzz input is the
+// program generator's random seed.
+func FuzzOptimizedProgram(f *testing.F) {
+	for seed := int64(0); seed < numSeedPrograms; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		source, result, errs := CheckGeneratedProgramOptimization(seed)
+		if len(errs) > 0 {
+			t.Fatalf("generated program has errors: %v\n%s", errs, source)
+		}
+
+		for _, mismatch := range result.Mismatches {
+			t.Errorf("optimization mismatch: %s", mismatch)
+		}
+
+		if t.Failed() {
+			t.Logf("generated program:\n%s", source)
+		}
+	})
+}
@@ -0,0 +1,773 @@
+package fuzz
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+const (
+	defaultMaxFunctions         = 4
+	defaultMaxParameters        = 8
+	defaultMaxExtraVariables    = 12
+	defaultMaxStatements        = 6
+	defaultMaxNestingDepth      = 3
+	defaultMaxLoopIterations    = 4
+	defaultMaxFloatImmediateAbs = 1024
+)
+
+var (
+	allCallConventions = []ast.CallConventionName{
+		ast.InternalCallConvention,
+		ast.InternalCalleeSavedCallConvention,
+		ast.InternalCallerSavedCallConvention,
+		ast.SystemVLiteCallConvention,
+	}
+
+	intTypes = []func(parseutil.StartEndPos) ast.Type{
+		ast.NewI8,
+		ast.NewI16,
+		ast.NewI32,
+		ast.NewI64,
+		ast.NewU8,
+		ast.NewU16,
+		ast.NewU32,
+		ast.NewU64,
+	}
+
+	floatTypes = []func(parseutil.StartEndPos) ast.Type{
+		ast.NewF32,
+		ast.NewF64,
+	}
+
+	conversions = []ast.UnaryOperationKind{
+		ast.ToI8,
+		ast.ToI16,
+		ast.ToI32,
+		ast.ToI64,
+		ast.ToU8,
+		ast.ToU16,
+		ast.ToU32,
+		ast.ToU64,
+		ast.ToF32,
+		ast.ToF64,
+	}
+
+	intOnlyBinaryOperations = []ast.BinaryOperationKind{
+		ast.Rem,
+		ast.Xor,
+		ast.Or,
+		ast.And,
+		ast.Shl,
+		ast.Shr,
+	}
+
+	numberBinaryOperations = []ast.BinaryOperationKind{
+		ast.Add,
+		ast.Sub,
+		ast.Mul,
+		ast.Div,
+	}
+)
+
+// Generates random, but well-formed and well-typed, function definitions for
+// stress testing the parser and the analyzer passes.
+//
+// Every generated program is guaranteed to pass semantic analysis (i.e., any
+// emitted error is a generator bug), and to terminate when executed:
+//   - all variables are defined in the entry block, hence every use is
+//     dominated by a definition,
+//   - loops are bounded by dedicated counters which are not modified by the
+//     loop bodies, and
+//   - a function only calls functions that are generated after it (the call
+//     graph is acyclic).
+type ProgramGenerator struct {
+	random *rand.Rand
+
+	// Call conventions are assigned to the generated functions uniformly at
+	// random.  By default, all supported call conventions are used.
+	CallConventions []ast.CallConventionName
+
+	MaxFunctions  int
+	MaxParameters int
+
+	// The number of variables defined in the entry block, in addition to the
+	// parameters and the one-per-type variables.
+	MaxExtraVariables int
+
+	// Maximum number of statements in each straight-line run.
+	MaxStatements int
+
+	// Maximum nesting depth of loops and branches.
+	MaxNestingDepth int
+
+	MaxLoopIterations int
+}
+
+func NewProgramGenerator(random *rand.Rand) *ProgramGenerator {
+	return &ProgramGenerator{
+		random:            random,
+		CallConventions:   allCallConventions,
+		MaxFunctions:      defaultMaxFunctions,
+		MaxParameters:     defaultMaxParameters,
+		MaxExtraVariables: defaultMaxExtraVariables,
+		MaxStatements:     defaultMaxStatements,
+		MaxNestingDepth:   defaultMaxNestingDepth,
+		MaxLoopIterations: defaultMaxLoopIterations,
+	}
+}
+
+// Generates a program with [1, MaxFunctions] function definitions.  The
+// function definitions are fresh (unanalyzed) ast nodes.
+func (generator *ProgramGenerator) Generate() []ast.SourceEntry {
+	numFuncs := 1 + generator.random.Intn(generator.MaxFunctions)
+
+	funcDefs := make([]*ast.FunctionDefinition, 0, numFuncs)
+	for idx := 0; idx < numFuncs; idx++ {
+		funcDefs = append(funcDefs, generator.generateSignature(idx))
+	}
+
+	result := make([]ast.SourceEntry, 0, numFuncs)
+	for idx, funcDef := range funcDefs {
+		builder := &functionBuilder{
+			ProgramGenerator:   generator,
+			FunctionDefinition: funcDef,
+			callees:            funcDefs[idx+1:],
+			variables:          map[string][]*variable{},
+		}
+		builder.build()
+		result = append(result, funcDef)
+	}
+
+	return result
+}
+
+func (generator *ProgramGenerator) generateSignature(
+	idx int,
+) *ast.FunctionDefinition {
+	params := []*ast.VariableDefinition{}
+	numParams := generator.random.Intn(generator.MaxParameters + 1)
+	for i := 0; i < numParams; i++ {
+		params = append(
+			params,
+			&ast.VariableDefinition{
+				Name: fmt.Sprintf("p%d", i),
+				Type: generator.randomNumberType(),
+			})
+	}
+
+	return &ast.FunctionDefinition{
+		CallConventionName: generator.CallConventions[generator.random.Intn(
+			len(generator.CallConventions))],
+		Label:      fmt.Sprintf("f%d", idx),
+		Parameters: params,
+		ReturnType: generator.randomNumberType(),
+	}
+}
+
+func (generator *ProgramGenerator) randomNumberType() ast.Type {
+	if generator.random.Intn(4) == 0 {
+		return floatTypes[generator.random.Intn(len(floatTypes))](
+			parseutil.StartEndPos{})
+	}
+	return intTypes[generator.random.Intn(len(intTypes))](
+		parseutil.StartEndPos{})
+}
+
+// Random values are biased toward small values and edge cases.
+func (generator *ProgramGenerator) randomImmediate(
+	valueType ast.Type,
+) ast.Value {
+	pos := parseutil.StartEndPos{}
+
+	floatType, ok := valueType.(*ast.FloatType)
+	if ok {
+		value := 0.0
+		switch generator.random.Intn(3) {
+		case 0:
+			value = float64(generator.random.Intn(9) - 4)
+		case 1:
+			value = float64(generator.random.Intn(33)-16) / 4
+		default:
+			value = (generator.random.Float64()*2 - 1) * defaultMaxFloatImmediateAbs
+		}
+
+		if floatType.Kind == ast.F32 {
+			value = float64(float32(value))
+		}
+
+		return &ast.FloatImmediate{
+			StartEndPos: pos,
+			Value:       value,
+		}
+	}
+
+	var min uint64 // magnitude of the smallest negative value
+	var max uint64
+	switch intType := valueType.(type) {
+	case *ast.SignedIntType:
+		size := map[ast.SignedIntTypeKind]int{
+			ast.I8:  8,
+			ast.I16: 16,
+			ast.I32: 32,
+			ast.I64: 64,
+		}[intType.Kind]
+		min = uint64(1) << (size - 1)
+		max = min - 1
+	case *ast.UnsignedIntType:
+		size := map[ast.UnsignedIntTypeKind]int{
+			ast.U8:  8,
+			ast.U16: 16,
+			ast.U32: 32,
+			ast.U64: 64,
+		}[intType.Kind]
+		max = math.MaxUint64 >> (64 - size)
+	default:
+		panic("should never happen")
+	}
+
+	switch generator.random.Intn(4) {
+	case 0: // edge cases
+		switch generator.random.Intn(3) {
+		case 0:
+			return ast.NewIntImmediate(pos, 0, false)
+		case 1:
+			return ast.NewIntImmediate(pos, max, false)
+		default:
+			if min == 0 {
+				return ast.NewIntImmediate(pos, 1, false)
+			}
+			return ast.NewIntImmediate(pos, min, true)
+		}
+	case 1: // full range
+		if min > 0 && generator.random.Intn(2) == 0 {
+			return ast.NewIntImmediate(pos, 1+generator.random.Uint64()%min, true)
+		}
+		if max == math.MaxUint64 {
+			return ast.NewIntImmediate(pos, generator.random.Uint64(), false)
+		}
+		return ast.NewIntImmediate(pos, generator.random.Uint64()%(max+1), false)
+	default: // small values
+		value := uint64(generator.random.Intn(17))
+		if min > 0 && generator.random.Intn(3) == 0 && value > 0 {
+			return ast.NewIntImmediate(pos, value, true)
+		}
+		return ast.NewIntImmediate(pos, value, false)
+	}
+}
+
+type variable struct {
+	name      string
+	valueType ast.Type
+}
+
+// Builds a single function definition's body.
+type functionBuilder struct {
+	*ProgramGenerator
+	*ast.FunctionDefinition
+
+	// Functions which may be called by this function.
+	callees []*ast.FunctionDefinition
+
+	// Assignable variables, grouped by type name.  Loop counters and function
+	// value variables are not assignable, and are not part of this mapping.
+	variables map[string][]*variable
+	typeNames []string // sorted by definition order for determinism
+
+	currentBlock *ast.Block
+
+	numLabels int
+	numTemps  int
+}
+
+func (builder *functionBuilder) build() {
+	builder.startBlock("")
+
+	for _, param := range builder.Parameters {
+		builder.addVariable(param.Name, param.Type)
+	}
+
+	// Ensure every int / float type has at least one variable, which can be
+	// used as operation destination.
+	typeConstructors := append(append([]func(parseutil.StartEndPos) ast.Type{},
+		intTypes...), floatTypes...)
+	for idx, newType := range typeConstructors {
+		builder.defineVariable(
+			fmt.Sprintf("v%d", idx),
+			newType(parseutil.StartEndPos{}))
+	}
+
+	numExtra := builder.random.Intn(builder.MaxExtraVariables + 1)
+	for i := 0; i < numExtra; i++ {
+		builder.defineVariable(
+			fmt.Sprintf("v%d", len(typeConstructors)+i),
+			builder.randomNumberType())
+	}
+
+	builder.generateStatements(0)
+	builder.generateReturn()
+}
+
+func (builder *functionBuilder) addVariable(name string, valueType ast.Type) {
+	typeName := valueType.String()
+	vars, ok := builder.variables[typeName]
+	if !ok {
+		builder.typeNames = append(builder.typeNames, typeName)
+	}
+	builder.variables[typeName] = append(vars, &variable{
+		name:      name,
+		valueType: valueType,
+	})
+}
+
+func (builder *functionBuilder) defineVariable(
+	name string,
+	valueType ast.Type,
+) {
+	builder.addVariable(name, valueType)
+	builder.emit(&ast.CopyOperation{
+		Dest: &ast.VariableDefinition{
+			Name: name,
+			Type: valueType,
+		},
+		Src: builder.randomImmediate(valueType),
+	})
+}
+
+func (builder *functionBuilder) newLabel(prefix string) string {
+	builder.numLabels++
+	return fmt.Sprintf("%s_%d", prefix, builder.numLabels)
+}
+
+func (builder *functionBuilder) newTempName(prefix string) string {
+	builder.numTemps++
+	return fmt.Sprintf("%s%d", prefix, builder.numTemps)
+}
+
+// The ssa constructor requires a variable to be defined in all parent blocks
+// of a merge point, even when the variable is not live at the merge point.
+// Hence, non-assignable variables which are defined inside loops / branches
+// are also defined at the beginning of the entry block.
+func (builder *functionBuilder) defineInEntryBlock(inst ast.Instruction) {
+	entryBlock := builder.Blocks[0]
+	entryBlock.Instructions = append(
+		[]ast.Instruction{inst},
+		entryBlock.Instructions...)
+}
+
+// Every block must have at least one instruction.  If the current block is
+// empty, a filler statement is added to the block before starting a new block.
+func (builder *functionBuilder) startBlock(label string) {
+	if builder.currentBlock != nil &&
+		len(builder.currentBlock.Instructions) == 0 {
+
+		builder.generateOperation()
+	}
+
+	builder.currentBlock = &ast.Block{
+		Label: label,
+	}
+	builder.Blocks = append(builder.Blocks, builder.currentBlock)
+}
+
+func (builder *functionBuilder) emit(inst ast.Instruction) {
+	if builder.currentBlock == nil {
+		panic("should never happen")
+	}
+
+	builder.currentBlock.Instructions = append(
+		builder.currentBlock.Instructions,
+		inst)
+
+	_, ok := inst.(ast.ControlFlowInstruction)
+	if ok {
+		builder.currentBlock = nil
+	}
+}
+
+func (builder *functionBuilder) randomVariable(valueType ast.Type) *variable {
+	vars := builder.variables[valueType.String()]
+	return vars[builder.random.Intn(len(vars))]
+}
+
+func (builder *functionBuilder) randomVariableOfAnyType() *variable {
+	typeName := builder.typeNames[builder.random.Intn(len(builder.typeNames))]
+	vars := builder.variables[typeName]
+	return vars[builder.random.Intn(len(vars))]
+}
+
+func (builder *functionBuilder) reference(v *variable) ast.Value {
+	return &ast.VariableReference{
+		Name: v.name,
+	}
+}
+
+// Returns either a variable reference or an immediate of the given type.
+func (builder *functionBuilder) randomValue(valueType ast.Type) ast.Value {
+	if builder.random.Intn(3) == 0 {
+		return builder.randomImmediate(valueType)
+	}
+	return builder.reference(builder.randomVariable(valueType))
+}
+
+// The destination is randomly explicitly typed.
+func (builder *functionBuilder) destination(
+	v *variable,
+) *ast.VariableDefinition {
+	def := &ast.VariableDefinition{
+		Name: v.name,
+	}
+
+	if builder.random.Intn(2) == 0 {
+		def.Type = v.valueType
+	}
+
+	return def
+}
+
+func (builder *functionBuilder) generateStatements(depth int) {
+	numStatements := 1 + builder.random.Intn(builder.MaxStatements)
+	for i := 0; i < numStatements; i++ {
+		if depth < builder.MaxNestingDepth {
+			switch builder.random.Intn(8) {
+			case 0:
+				builder.generateLoop(depth)
+				continue
+			case 1:
+				builder.generateDoWhileLoop(depth)
+				continue
+			case 2:
+				builder.generateBranch(depth)
+				continue
+			}
+		}
+
+		if len(builder.callees) > 0 && builder.random.Intn(5) == 0 {
+			builder.generateCall()
+		} else {
+			builder.generateOperation()
+		}
+	}
+}
+
+func (builder *functionBuilder) generateOperation() {
+	switch builder.random.Intn(6) {
+	case 0:
+		dest := builder.randomVariableOfAnyType()
+		src := builder.randomValue(dest.valueType)
+
+		// Immediate copy's destination must be explicitly typed.
+		destDef := builder.destination(dest)
+		_, ok := src.(*ast.VariableReference)
+		if !ok {
+			destDef.Type = dest.valueType
+		}
+
+		builder.emit(&ast.CopyOperation{
+			Dest: destDef,
+			Src:  src,
+		})
+	case 1:
+		builder.generateUnaryOperation()
+	default:
+		builder.generateBinaryOperation()
+	}
+}
+
+func (builder *functionBuilder) generateUnaryOperation() {
+	src := builder.randomVariableOfAnyType()
+
+	kinds := append([]ast.UnaryOperationKind{}, conversions...)
+	if ast.IsIntSubType(src.valueType) {
+		kinds = append(kinds, ast.Not, ast.Not, ast.Not)
+	}
+	if ast.IsSignedIntSubType(src.valueType) {
+		kinds = append(kinds, ast.Neg, ast.Neg, ast.Neg)
+	}
+
+	kind := kinds[builder.random.Intn(len(kinds))]
+
+	destType := src.valueType
+	switch kind {
+	case ast.Neg, ast.Not:
+	default:
+		destType = map[ast.UnaryOperationKind]ast.Type{
+			ast.ToI8:  ast.NewI8(parseutil.StartEndPos{}),
+			ast.ToI16: ast.NewI16(parseutil.StartEndPos{}),
+			ast.ToI32: ast.NewI32(parseutil.StartEndPos{}),
+			ast.ToI64: ast.NewI64(parseutil.StartEndPos{}),
+			ast.ToU8:  ast.NewU8(parseutil.StartEndPos{}),
+			ast.ToU16: ast.NewU16(parseutil.StartEndPos{}),
+			ast.ToU32: ast.NewU32(parseutil.StartEndPos{}),
+			ast.ToU64: ast.NewU64(parseutil.StartEndPos{}),
+			ast.ToF32: ast.NewF32(parseutil.StartEndPos{}),
+			ast.ToF64: ast.NewF64(parseutil.StartEndPos{}),
+		}[kind]
+	}
+
+	builder.emit(&ast.UnaryOperation{
+		Kind: kind,
+		Dest: builder.destination(builder.randomVariable(destType)),
+		Src:  builder.reference(src),
+	})
+}
+
+func (builder *functionBuilder) generateBinaryOperation() {
+	dest := builder.randomVariableOfAnyType()
+
+	kinds := numberBinaryOperations
+	if ast.IsIntSubType(dest.valueType) {
+		kinds = append(append([]ast.BinaryOperationKind{}, kinds...),
+			intOnlyBinaryOperations...)
+	}
+
+	kind := kinds[builder.random.Intn(len(kinds))]
+
+	// At least one of the sources must be a variable in order to infer the
+	// operation type.  Shift value must be a variable since the shift count
+	// does not participate in type inference.
+	var src1 ast.Value
+	var src2 ast.Value
+	switch kind {
+	case ast.Shl, ast.Shr:
+		src1 = builder.reference(builder.randomVariable(dest.valueType))
+
+		u8 := ast.NewU8(parseutil.StartEndPos{})
+		if builder.random.Intn(2) == 0 {
+			src2 = builder.reference(builder.randomVariable(u8))
+		} else {
+			src2 = ast.NewIntImmediate(
+				parseutil.StartEndPos{},
+				uint64(builder.random.Intn(72)),
+				false)
+		}
+	default:
+		src1 = builder.reference(builder.randomVariable(dest.valueType))
+		src2 = builder.randomValue(dest.valueType)
+		if builder.random.Intn(4) == 0 {
+			src1, src2 = src2, src1
+		}
+	}
+
+	builder.emit(&ast.BinaryOperation{
+		Kind: kind,
+		Dest: builder.destination(dest),
+		Src1: src1,
+		Src2: src2,
+	})
+}
+
+func (builder *functionBuilder) generateCall() {
+	callee := builder.callees[builder.random.Intn(len(builder.callees))]
+
+	var funcValue ast.Value = &ast.GlobalLabelReference{
+		Label: callee.Label,
+	}
+
+	// Occasionally call through a function value variable.
+	if builder.random.Intn(4) == 0 {
+		name := builder.newTempName("fn")
+		builder.defineInEntryBlock(&ast.CopyOperation{
+			Dest: &ast.VariableDefinition{
+				Name: name,
+			},
+			Src: funcValue,
+		})
+		funcValue = &ast.VariableReference{
+			Name: name,
+		}
+	}
+
+	args := make([]ast.Value, 0, len(callee.Parameters))
+	for _, param := range callee.Parameters {
+		args = append(args, builder.randomValue(param.Type))
+	}
+
+	builder.emit(&ast.FuncCall{
+		Kind: ast.Call,
+		Dest: builder.destination(builder.randomVariable(callee.ReturnType)),
+		Func: funcValue,
+		Args: args,
+	})
+}
+
+// Returns a random conditional jump to the given label.  Note that floats are
+// ordered, but not comparable.
+func (builder *functionBuilder) randomConditionalJump(
+	label string,
+) *ast.ConditionalJump {
+	src := builder.randomVariableOfAnyType()
+
+	kinds := []ast.ConditionalJumpKind{ast.Jlt, ast.Jge}
+	if ast.IsIntSubType(src.valueType) {
+		kinds = append(kinds, ast.Jeq, ast.Jne)
+	}
+
+	var src1 ast.Value = builder.reference(src)
+	src2 := builder.randomValue(src.valueType)
+	if builder.random.Intn(4) == 0 {
+		src1, src2 = src2, src1
+	}
+
+	return &ast.ConditionalJump{
+		Kind:  kinds[builder.random.Intn(len(kinds))],
+		Label: label,
+		Src1:  src1,
+		Src2:  src2,
+	}
+}
+
+// Generates a branch of the form:
+//
+//	  <jcc> :else, <src1>, <src2>
+//	  <then statements>
+//	  jmp :endif  (or ret <value>)
+//	:else
+//	  <else statements>
+//	:endif
+//
+// Only the then branch may return early, hence the endif block is always
+// reachable.
+func (builder *functionBuilder) generateBranch(depth int) {
+	elseLabel := builder.newLabel("else")
+	endifLabel := builder.newLabel("endif")
+
+	builder.emit(builder.randomConditionalJump(elseLabel))
+
+	builder.startBlock("")
+	builder.generateStatements(depth + 1)
+
+	if builder.random.Intn(5) == 0 {
+		builder.generateReturn()
+	} else {
+		builder.emit(&ast.Jump{
+			Label: endifLabel,
+		})
+	}
+
+	builder.startBlock(elseLabel)
+	if builder.random.Intn(4) != 0 {
+		builder.generateStatements(depth + 1)
+	}
+
+	builder.startBlock(endifLabel)
+}
+
+// Returns a new loop counter variable, initialized with the number of loop
+// iterations.
+func (builder *functionBuilder) defineLoopCounter(minIterations int) *variable {
+	iterations := minIterations + builder.random.Intn(
+		builder.MaxLoopIterations-minIterations+1)
+
+	// All int types can hold up to math.MaxInt8 iterations.
+	counterType := ast.NewI64(parseutil.StartEndPos{})
+	if iterations <= math.MaxInt8 {
+		counterType = intTypes[builder.random.Intn(len(intTypes))](
+			parseutil.StartEndPos{})
+	}
+
+	counter := &variable{
+		name:      builder.newTempName("cnt"),
+		valueType: counterType,
+	}
+	builder.defineInEntryBlock(&ast.CopyOperation{
+		Dest: &ast.VariableDefinition{
+			Name: counter.name,
+			Type: counter.valueType,
+		},
+		Src: ast.NewIntImmediate(parseutil.StartEndPos{}, 0, false),
+	})
+
+	builder.emit(&ast.CopyOperation{
+		Dest: &ast.VariableDefinition{
+			Name: counter.name,
+			Type: counter.valueType,
+		},
+		Src: ast.NewIntImmediate(
+			parseutil.StartEndPos{},
+			uint64(iterations),
+			false),
+	})
+
+	return counter
+}
+
+func (builder *functionBuilder) decrementLoopCounter(counter *variable) {
+	builder.emit(&ast.BinaryOperation{
+		Kind: ast.Sub,
+		Dest: &ast.VariableDefinition{
+			Name: counter.name,
+		},
+		Src1: builder.reference(counter),
+		Src2: ast.NewIntImmediate(parseutil.StartEndPos{}, 1, false),
+	})
+}
+
+// Generates a loop of the form:
+//
+//	  <counter> = <iterations>
+//	:loop
+//	  jeq :exit, <counter>, 0
+//	  <body statements>
+//	  <counter> = sub <counter>, 1
+//	  jmp :loop
+//	:exit
+func (builder *functionBuilder) generateLoop(depth int) {
+	counter := builder.defineLoopCounter(0)
+
+	loopLabel := builder.newLabel("loop")
+	exitLabel := builder.newLabel("loop_exit")
+
+	builder.startBlock(loopLabel)
+	builder.emit(&ast.ConditionalJump{
+		Kind:  ast.Jeq,
+		Label: exitLabel,
+		Src1:  builder.reference(counter),
+		Src2:  ast.NewIntImmediate(parseutil.StartEndPos{}, 0, false),
+	})
+
+	builder.startBlock(builder.newLabel("loop_body"))
+	builder.generateStatements(depth + 1)
+	builder.decrementLoopCounter(counter)
+	builder.emit(&ast.Jump{
+		Label: loopLabel,
+	})
+
+	builder.startBlock(exitLabel)
+}
+
+// Generates a loop of the form:
+//
+//	  <counter> = <iterations>  (at least one)
+//	:loop
+//	  <body statements>
+//	  <counter> = sub <counter>, 1
+//	  jne :loop, <counter>, 0
+func (builder *functionBuilder) generateDoWhileLoop(depth int) {
+	counter := builder.defineLoopCounter(1)
+
+	loopLabel := builder.newLabel("do_loop")
+
+	builder.startBlock(loopLabel)
+	builder.generateStatements(depth + 1)
+	builder.decrementLoopCounter(counter)
+	builder.emit(&ast.ConditionalJump{
+		Kind:  ast.Jne,
+		Label: loopLabel,
+		Src1:  builder.reference(counter),
+		Src2:  ast.NewIntImmediate(parseutil.StartEndPos{}, 0, false),
+	})
+
+	builder.startBlock("")
+}
+
+func (builder *functionBuilder) generateReturn() {
+	builder.emit(&ast.Terminal{
+		Kind:   ast.Ret,
+		RetVal: builder.randomValue(builder.ReturnType),
+	})
+}
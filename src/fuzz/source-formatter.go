@@ -0,0 +1,299 @@
+package fuzz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Formats unanalyzed function / data definitions and function declarations as
+// .chi source.
+func FormatSource(entries []ast.SourceEntry) string {
+	builder := &strings.Builder{}
+	for idx, entry := range entries {
+		if idx > 0 {
+			builder.WriteString("\n")
+		}
+
+		switch def := entry.(type) {
+		case *ast.FunctionDefinition:
+			formatFunctionDefinition(builder, def)
+		case *ast.DataDefinition:
+			formatDataDefinition(builder, def)
+		case *ast.FunctionDeclaration:
+			formatFunctionDeclaration(builder, def)
+		default:
+			panic(fmt.Sprintf("unhandled source entry: %s", entry.Loc()))
+		}
+	}
+
+	return builder.String()
+}
+
+func formatDataDefinition(
+	builder *strings.Builder,
+	dataDef *ast.DataDefinition,
+) {
+	kind := "data"
+	if dataDef.IsMutable {
+		kind = "var"
+	}
+
+	values := []string{}
+	for _, value := range dataDef.Values {
+		switch data := value.(type) {
+		case *ast.ImmediateData:
+			formatted := formatValue(data.Value)
+			if data.Count != 1 {
+				formatted = fmt.Sprintf("%s * %d", formatted, data.Count)
+			}
+			values = append(values, formatted)
+		case *ast.StringData:
+			values = append(values, strconv.Quote(data.Value))
+		default:
+			panic(fmt.Sprintf("unhandled data value: %s", value.Loc()))
+		}
+	}
+
+	fmt.Fprintf(
+		builder,
+		"define %s @%s %s = %s\n",
+		kind,
+		formatIdentifier(dataDef.Label),
+		dataDef.ElementType,
+		strings.Join(values, ", "))
+}
+
+func formatFunctionDeclaration(
+	builder *strings.Builder,
+	funcDecl *ast.FunctionDeclaration,
+) {
+	paramTypes := []string{}
+	for _, paramType := range funcDecl.ParameterTypes {
+		paramTypes = append(paramTypes, paramType.String())
+	}
+
+	fmt.Fprintf(
+		builder,
+		"declare func{%s} @%s(%s) %s\n",
+		funcDecl.CallConventionName,
+		formatIdentifier(funcDecl.Label),
+		strings.Join(paramTypes, ", "),
+		funcDecl.ReturnType)
+}
+
+func formatFunctionDefinition(
+	builder *strings.Builder,
+	funcDef *ast.FunctionDefinition,
+) {
+	fmt.Fprintf(
+		builder,
+		"define func{%s} @%s(",
+		funcDef.CallConventionName,
+		formatIdentifier(funcDef.Label))
+	if len(funcDef.Parameters) > 0 {
+		builder.WriteString("\n")
+		for _, param := range funcDef.Parameters {
+			fmt.Fprintf(builder, "  %s,\n", formatVariableDefinition(param))
+		}
+	}
+	fmt.Fprintf(builder, ") %s {\n", funcDef.ReturnType)
+
+	for _, block := range funcDef.Blocks {
+		if block.Label != "" {
+			fmt.Fprintf(builder, ":%s\n", formatIdentifier(block.Label))
+		}
+
+		for _, inst := range block.Instructions {
+			fmt.Fprintf(builder, "  %s\n", formatInstruction(inst))
+		}
+	}
+
+	builder.WriteString("}\n")
+}
+
+func formatInstruction(in ast.Instruction) string {
+	switch inst := in.(type) {
+	case *ast.CopyOperation:
+		return fmt.Sprintf(
+			"%s = %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Src))
+	case *ast.UnaryOperation:
+		return fmt.Sprintf(
+			"%s = %s %s",
+			formatVariableDefinition(inst.Dest),
+			inst.Kind,
+			formatValue(inst.Src))
+	case *ast.BinaryOperation:
+		return fmt.Sprintf(
+			"%s = %s %s, %s",
+			formatVariableDefinition(inst.Dest),
+			inst.Kind,
+			formatValue(inst.Src1),
+			formatValue(inst.Src2))
+	case *ast.LoadOperation:
+		return fmt.Sprintf(
+			"%s = load %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Address))
+	case *ast.StoreOperation:
+		return fmt.Sprintf(
+			"store %s, %s",
+			formatValue(inst.Address),
+			formatValue(inst.Src))
+	case *ast.ExtractOperation:
+		return fmt.Sprintf(
+			"%s = extract %s, %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Src),
+			formatIdentifier(inst.Field))
+	case *ast.InsertOperation:
+		return fmt.Sprintf(
+			"%s = insert %s, %s, %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Src),
+			formatIdentifier(inst.Field),
+			formatValue(inst.Value))
+	case *ast.GetElementOperation:
+		return fmt.Sprintf(
+			"%s = getelem %s, %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Src),
+			formatValue(inst.Index))
+	case *ast.SetElementOperation:
+		return fmt.Sprintf(
+			"%s = setelem %s, %s, %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Src),
+			formatValue(inst.Index),
+			formatValue(inst.Value))
+	case *ast.SelectOperation:
+		return fmt.Sprintf(
+			"%s = select %s, %s, %s",
+			formatVariableDefinition(inst.Dest),
+			formatValue(inst.Cond),
+			formatValue(inst.TrueValue),
+			formatValue(inst.FalseValue))
+	case *ast.FuncCall:
+		args := make([]string, 0, len(inst.Args))
+		for _, arg := range inst.Args {
+			args = append(args, formatValue(arg))
+		}
+
+		call := fmt.Sprintf(
+			"%s %s(%s)",
+			inst.Kind,
+			formatValue(inst.Func),
+			strings.Join(args, ", "))
+		if inst.Dest != nil {
+			return formatVariableDefinition(inst.Dest) + " = " + call
+		} else if len(inst.TupleDests) > 0 {
+			dests := make([]string, 0, len(inst.TupleDests))
+			for _, dest := range inst.TupleDests {
+				dests = append(dests, formatVariableDefinition(dest))
+			}
+			return strings.Join(dests, ", ") + " = " + call
+		}
+		return call
+	case *ast.Jump:
+		return fmt.Sprintf("jmp :%s", formatIdentifier(inst.Label))
+	case *ast.ConditionalJump:
+		return fmt.Sprintf(
+			"%s :%s, %s, %s",
+			inst.Kind,
+			formatIdentifier(inst.Label),
+			formatValue(inst.Src1),
+			formatValue(inst.Src2))
+	case *ast.Switch:
+		cases := make([]string, 0, len(inst.Cases))
+		for _, switchCase := range inst.Cases {
+			cases = append(
+				cases,
+				fmt.Sprintf(
+					"%s: :%s",
+					formatValue(switchCase.Value),
+					formatIdentifier(switchCase.Label)))
+		}
+		return fmt.Sprintf(
+			"switch %s, :%s, [%s]",
+			formatValue(inst.Src),
+			formatIdentifier(inst.DefaultLabel),
+			strings.Join(cases, ", "))
+	case *ast.TailCall:
+		args := make([]string, 0, len(inst.Args))
+		for _, arg := range inst.Args {
+			args = append(args, formatValue(arg))
+		}
+		return fmt.Sprintf(
+			"tailcall %s(%s)",
+			formatValue(inst.Func),
+			strings.Join(args, ", "))
+	case *ast.Terminal:
+		if inst.RetVal == nil {
+			vals := make([]string, 0, len(inst.TupleRetVals))
+			for _, val := range inst.TupleRetVals {
+				vals = append(vals, formatValue(val))
+			}
+			return fmt.Sprintf("%s %s", inst.Kind, strings.Join(vals, ", "))
+		}
+		return fmt.Sprintf("%s %s", inst.Kind, formatValue(inst.RetVal))
+	default:
+		panic(fmt.Sprintf("unhandled instruction: %s", in.Loc()))
+	}
+}
+
+func formatVariableDefinition(def *ast.VariableDefinition) string {
+	result := "%" + formatIdentifier(def.Name)
+	if def.Type != nil {
+		result += " " + def.Type.String()
+	}
+	return result
+}
+
+func formatValue(value ast.Value) string {
+	switch val := value.(type) {
+	case *ast.VariableReference:
+		return "%" + formatIdentifier(val.Name)
+	case *ast.GlobalLabelReference:
+		return "@" + formatIdentifier(val.Label)
+	case *ast.IntImmediate:
+		return val.String()
+	case *ast.FloatImmediate:
+		// Float literals must be distinguishable from int literals.
+		result := strconv.FormatFloat(val.Value, 'f', -1, 64)
+		if !strings.Contains(result, ".") {
+			result += ".0"
+		}
+		return result
+	case *ast.BoolImmediate:
+		return val.String()
+	case *ast.ZeroImmediate:
+		return "zero"
+	default:
+		panic(fmt.Sprintf("unhandled value: %s", value.Loc()))
+	}
+}
+
+// Non-identifier names are formatted as string literals.
+func formatIdentifier(name string) string {
+	for idx, char := range name {
+		if char == '_' ||
+			('a' <= char && char <= 'z') ||
+			('A' <= char && char <= 'Z') ||
+			(idx > 0 && '0' <= char && char <= '9') {
+
+			continue
+		}
+
+		return strconv.Quote(name)
+	}
+
+	if name == "" {
+		return strconv.Quote(name)
+	}
+
+	return name
+}
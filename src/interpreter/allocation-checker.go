@@ -0,0 +1,320 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+const (
+	defaultCheckMaxSteps = 1000000
+)
+
+type Mismatch struct {
+	Label string
+	Args  []Value
+
+	Expected string
+	Actual   string
+}
+
+func (mismatch *Mismatch) String() string {
+	return fmt.Sprintf(
+		"@%s(%s): expected %s, actual %s",
+		mismatch.Label,
+		mismatch.formatArgs(),
+		mismatch.Expected,
+		mismatch.Actual)
+}
+
+func (mismatch *Mismatch) formatArgs() string {
+	args := make([]string, 0, len(mismatch.Args))
+	for _, arg := range mismatch.Args {
+		args = append(args, fmt.Sprintf("%#x", uint64(arg)))
+	}
+	return strings.Join(args, ", ")
+}
+
+// The result of a differential check (see CheckAllocation and
+// CheckOptimization).
+type CheckResult struct {
+	NumTrials int
+
+	// Functions which are not checked since the checker can't generate their
+	// arguments.
+	Skipped []string
+
+	Mismatches []*Mismatch
+}
+
+type callOutcome struct {
+	result   Value
+	err      error
+	sysCalls []SysCallRecord
+}
+
+func (outcome callOutcome) String() string {
+	result := ""
+
+	exitErr := &ExitError{}
+	if errors.As(outcome.err, &exitErr) {
+		result = fmt.Sprintf("exit(%d)", exitErr.Status)
+	} else if outcome.err != nil {
+		result = fmt.Sprintf("error(%s)", outcome.err)
+	} else {
+		result = fmt.Sprintf("%#x", uint64(outcome.result))
+	}
+
+	for _, record := range outcome.sysCalls {
+		result += fmt.Sprintf(
+			" [syscall %d %v = %d]",
+			record.Number,
+			record.Args,
+			record.Result)
+	}
+
+	return result
+}
+
+func (outcome callOutcome) equals(other callOutcome) bool {
+	if outcome.err != nil || other.err != nil {
+		if outcome.err == nil || other.err == nil {
+			return false
+		}
+
+		// Both runs share the same operation evaluators, hence equivalent
+		// errors have identical messages.
+		if outcome.err.Error() != other.err.Error() {
+			return false
+		}
+	} else if outcome.result != other.result {
+		return false
+	}
+
+	if len(outcome.sysCalls) != len(other.sysCalls) {
+		return false
+	}
+
+	for idx, record := range outcome.sysCalls {
+		otherRecord := other.sysCalls[idx]
+		if record.Number != otherRecord.Number ||
+			record.Result != otherRecord.Result ||
+			len(record.Args) != len(otherRecord.Args) {
+
+			return false
+		}
+
+		for argIdx, arg := range record.Args {
+			if arg != otherRecord.Args[argIdx] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+type checkTrial struct {
+	label    string
+	args     []Value
+	expected callOutcome
+}
+
+// Calls each scalar function definition with numTrials random argument
+// vectors on the interpreter, and records the outcomes as the expected
+// outcomes.  Inconclusive calls (e.g., due to step limit) are not recorded.
+func (result *CheckResult) generateTrials(
+	sources []ast.SourceEntry,
+	interpreter *Interpreter,
+	numTrials int,
+	random *rand.Rand,
+) []checkTrial {
+	trials := []checkTrial{}
+	for _, entry := range sources {
+		funcDef, ok := entry.(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+
+		if !canGenerateValues(funcDef.FuncType) {
+			result.Skipped = append(result.Skipped, funcDef.Label)
+			continue
+		}
+
+		for i := 0; i < numTrials; i++ {
+			args := make([]Value, 0, len(funcDef.FuncType.ParameterTypes))
+			for _, paramType := range funcDef.FuncType.ParameterTypes {
+				args = append(args, randomValue(paramType, random))
+			}
+
+			outcome := interpretCall(interpreter, funcDef.Label, args)
+			if isInconclusive(outcome.err) {
+				continue
+			}
+
+			trials = append(
+				trials,
+				checkTrial{
+					label:    funcDef.Label,
+					args:     args,
+					expected: outcome,
+				})
+		}
+	}
+
+	return trials
+}
+
+// Reruns the trials with the given call function, and records the outcomes
+// which differ from the expected outcomes.
+func (result *CheckResult) checkTrials(
+	trials []checkTrial,
+	call func(label string, args []Value) callOutcome,
+) {
+	for _, trial := range trials {
+		actual := call(trial.label, trial.args)
+
+		result.NumTrials++
+		if !trial.expected.equals(actual) {
+			result.Mismatches = append(
+				result.Mismatches,
+				&Mismatch{
+					Label:    trial.label,
+					Args:     trial.args,
+					Expected: trial.expected.String(),
+					Actual:   actual.String(),
+				})
+		}
+	}
+}
+
+func interpretCall(
+	interpreter *Interpreter,
+	label string,
+	args []Value,
+) callOutcome {
+	interpreter.SysCalls = nil
+	interpreter.ResetMemory()
+	result, err := interpreter.CallValues(label, args)
+	return callOutcome{
+		result:   result,
+		err:      err,
+		sysCalls: interpreter.SysCalls,
+	}
+}
+
+func isInconclusive(err error) bool {
+	return errors.Is(err, ErrMaxStepsExceeded) ||
+		errors.Is(err, ErrMaxCallDepthExceeded)
+}
+
+// Dynamically cross-checks the register / stack allocator's output against
+// the ssa semantics.  Each function definition is called with numTrials random
+// argument vectors, first by interpreting the analyzed ssa directly, then by
+// executing the allocator's operation streams on the abstract machine (see
+// Machine).  The two runs' return values and syscalls must match.
+//
+// The sources are analyzed (optimized if optimize is true) and allocated by
+// this function (the allocator modifies the function definitions).  This
+// returns nil if the sources have errors.
+func CheckAllocation(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+	emitter *parseutil.Emitter,
+	optimize bool,
+	numTrials int,
+	random *rand.Rand,
+) *CheckResult {
+	analyzer.AnalyzeSemantics(sources, targetPlatform, emitter, optimize)
+	if emitter.HasErrors() {
+		return nil
+	}
+
+	checkResult := &CheckResult{}
+
+	// The allocator modifies the control flow graphs.  Hence, all ssa runs must
+	// complete prior to allocation.
+	interpreter := NewInterpreter(targetPlatform, sources)
+	interpreter.MaxSteps = defaultCheckMaxSteps
+
+	trials := checkResult.generateTrials(
+		sources,
+		interpreter,
+		numTrials,
+		random)
+
+	allocators := analyzer.AllocateRegisters(sources, targetPlatform)
+
+	// The operation streams include explicit jumps, which are not part of the
+	// ssa run's step count.
+	machine := NewMachine(targetPlatform, sources, allocators, random)
+	machine.MaxSteps = 2 * defaultCheckMaxSteps
+
+	checkResult.checkTrials(
+		trials,
+		func(label string, args []Value) callOutcome {
+			machine.SysCalls = nil
+			machine.ResetMemory()
+			result, err := machine.CallValues(label, args)
+			return callOutcome{
+				result:   result,
+				err:      err,
+				sysCalls: machine.SysCalls,
+			}
+		})
+
+	return checkResult
+}
+
+func canGenerateValues(funcType *ast.FunctionType) bool {
+	for _, paramType := range funcType.ParameterTypes {
+		if !ast.IsIntSubType(paramType) && !ast.IsFloatSubType(paramType) {
+			return false
+		}
+	}
+
+	return isScalarFunctionType(funcType)
+}
+
+// Random values are biased toward edge cases.
+func randomValue(valueType ast.Type, random *rand.Rand) Value {
+	if ast.IsFloatSubType(valueType) {
+		switch random.Intn(4) {
+		case 0:
+			edges := []float64{0, math.Copysign(0, -1), 1, -1, 0.5}
+			return newFloatValue(valueType, edges[random.Intn(len(edges))])
+		case 1:
+			return newFloatValue(valueType, float64(random.Intn(33)-16))
+		default:
+			return newFloatValue(valueType, (random.Float64()*2-1)*1000)
+		}
+	}
+
+	size := bitSize(valueType)
+	switch random.Intn(4) {
+	case 0:
+		var min uint64
+		var max uint64
+		if ast.IsSignedIntSubType(valueType) {
+			min = uint64(1) << (size - 1)
+			max = min - 1
+		} else {
+			max = math.MaxUint64 >> (64 - size)
+		}
+
+		edges := []uint64{0, 1, math.MaxUint64, min, max}
+		return normalize(valueType, edges[random.Intn(len(edges))])
+	case 1:
+		return normalize(valueType, uint64(random.Int63n(33)-16))
+	default:
+		return normalize(valueType, random.Uint64())
+	}
+}
@@ -0,0 +1,77 @@
+package interpreter
+
+import (
+	"github.com/pattyshack/chickadee/ast"
+)
+
+const (
+	// Data definitions are mapped sequentially (in source order), starting
+	// from the base address.  Each definition is aligned to dataAlignment.
+	dataBaseAddress = 0x10000000
+	dataAlignment   = 16
+)
+
+// A data definition's initial memory content.
+type dataSegment struct {
+	label   string
+	address Value
+	bytes   []byte
+}
+
+// Encodes the data definitions' values (in little endian, see Memory) and
+// assigns their pseudo addresses.
+//
+// NOTE: the interpreter does not distinguish read-only data from mutable data.
+func layoutData(sources []ast.SourceEntry) []dataSegment {
+	segments := []dataSegment{}
+	address := uint64(dataBaseAddress)
+	for _, entry := range sources {
+		def, ok := entry.(*ast.DataDefinition)
+		if !ok {
+			continue
+		}
+
+		elementSize := bitSize(def.ElementType) / 8
+
+		bytes := []byte{}
+		for _, value := range def.Values {
+			switch data := value.(type) {
+			case *ast.ImmediateData:
+				element, ok := ImmediateValue(data.Value)
+				if !ok {
+					panic("should never happen")
+				}
+
+				for i := 0; i < data.Count; i++ {
+					for idx := 0; idx < elementSize; idx++ {
+						bytes = append(bytes, byte(uint64(element)>>(8*idx)))
+					}
+				}
+			case *ast.StringData:
+				bytes = append(bytes, data.Value...)
+			default:
+				panic("should never happen")
+			}
+		}
+
+		segments = append(
+			segments,
+			dataSegment{
+				label:   def.Label,
+				address: Value(address),
+				bytes:   bytes,
+			})
+
+		address += uint64(len(bytes))
+		address = (address + dataAlignment - 1) / dataAlignment * dataAlignment
+	}
+
+	return segments
+}
+
+// Maps the data segments with their initial content.
+func (memory Memory) mapData(segments []dataSegment) {
+	for _, segment := range segments {
+		memory.Write(uint64(segment.address), segment.bytes)
+	}
+}
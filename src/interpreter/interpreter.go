@@ -0,0 +1,688 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+const (
+	// Function pseudo addresses are assigned sequentially, starting from the
+	// base address.  The addresses are only meaningful to the interpreter.
+	functionBaseAddress = 0x1000
+	functionAddressStep = 0x10
+
+	defaultMaxCallDepth = 10000
+)
+
+var (
+	ErrMaxStepsExceeded     = errors.New("maximum number of steps exceeded")
+	ErrMaxCallDepthExceeded = errors.New("maximum call depth exceeded")
+)
+
+func functionAddress(idx int) Value {
+	return Value(functionBaseAddress + idx*functionAddressStep)
+}
+
+// A reference interpreter which directly executes analyzed function
+// definitions (i.e., after ssa construction and type checking).  The
+// interpreter does not depend on register allocation or code generation, and
+// serves as the semantic oracle for compiled code.
+//
+// NOTE: the oracle is stricter than native code.  Operations with undefined
+// results (i.e., NaN / out of range float to int conversion, integer division
+// by zero, and signed integer division overflow) are reported as errors
+// rather than emulating a particular native result.  Float arithmetic and
+// comparisons are well defined; they follow IEEE 754 semantics (e.g., all NaN
+// comparisons are false), and are never errors.
+type Interpreter struct {
+	platform platform.Platform
+
+	functions map[string]*ast.FunctionDefinition
+	data      []dataSegment
+	addresses map[string]Value // function and data pseudo addresses
+	labels    map[Value]string // function pseudo address -> label
+
+	// Maximum number of instructions executed per Call.  Zero means unlimited.
+	MaxSteps int
+
+	// Maximum call stack depth.
+	MaxCallDepth int
+
+	// All emulated syscalls, in execution order.
+	SysCalls []SysCallRecord
+
+	// Memory accessed by load / store instructions.  Data definitions are
+	// mapped with their initial values.  The caller is responsible for mapping
+	// the memory referenced by pointer arguments.
+	Memory Memory
+
+	steps int
+}
+
+// The source entries must have been analyzed (see analyzer.AnalyzeSemantics)
+// without errors.
+func NewInterpreter(
+	targetPlatform platform.Platform,
+	sources []ast.SourceEntry,
+) *Interpreter {
+	interpreter := &Interpreter{
+		platform:     targetPlatform,
+		functions:    map[string]*ast.FunctionDefinition{},
+		addresses:    map[string]Value{},
+		labels:       map[Value]string{},
+		MaxCallDepth: defaultMaxCallDepth,
+		Memory:       Memory{},
+	}
+
+	for _, entry := range sources {
+		funcDef, ok := entry.(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+
+		address := functionAddress(len(interpreter.functions))
+		interpreter.functions[funcDef.Label] = funcDef
+		interpreter.addresses[funcDef.Label] = address
+		interpreter.labels[address] = funcDef.Label
+	}
+
+	interpreter.data = layoutData(sources)
+	for _, segment := range interpreter.data {
+		interpreter.addresses[segment.label] = segment.address
+	}
+	interpreter.Memory.mapData(interpreter.data)
+
+	return interpreter
+}
+
+// Discards all memory content, then remaps the data definitions with their
+// initial values.
+func (interpreter *Interpreter) ResetMemory() {
+	interpreter.Memory = Memory{}
+	interpreter.Memory.mapData(interpreter.data)
+}
+
+// Calls the function with the given arguments.  Int arguments must be go
+// integers, and float arguments must be go floats.  Int return values are
+// returned as int64 (signed) / uint64 (unsigned), and float return values are
+// returned as float64.
+//
+// If the program terminates via the exit syscall, the returned error is an
+// *ExitError.
+func (interpreter *Interpreter) Call(
+	label string,
+	args ...interface{},
+) (
+	interface{},
+	error,
+) {
+	funcDef, ok := interpreter.functions[label]
+	if !ok {
+		return nil, fmt.Errorf("function not found: %s", label)
+	}
+
+	paramTypes := funcDef.FuncType.ParameterTypes
+	if len(args) != len(paramTypes) {
+		return nil, fmt.Errorf(
+			"@%s expects %d arguments, but %d were given",
+			label,
+			len(paramTypes),
+			len(args))
+	}
+
+	values := make([]Value, 0, len(args))
+	for idx, arg := range args {
+		value, err := NewValue(paramTypes[idx], arg)
+		if err != nil {
+			return nil, fmt.Errorf("@%s argument %d: %w", label, idx, err)
+		}
+		values = append(values, value)
+	}
+
+	result, err := interpreter.CallValues(label, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Interface(funcDef.FuncType.ReturnType), nil
+}
+
+// Similar to Call, but the arguments and return value are in canonical
+// representation.  Functions with struct parameters or return value can only
+// be called by other functions.
+func (interpreter *Interpreter) CallValues(
+	label string,
+	args []Value,
+) (
+	Value,
+	error,
+) {
+	funcDef, ok := interpreter.functions[label]
+	if !ok {
+		return 0, fmt.Errorf("function not found: %s", label)
+	}
+
+	if len(args) != len(funcDef.Parameters) {
+		return 0, fmt.Errorf(
+			"@%s expects %d arguments, but %d were given",
+			label,
+			len(funcDef.Parameters),
+			len(args))
+	}
+
+	if !isScalarFunctionType(funcDef.FuncType) {
+		return 0, fmt.Errorf("@%s has non-scalar parameter / return type", label)
+	}
+
+	argChunks := make([][]Value, 0, len(args))
+	for _, arg := range args {
+		argChunks = append(argChunks, []Value{arg})
+	}
+
+	interpreter.steps = 0
+	result, err := interpreter.run(funcDef, argChunks, 1)
+	if err != nil {
+		return 0, err
+	}
+	return result[0], nil
+}
+
+// Returns the function's pseudo address.
+func (interpreter *Interpreter) FunctionAddress(label string) (Value, bool) {
+	_, ok := interpreter.functions[label]
+	if !ok {
+		return 0, false
+	}
+	return interpreter.addresses[label], true
+}
+
+// Returns the data definition's pseudo address.
+func (interpreter *Interpreter) DataAddress(label string) (Value, bool) {
+	_, ok := interpreter.functions[label]
+	if ok {
+		return 0, false
+	}
+
+	address, ok := interpreter.addresses[label]
+	return address, ok
+}
+
+// Scalar values occupy a single chunk.
+type frame map[*ast.VariableDefinition][]Value
+
+func newFrame(funcDef *ast.FunctionDefinition, args [][]Value) frame {
+	values := frame{}
+	for idx, param := range funcDef.Parameters {
+		values[param] = args[idx]
+	}
+
+	// Callee-saved pseudo parameters are opaque to the program.
+	for _, param := range funcDef.PseudoParameters {
+		values[param] = []Value{0}
+	}
+
+	return values
+}
+
+func (interpreter *Interpreter) run(
+	funcDef *ast.FunctionDefinition,
+	args [][]Value,
+	depth int,
+) (
+	[]Value,
+	error,
+) {
+	if depth > interpreter.MaxCallDepth {
+		return nil, fmt.Errorf(
+			"%s: %w (%d)",
+			funcDef.Loc(),
+			ErrMaxCallDepthExceeded,
+			interpreter.MaxCallDepth)
+	}
+
+	values := newFrame(funcDef, args)
+
+	var prev *ast.Block
+	block := funcDef.Blocks[0]
+blockLoop:
+	for {
+		err := interpreter.evaluatePhis(values, prev, block)
+		if err != nil {
+			return nil, err
+		}
+
+		next := (*ast.Block)(nil)
+		if len(block.Children) > 0 {
+			next = block.Children[0]
+		}
+
+		for _, inst := range block.Instructions {
+			interpreter.steps++
+			if interpreter.MaxSteps > 0 && interpreter.steps > interpreter.MaxSteps {
+				return nil, fmt.Errorf(
+					"%s: %w (%d)",
+					inst.Loc(),
+					ErrMaxStepsExceeded,
+					interpreter.MaxSteps)
+			}
+
+			switch inst := inst.(type) {
+			case *ast.Terminal:
+				// NOTE: exit terminals are replaced by exit syscalls prior to ssa
+				// construction.
+				if inst.Kind != ast.Ret {
+					panic("unhandled terminal kind: " + inst.Kind)
+				}
+				return interpreter.chunks(values, inst.RetVal)
+			case *ast.TailCall:
+				// The callee replaces the current function at the same call depth.
+				funcDef, args, err = interpreter.tailCall(values, inst)
+				if err != nil {
+					return nil, err
+				}
+
+				values = newFrame(funcDef, args)
+				prev = nil
+				block = funcDef.Blocks[0]
+				continue blockLoop
+			case *ast.Jump:
+				// Already set.
+			case *ast.ConditionalJump:
+				src1, err := interpreter.value(values, inst.Src1)
+				if err != nil {
+					return nil, err
+				}
+
+				src2, err := interpreter.value(values, inst.Src2)
+				if err != nil {
+					return nil, err
+				}
+
+				// Both branches may share the same child, in which case there's only
+				// one child.
+				if !EvaluateConditionalJump(inst, src1, src2) {
+					next = block.Children[len(block.Children)-1]
+				}
+			case *ast.Switch:
+				src, err := interpreter.value(values, inst.Src)
+				if err != nil {
+					return nil, err
+				}
+
+				next = block.Children[EvaluateSwitch(inst, src)]
+			default:
+				err := interpreter.evaluate(values, inst, depth)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if next == nil {
+			return nil, fmt.Errorf(
+				"%s: block (%s) has no successor",
+				block.Loc(),
+				block.Label)
+		}
+
+		prev = block
+		block = next
+	}
+}
+
+// Phis are evaluated simultaneously (i.e., all sources are read before any
+// destination is written).
+func (interpreter *Interpreter) evaluatePhis(
+	values frame,
+	prev *ast.Block,
+	block *ast.Block,
+) error {
+	if len(block.Phis) == 0 {
+		return nil
+	}
+
+	results := make(map[*ast.VariableDefinition][]Value, len(block.Phis))
+	for _, phi := range block.Phis {
+		src, ok := phi.Srcs[prev]
+		if !ok {
+			return fmt.Errorf(
+				"%s: phi (%s) has no source for the predecessor block",
+				phi.Loc(),
+				phi.Dest.Name)
+		}
+
+		value, err := interpreter.chunks(values, src)
+		if err != nil {
+			return err
+		}
+		results[phi.Dest] = value
+	}
+
+	for def, value := range results {
+		values[def] = value
+	}
+
+	return nil
+}
+
+func (interpreter *Interpreter) evaluate(
+	values frame,
+	inst ast.Instruction,
+	depth int,
+) error {
+	var result Value
+	var resultChunks []Value // set by instructions which operate on chunks
+	var err error
+	switch inst := inst.(type) {
+	case *ast.CopyOperation:
+		resultChunks, err = interpreter.chunks(values, inst.Src)
+	case *ast.UnaryOperation:
+		src, err := interpreter.value(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		result, err = EvaluateUnaryOperation(inst, src)
+		if err != nil {
+			return err
+		}
+	case *ast.BinaryOperation:
+		src1, err := interpreter.value(values, inst.Src1)
+		if err != nil {
+			return err
+		}
+
+		src2, err := interpreter.value(values, inst.Src2)
+		if err != nil {
+			return err
+		}
+
+		result, err = EvaluateBinaryOperation(inst, src1, src2)
+		if err != nil {
+			return err
+		}
+	case *ast.LoadOperation:
+		address, err := interpreter.value(values, inst.Address)
+		if err != nil {
+			return err
+		}
+
+		result, err = EvaluateLoadOperation(interpreter.Memory, inst, address)
+		if err != nil {
+			return err
+		}
+	case *ast.StoreOperation:
+		address, err := interpreter.value(values, inst.Address)
+		if err != nil {
+			return err
+		}
+
+		src, err := interpreter.value(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		err = EvaluateStoreOperation(interpreter.Memory, inst, address, src)
+		if err != nil {
+			return err
+		}
+	case *ast.ExtractOperation:
+		src, err := interpreter.chunks(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		resultChunks = EvaluateExtractOperation(inst, src)
+	case *ast.InsertOperation:
+		src, err := interpreter.chunks(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		value, err := interpreter.chunks(values, inst.Value)
+		if err != nil {
+			return err
+		}
+
+		resultChunks = EvaluateInsertOperation(inst, src, value)
+	case *ast.GetElementOperation:
+		src, err := interpreter.chunks(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		index, err := interpreter.value(values, inst.Index)
+		if err != nil {
+			return err
+		}
+
+		result, err = EvaluateGetElementOperation(inst, src, index)
+		if err != nil {
+			return err
+		}
+	case *ast.SetElementOperation:
+		src, err := interpreter.chunks(values, inst.Src)
+		if err != nil {
+			return err
+		}
+
+		index, err := interpreter.value(values, inst.Index)
+		if err != nil {
+			return err
+		}
+
+		value, err := interpreter.value(values, inst.Value)
+		if err != nil {
+			return err
+		}
+
+		resultChunks, err = EvaluateSetElementOperation(inst, src, index, value)
+		if err != nil {
+			return err
+		}
+	case *ast.SelectOperation:
+		cond, err := interpreter.value(values, inst.Cond)
+		if err != nil {
+			return err
+		}
+
+		trueValue, err := interpreter.value(values, inst.TrueValue)
+		if err != nil {
+			return err
+		}
+
+		falseValue, err := interpreter.value(values, inst.FalseValue)
+		if err != nil {
+			return err
+		}
+
+		result = EvaluateSelectOperation(inst, cond, trueValue, falseValue)
+	case *ast.FuncCall:
+		resultChunks, err = interpreter.call(values, inst, depth)
+	default:
+		panic(fmt.Sprintf("unhandled instruction: %s", inst.Loc()))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	dest := inst.Destination()
+	if dest != nil {
+		if resultChunks == nil {
+			resultChunks = []Value{result}
+		}
+		values[dest] = resultChunks
+	}
+
+	return nil
+}
+
+func (interpreter *Interpreter) call(
+	values frame,
+	call *ast.FuncCall,
+	depth int,
+) (
+	[]Value,
+	error,
+) {
+	funcValue, args, err := interpreter.callArguments(
+		values,
+		call.Func,
+		call.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	if call.Kind == ast.SysCall {
+		// Syscall arguments are scalars.
+		sysCallArgs := make([]Value, 0, len(args))
+		for _, arg := range args {
+			sysCallArgs = append(sysCallArgs, arg[0])
+		}
+
+		record, err := emulateSysCall(
+			interpreter.platform,
+			call,
+			funcValue,
+			sysCallArgs)
+		if err == nil || isExit(err) {
+			interpreter.SysCalls = append(interpreter.SysCalls, record)
+		}
+		return []Value{record.Result}, err
+	}
+
+	callee, err := interpreter.callee(call, funcValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return interpreter.run(callee, args, depth+1)
+}
+
+// Returns the tail call's callee and arguments.
+func (interpreter *Interpreter) tailCall(
+	values frame,
+	call *ast.TailCall,
+) (
+	*ast.FunctionDefinition,
+	[][]Value,
+	error,
+) {
+	funcValue, args, err := interpreter.callArguments(
+		values,
+		call.Func,
+		call.Args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	callee, err := interpreter.callee(call, funcValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return callee, args, nil
+}
+
+// Returns the function value and the arguments' chunks.
+func (interpreter *Interpreter) callArguments(
+	values frame,
+	funcLoc ast.Value,
+	argValues []ast.Value,
+) (
+	Value,
+	[][]Value,
+	error,
+) {
+	funcValue, err := interpreter.value(values, funcLoc)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	args := make([][]Value, 0, len(argValues))
+	for _, arg := range argValues {
+		value, err := interpreter.chunks(values, arg)
+		if err != nil {
+			return 0, nil, err
+		}
+		args = append(args, value)
+	}
+
+	return funcValue, args, nil
+}
+
+func (interpreter *Interpreter) callee(
+	call ast.Instruction,
+	funcValue Value,
+) (
+	*ast.FunctionDefinition,
+	error,
+) {
+	label, ok := interpreter.labels[funcValue]
+	if !ok {
+		return nil, fmt.Errorf(
+			"%s: invalid function address (%#x)",
+			call.Loc(),
+			uint64(funcValue))
+	}
+
+	return interpreter.functions[label], nil
+}
+
+// Returns the scalar value.
+func (interpreter *Interpreter) value(
+	values frame,
+	value ast.Value,
+) (
+	Value,
+	error,
+) {
+	chunks, err := interpreter.chunks(values, value)
+	if err != nil {
+		return 0, err
+	}
+	return chunks[0], nil
+}
+
+// Returns the value's register sized chunks.
+func (interpreter *Interpreter) chunks(
+	values frame,
+	value ast.Value,
+) (
+	[]Value,
+	error,
+) {
+	switch val := value.(type) {
+	case *ast.VariableReference:
+		result, ok := values[val.UseDef]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%s: variable (%s) used before definition",
+				val.Loc(),
+				val.Name)
+		}
+		return result, nil
+	case *ast.GlobalLabelReference:
+		address, ok := interpreter.addresses[val.Label]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%s: global label (%s) has no definition",
+				val.Loc(),
+				val.Label)
+		}
+		return []Value{address}, nil
+	case *ast.ZeroImmediate:
+		return make([]Value, architecture.NumRegisters(val.Type())), nil
+	}
+
+	result, ok := ImmediateValue(value)
+	if !ok {
+		panic(fmt.Sprintf("unhandled value: %s", value.Loc()))
+	}
+	return []Value{result}, nil
+}
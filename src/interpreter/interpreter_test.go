@@ -0,0 +1,366 @@
+package interpreter
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func newTestInterpreter(t *testing.T, source string) *Interpreter {
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(source)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+	analyzer.AnalyzeSemantics(entries, targetPlatform, emitter, false)
+	expect.False(t, emitter.HasErrors(), "%v", emitter.Errors())
+
+	return NewInterpreter(targetPlatform, entries)
+}
+
+func expectCall(
+	t *testing.T,
+	interpreter *Interpreter,
+	expected interface{},
+	label string,
+	args ...interface{},
+) {
+	result, err := interpreter.Call(label, args...)
+	expect.Nil(t, err, "@%s%v", label, args)
+	expect.Equal(t, expected, result, "@%s%v", label, args)
+}
+
+func expectCallError(
+	t *testing.T,
+	interpreter *Interpreter,
+	errMsg string,
+	label string,
+	args ...interface{},
+) {
+	_, err := interpreter.Call(label, args...)
+	expect.Error(t, err, errMsg, "@%s%v", label, args)
+}
+
+func TestIntegerWraparound(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @addI8(%a I8, %b I8) I8 {
+  %c = add %a, %b
+  ret %c
+}
+
+define func @addU8(%a U8, %b U8) U8 {
+  %c = add %a, %b
+  ret %c
+}
+
+define func @subU32(%a U32, %b U32) U32 {
+  %c = sub %a, %b
+  ret %c
+}
+
+define func @mulI64(%a I64, %b I64) I64 {
+  %c = mul %a, %b
+  ret %c
+}
+
+define func @negI8(%a I8) I8 {
+  %b = neg %a
+  ret %b
+}
+
+define func @u8ToI8(%a U8) I8 {
+  %b = toI8 %a
+  ret %b
+}
+
+define func @i8ToU64(%a I8) U64 {
+  %b = toU64 %a
+  ret %b
+}
+
+define func @ltI8(%a I8, %b I8) Bool {
+  %c = lt %a, %b
+  ret %c
+}
+
+define func @ltU8(%a U8, %b U8) Bool {
+  %c = lt %a, %b
+  ret %c
+}
+`)
+
+	expectCall(t, interpreter, int64(-128), "addI8", int64(127), int64(1))
+	expectCall(t, interpreter, uint64(0), "addU8", uint64(255), uint64(1))
+	expectCall(t, interpreter, uint64(math.MaxUint32), "subU32", 0, 1)
+	expectCall(
+		t,
+		interpreter,
+		int64(math.MinInt64),
+		"mulI64",
+		int64(math.MinInt64),
+		int64(-1))
+	expectCall(t, interpreter, int64(-128), "negI8", int64(-128))
+
+	// Conversions reinterpret / sign extend the bits.
+	expectCall(t, interpreter, int64(-1), "u8ToI8", uint64(255))
+	expectCall(t, interpreter, uint64(math.MaxUint64), "i8ToU64", int64(-1))
+
+	// Signedness determines the comparison's ordering.
+	expectCall(t, interpreter, true, "ltI8", int64(-1), int64(1))
+	expectCall(t, interpreter, false, "ltU8", uint64(255), uint64(1))
+
+	// Arguments must fit in the parameter's type.
+	expectCallError(t, interpreter, "128 overflows I8", "addI8", 128, 0)
+	expectCallError(t, interpreter, "-1 overflows U8", "addU8", -1, 0)
+}
+
+func TestShifts(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @shlI32(%a I32, %b U8) I32 {
+  %c = shl %a, %b
+  ret %c
+}
+
+define func @shrI32(%a I32, %b U8) I32 {
+  %c = shr %a, %b
+  ret %c
+}
+
+define func @shrU32(%a U32, %b U8) U32 {
+  %c = shr %a, %b
+  ret %c
+}
+
+define func @shlU8(%a U8, %b U8) U8 {
+  %c = shl %a, %b
+  ret %c
+}
+
+define func @shrI64(%a I64, %b U8) I64 {
+  %c = shr %a, %b
+  ret %c
+}
+`)
+
+	expectCall(t, interpreter, int64(math.MinInt32), "shlI32", 1, 31)
+	expectCall(t, interpreter, int64(-2), "shrI32", -8, 2) // arithmetic shift
+	expectCall(t, interpreter, uint64(1<<30-2), "shrU32", uint64(1<<32-8), 2)
+
+	// Bits shifted out of the operand size are discarded.
+	expectCall(t, interpreter, uint64(0x80), "shlU8", uint64(0xff), 7)
+
+	// The shift count is masked to the operand size.
+	expectCall(t, interpreter, int64(2), "shlI32", 1, 33)
+	expectCall(t, interpreter, int64(-1), "shrI64", -1, 64+63)
+	expectCall(t, interpreter, int64(1<<62), "shrI64", int64(1<<62), 64)
+}
+
+func TestIntegerDivision(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @divI32(%a I32, %b I32) I32 {
+  %c = div %a, %b
+  ret %c
+}
+
+define func @remI32(%a I32, %b I32) I32 {
+  %c = rem %a, %b
+  ret %c
+}
+
+define func @divU64(%a U64, %b U64) U64 {
+  %c = div %a, %b
+  ret %c
+}
+`)
+
+	// Signed division truncates toward zero.
+	expectCall(t, interpreter, int64(-3), "divI32", -7, 2)
+	expectCall(t, interpreter, int64(-1), "remI32", -7, 2)
+	expectCall(
+		t,
+		interpreter,
+		uint64(math.MaxUint64/2),
+		"divU64",
+		uint64(math.MaxUint64),
+		uint64(2))
+
+	expectCallError(t, interpreter, "integer division by zero", "divI32", 1, 0)
+	expectCallError(t, interpreter, "integer division by zero", "remI32", 1, 0)
+	expectCallError(t, interpreter, "integer division by zero", "divU64", 1, 0)
+
+	expectCallError(
+		t,
+		interpreter,
+		"integer division overflow",
+		"divI32",
+		math.MinInt32,
+		-1)
+	expectCallError(
+		t,
+		interpreter,
+		"integer division overflow",
+		"remI32",
+		math.MinInt32,
+		-1)
+}
+
+func TestExit(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @exit(%code I32) I32 {
+  exit %code
+}
+
+define func @callExit(%code I32) I32 {
+  %a = call @exit(%code)
+  ret %a
+}
+`)
+
+	for _, label := range []string{"exit", "callExit"} {
+		_, err := interpreter.Call(label, 3)
+		exitErr, ok := err.(*ExitError)
+		expect.True(t, ok, "%s: %v", label, err)
+		expect.Equal(t, int32(3), exitErr.Status)
+	}
+
+	expect.Equal(t, 2, len(interpreter.SysCalls))
+	expect.Equal(t, Value(linuxAmd64Exit), interpreter.SysCalls[0].Number)
+}
+
+func TestFloatConversions(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @f64ToI32(%a F64) I32 {
+  %b = toI32 %a
+  ret %b
+}
+
+define func @f64ToU8(%a F64) U8 {
+  %b = toU8 %a
+  ret %b
+}
+
+define func @f32ToI64(%a F32) I64 {
+  %b = toI64 %a
+  ret %b
+}
+
+define func @i64ToF32(%a I64) F32 {
+  %b = toF32 %a
+  ret %b
+}
+
+define func @f64ToF32(%a F64) F32 {
+  %b = toF32 %a
+  ret %b
+}
+`)
+
+	// Float to int conversion truncates toward zero.
+	expectCall(t, interpreter, int64(-2), "f64ToI32", -2.9)
+	expectCall(t, interpreter, int64(math.MaxInt32), "f64ToI32", 2147483647.5)
+	expectCall(t, interpreter, uint64(255), "f64ToU8", 255.9)
+	expectCall(t, interpreter, uint64(0), "f64ToU8", -0.5)
+	expectCall(t, interpreter, int64(-1<<63), "f32ToI64", float32(-1<<63))
+
+	expectCallError(t, interpreter, "out of I32's range", "f64ToI32", math.NaN())
+	expectCallError(t, interpreter, "out of I32's range", "f64ToI32", 1<<31+0.0)
+	expectCallError(t, interpreter, "out of U8's range", "f64ToU8", 256.0)
+	expectCallError(t, interpreter, "out of U8's range", "f64ToU8", -1.0)
+	expectCallError(
+		t,
+		interpreter,
+		"out of I64's range",
+		"f32ToI64",
+		math.Inf(1))
+
+	// Int to F32 conversion rounds once (to nearest even).
+	expectCall(
+		t,
+		interpreter,
+		float64(float32(1<<62+1<<38+1)),
+		"i64ToF32",
+		int64(1<<62+1<<38+1))
+	expectCall(t, interpreter, float64(float32(0.1)), "f64ToF32", 0.1)
+}
+
+func TestNaNComparisons(t *testing.T) {
+	interpreter := newTestInterpreter(
+		t,
+		`
+define func @lt(%a F64, %b F64) Bool {
+  %c = lt %a, %b
+  ret %c
+}
+
+define func @le(%a F32, %b F32) Bool {
+  %c = le %a, %b
+  ret %c
+}
+
+define func @gt(%a F64, %b F64) Bool {
+  %c = gt %a, %b
+  ret %c
+}
+
+define func @ge(%a F64, %b F64) Bool {
+  %c = ge %a, %b
+  ret %c
+}
+
+define func @jge(%a F64, %b F64) I64 {
+  jge :yes, %a, %b
+  ret 1
+:yes
+  ret 2
+}
+
+define func @jlt(%a F32, %b F32) I64 {
+  jlt :yes, %a, %b
+  ret 1
+:yes
+  ret 2
+}
+`)
+
+	nan := math.NaN()
+	pairs := [][2]float64{
+		{nan, 1},
+		{1, nan},
+		{nan, nan},
+	}
+
+	// NaN is unordered: all comparisons are false (not errors).
+	for _, pair := range pairs {
+		expectCall(t, interpreter, false, "lt", pair[0], pair[1])
+		expectCall(t, interpreter, false, "le", pair[0], pair[1])
+		expectCall(t, interpreter, false, "gt", pair[0], pair[1])
+		expectCall(t, interpreter, false, "ge", pair[0], pair[1])
+		expectCall(t, interpreter, int64(1), "jge", pair[0], pair[1])
+		expectCall(t, interpreter, int64(1), "jlt", pair[0], pair[1])
+	}
+
+	expectCall(t, interpreter, true, "le", 1.0, 1.0)
+	expectCall(t, interpreter, int64(2), "jge", 1.0, 1.0)
+	expectCall(t, interpreter, int64(2), "jlt", 1.0, 2.0)
+}
@@ -0,0 +1,876 @@
+package interpreter
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/pattyshack/chickadee/analyzer/allocator"
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+const (
+	initialStackPointer = 0x7fff00000000
+
+	// Pushed onto the stack by calls in place of real return addresses.
+	returnAddressMarker = 0x0badc0de0badc0de
+)
+
+// An abstract register-and-stack machine which executes the allocator's
+// operation streams (rather than machine code).  Values are tracked per
+// register and per register sized stack chunk, and all stack locations are
+// addressed relative to the stack pointer, following the data locations'
+// registers and offsets.
+//
+// The machine follows the code generator's frame conventions: the entire
+// stack frame is allocated on function entry and deallocated on return, and
+// instruction destinations are located by the location allocations that
+// immediately follow the instructions.
+//
+// To surface allocation errors, all data which the program must not depend on
+// are replaced by random junk: uninitialized registers and stack chunks,
+// registers clobbered by instructions, scratch registers, and caller-saved
+// stack sources.  The machine also verifies callee-saved registers and the
+// stack pointer are preserved across calls.
+type Machine struct {
+	platform platform.Platform
+
+	functions map[string]*allocator.Allocator
+	data      []dataSegment
+	addresses map[string]Value // function and data pseudo addresses
+	labels    map[Value]string // function pseudo address -> label
+
+	// Maximum number of executed instructions per Call.  Zero means unlimited.
+	MaxSteps int
+
+	// Maximum call stack depth.
+	MaxCallDepth int
+
+	// All emulated syscalls, in execution order.
+	SysCalls []SysCallRecord
+
+	// Memory accessed by load / store instructions (see Interpreter.Memory).
+	Memory Memory
+
+	rand *rand.Rand
+
+	registers    map[*arch.Register]Value
+	memory       map[uint64]Value
+	stackPointer uint64
+
+	steps int
+}
+
+// The allocators must be in source order (see analyzer.AllocateRegisters) for
+// function pseudo addresses to match the interpreter's.  The sources' data
+// definitions are mapped into memory at the interpreter's pseudo addresses.
+func NewMachine(
+	targetPlatform platform.Platform,
+	sources []ast.SourceEntry,
+	allocators []*allocator.Allocator,
+	random *rand.Rand,
+) *Machine {
+	machine := &Machine{
+		platform:     targetPlatform,
+		functions:    map[string]*allocator.Allocator{},
+		addresses:    map[string]Value{},
+		labels:       map[Value]string{},
+		MaxCallDepth: defaultMaxCallDepth,
+		Memory:       Memory{},
+		rand:         random,
+	}
+
+	for idx, registerStackAllocator := range allocators {
+		label := registerStackAllocator.FuncDef.Label
+		address := functionAddress(idx)
+		machine.functions[label] = registerStackAllocator
+		machine.addresses[label] = address
+		machine.labels[address] = label
+	}
+
+	machine.data = layoutData(sources)
+	for _, segment := range machine.data {
+		machine.addresses[segment.label] = segment.address
+	}
+	machine.Memory.mapData(machine.data)
+
+	return machine
+}
+
+// Discards all memory content (see Interpreter.ResetMemory).
+func (machine *Machine) ResetMemory() {
+	machine.Memory = Memory{}
+	machine.Memory.mapData(machine.data)
+}
+
+func (machine *Machine) junk() Value {
+	return Value(machine.rand.Uint64())
+}
+
+// Calls the function using its call convention, with the arguments and return
+// value in canonical representation.  All registers and stack memory are
+// reset to random junk prior to the call.  Functions with struct parameters or
+// return value can only be called by other functions.
+func (machine *Machine) CallValues(
+	label string,
+	args []Value,
+) (
+	Value,
+	error,
+) {
+	registerStackAllocator, ok := machine.functions[label]
+	if !ok {
+		return 0, fmt.Errorf("function not found: %s", label)
+	}
+
+	funcType := registerStackAllocator.FuncDef.FuncType
+	if len(args) != len(funcType.ParameterTypes) {
+		return 0, fmt.Errorf(
+			"@%s expects %d arguments, but %d were given",
+			label,
+			len(funcType.ParameterTypes),
+			len(args))
+	}
+
+	if !isScalarFunctionType(funcType) {
+		return 0, fmt.Errorf("@%s has non-scalar parameter / return type", label)
+	}
+
+	machine.registers = map[*arch.Register]Value{}
+	for _, reg := range machine.platform.ArchitectureRegisters().Data {
+		machine.registers[reg] = machine.junk()
+	}
+	machine.memory = map[uint64]Value{}
+	machine.stackPointer = initialStackPointer
+	machine.steps = 0
+
+	constraints := machine.platform.CallConvention(funcType).CallConstraints
+
+	// Stack sources are laid out (from top to bottom) in the same order as the
+	// call's sources, followed by the stack destination.
+	stackSize := 0
+	sourceOffsets := make([]int, len(args))
+	for idx, src := range constraints.Sources[1:] { // skip func value
+		if src.RequireOnStack {
+			sourceOffsets[idx] = stackSize
+			stackSize += arch.AlignedSize(funcType.ParameterTypes[idx])
+		}
+	}
+
+	destinationOffset := stackSize
+	if constraints.Destination.RequireOnStack {
+		stackSize += arch.AlignedSize(funcType.ReturnType)
+	}
+
+	if stackSize%arch.StackFrameAlignment != 0 {
+		stackSize += arch.StackFrameAlignment - stackSize%arch.StackFrameAlignment
+	}
+
+	machine.stackPointer -= uint64(stackSize)
+	for idx, src := range constraints.Sources[1:] {
+		if src.RequireOnStack {
+			machine.memory[machine.stackPointer+uint64(sourceOffsets[idx])] = args[idx]
+		} else {
+			machine.registers[src.Registers[0].Require] = args[idx]
+		}
+	}
+
+	if constraints.Destination.RequireOnStack {
+		machine.memory[machine.stackPointer+uint64(destinationOffset)] = 0
+	}
+
+	err := machine.call(constraints, label, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	var result Value
+	if constraints.Destination.RequireOnStack {
+		result = machine.load(machine.stackPointer + uint64(destinationOffset))
+	} else {
+		result = machine.registers[constraints.Destination.Registers[0].Require]
+	}
+
+	return normalize(funcType.ReturnType, uint64(result)), nil
+}
+
+// Calls the function on behalf of a caller with the given call constraints.
+// The arguments must already be in the call convention locations.
+func (machine *Machine) call(
+	constraints *arch.InstructionConstraints,
+	label string,
+	depth int,
+) error {
+	if depth > machine.MaxCallDepth {
+		return fmt.Errorf(
+			"%s: %w (%d)",
+			machine.functions[label].FuncDef.Loc(),
+			ErrMaxCallDepthExceeded,
+			machine.MaxCallDepth)
+	}
+
+	preserved := map[*arch.Register]Value{}
+	for reg, value := range machine.registers {
+		if !constraints.RequiredRegisters[reg] {
+			preserved[reg] = value
+		}
+	}
+
+	callerStackPointer := machine.stackPointer
+	if callerStackPointer%arch.StackFrameAlignment != 0 {
+		return fmt.Errorf(
+			"@%s: stack pointer not stack frame aligned at call (%#x)",
+			label,
+			callerStackPointer)
+	}
+
+	machine.stackPointer -= arch.RegisterByteSize
+	machine.memory[machine.stackPointer] = returnAddressMarker
+
+	err := machine.run(machine.functions[label], depth)
+	if err != nil {
+		return err
+	}
+
+	if machine.stackPointer != callerStackPointer {
+		return fmt.Errorf(
+			"@%s: stack pointer not preserved (%#x != %#x)",
+			label,
+			machine.stackPointer,
+			callerStackPointer)
+	}
+
+	for reg, value := range preserved {
+		if machine.registers[reg] != value {
+			return fmt.Errorf(
+				"@%s: callee-saved register (%s) not preserved",
+				label,
+				reg.Name)
+		}
+	}
+
+	return nil
+}
+
+type machineFrame struct {
+	*allocator.Allocator
+
+	depth int
+}
+
+func (machine *Machine) run(
+	registerStackAllocator *allocator.Allocator,
+	depth int,
+) error {
+	frame := &machineFrame{
+		Allocator: registerStackAllocator,
+		depth:     depth,
+	}
+
+	machine.stackPointer -= uint64(frame.TotalFrameSize)
+
+	block := frame.FuncDef.Blocks[0]
+blockLoop:
+	for {
+		ops := frame.BlockStates[block].Operations
+
+		var next *ast.Block
+		isControlFlow := false
+		for idx, op := range ops {
+			if op.Kind != arch.ExecuteInstruction {
+				err := machine.executeOperation(frame, op)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			machine.steps++
+			if machine.MaxSteps > 0 && machine.steps > machine.MaxSteps {
+				return fmt.Errorf(
+					"%s: %w (%d)",
+					op.Instruction.Loc(),
+					ErrMaxStepsExceeded,
+					machine.MaxSteps)
+			}
+
+			switch inst := op.Instruction.(type) {
+			case *ast.Terminal:
+				return machine.ret(frame, op)
+			case *ast.TailCall:
+				// The callee replaces the current function at the same call depth.
+				callee, err := machine.tailCall(frame, op)
+				if err != nil {
+					return err
+				}
+
+				frame = &machineFrame{
+					Allocator: callee,
+					depth:     depth,
+				}
+
+				machine.stackPointer -= uint64(frame.TotalFrameSize)
+				block = frame.FuncDef.Blocks[0]
+				continue blockLoop
+			case *ast.Jump:
+				isControlFlow = true
+				next = block.Children[0]
+			case *ast.ConditionalJump:
+				isControlFlow = true
+
+				src1 := machine.source(frame, op, 0)
+				src2 := machine.source(frame, op, 1)
+				if EvaluateConditionalJump(inst, src1, src2) {
+					next = block.Children[0]
+				} else {
+					next = block.Children[1]
+				}
+			case *ast.Switch:
+				isControlFlow = true
+
+				src := machine.source(frame, op, 0)
+				next = block.Children[EvaluateSwitch(inst, src)]
+			default:
+				err := machine.executeInstruction(frame, op, ops[idx+1:])
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if !isControlFlow {
+			if len(block.Children) == 0 {
+				// The block must end with an exit syscall.
+				return fmt.Errorf(
+					"%s: block (%s) has no successor",
+					block.Loc(),
+					block.Label)
+			}
+
+			// Fallthrough to the next block in layout order.
+			next = block.Children[0]
+		}
+
+		block = next
+	}
+}
+
+func (machine *Machine) ret(frame *machineFrame, op arch.Operation) error {
+	if frame.StackFrame.Destination != nil {
+		// The return value is placed on the temp stack by the allocator.  Move
+		// it to the caller allocated destination.
+		machine.copyLocation(frame, frame.StackFrame.Destination, op.Sources[0])
+	}
+
+	machine.stackPointer += uint64(frame.TotalFrameSize)
+
+	if machine.load(machine.stackPointer) != returnAddressMarker {
+		return fmt.Errorf(
+			"%s: return address clobbered",
+			op.Instruction.Loc())
+	}
+
+	machine.stackPointer += arch.RegisterByteSize
+	return nil
+}
+
+// The stack arguments are placed on the temp stack by the allocator.  Move
+// them to the caller allocated stack argument area, deallocate the current
+// frame, and return the callee.  The callee returns directly to the current
+// function's caller.
+func (machine *Machine) tailCall(
+	frame *machineFrame,
+	op arch.Operation,
+) (
+	*allocator.Allocator,
+	error,
+) {
+	call := op.Instruction.(*ast.TailCall)
+
+	label, err := machine.callee(call, call.Func, machine.source(frame, op, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	address := machine.stackPointer + uint64(frame.StackArgumentsOffset())
+	for _, loc := range op.Sources[1 : len(call.Args)+1] {
+		if !loc.IsOnStack() {
+			continue
+		}
+
+		for idx := 0; idx < numChunks(loc); idx++ {
+			machine.memory[address] = machine.readChunk(frame, loc, idx)
+			address += arch.RegisterByteSize
+		}
+	}
+
+	machine.stackPointer += uint64(frame.TotalFrameSize)
+	return machine.functions[label], nil
+}
+
+func (machine *Machine) executeOperation(
+	frame *machineFrame,
+	op arch.Operation,
+) error {
+	switch op.Kind {
+	case arch.MoveRegister:
+		machine.registers[op.DestRegister] = machine.registers[op.SrcRegister]
+	case arch.CopyLocation:
+		machine.copyLocation(frame, op.Destination, op.Sources[0])
+		machine.clobberScratch(op.DestRegister)
+	case arch.SetConstantValue:
+		_, ok := op.Value.(*ast.ZeroImmediate)
+		if ok {
+			machine.clobberScratch(op.DestRegister)
+			for idx := 0; idx < numChunks(op.Destination); idx++ {
+				machine.writeChunk(frame, op.Destination, idx, 0)
+			}
+			break
+		}
+
+		value, err := machine.value(op.Value)
+		if err != nil {
+			return err
+		}
+		machine.clobberScratch(op.DestRegister)
+		machine.writeChunk(frame, op.Destination, 0, value)
+	case arch.SetFramePointerAddress:
+		// The frame pointer points to the current frame's return address.
+		machine.writeChunk(
+			frame,
+			op.Destination,
+			0,
+			Value(machine.stackPointer+uint64(frame.TotalFrameSize)))
+	case arch.InitializeZeros:
+		machine.clobberScratch(op.DestRegister)
+		for idx := 0; idx < numChunks(op.Destination); idx++ {
+			machine.writeChunk(frame, op.Destination, idx, 0)
+		}
+	case arch.AllocateLocation,
+		arch.FreeLocation,
+		arch.AssignLocationToDefinition:
+		// debugging operations have no effect
+	default:
+		return fmt.Errorf("unexpected operation (%s)", op.Kind)
+	}
+
+	return nil
+}
+
+func (machine *Machine) clobberScratch(scratch *arch.Register) {
+	if scratch != nil {
+		machine.registers[scratch] = machine.junk()
+	}
+}
+
+func numChunks(loc *arch.DataLocation) int {
+	if loc.IsOnStack() {
+		return loc.AlignedSize / arch.RegisterByteSize
+	}
+	return len(loc.Registers)
+}
+
+// Operations hold copies of the data locations made prior to stack frame
+// finalization.  Hence, fixed stack offsets must be looked up from the
+// finalized frame.
+func (machine *Machine) stackAddress(
+	frame *machineFrame,
+	loc *arch.DataLocation,
+) uint64 {
+	if loc.OnTempStack {
+		return machine.stackPointer + uint64(loc.Offset)
+	}
+
+	frameLoc, ok := frame.Locations[loc.Name]
+	if !ok {
+		panic("should never happen. missing stack location: " + loc.Name)
+	}
+	return machine.stackPointer + uint64(frameLoc.Offset)
+}
+
+// Uninitialized memory contains junk.
+func (machine *Machine) load(address uint64) Value {
+	value, ok := machine.memory[address]
+	if !ok {
+		value = machine.junk()
+		machine.memory[address] = value
+	}
+	return value
+}
+
+func (machine *Machine) readChunk(
+	frame *machineFrame,
+	loc *arch.DataLocation,
+	idx int,
+) Value {
+	if loc.IsOnStack() {
+		return machine.load(
+			machine.stackAddress(frame, loc) + uint64(idx*arch.RegisterByteSize))
+	}
+	return machine.registers[loc.Registers[idx]]
+}
+
+func (machine *Machine) writeChunk(
+	frame *machineFrame,
+	loc *arch.DataLocation,
+	idx int,
+	value Value,
+) {
+	if loc.IsOnStack() {
+		address := machine.stackAddress(frame, loc)
+		machine.memory[address+uint64(idx*arch.RegisterByteSize)] = value
+	} else {
+		machine.registers[loc.Registers[idx]] = value
+	}
+}
+
+func (machine *Machine) copyLocation(
+	frame *machineFrame,
+	dest *arch.DataLocation,
+	src *arch.DataLocation,
+) {
+	if numChunks(dest) != numChunks(src) {
+		panic("should never happen")
+	}
+
+	// Read all chunks before writing in case the locations overlap.
+	chunks := make([]Value, numChunks(src))
+	for idx := range chunks {
+		chunks[idx] = machine.readChunk(frame, src, idx)
+	}
+
+	for idx, chunk := range chunks {
+		machine.writeChunk(frame, dest, idx, chunk)
+	}
+}
+
+func (machine *Machine) value(value ast.Value) (Value, error) {
+	ref, ok := value.(*ast.GlobalLabelReference)
+	if ok {
+		address, ok := machine.addresses[ref.Label]
+		if !ok {
+			return 0, fmt.Errorf(
+				"%s: global label (%s) has no definition",
+				ref.Loc(),
+				ref.Label)
+		}
+		return address, nil
+	}
+
+	result, ok := ImmediateValue(value)
+	if !ok {
+		panic(fmt.Sprintf("unhandled value: %s", value.Loc()))
+	}
+	return result, nil
+}
+
+// Returns the instruction's idx-th register (or encoded immediate) source
+// value, normalized to the source's type (registers may contain junk upper
+// bits).
+func (machine *Machine) source(
+	frame *machineFrame,
+	op arch.Operation,
+	idx int,
+) Value {
+	loc := op.Sources[idx]
+	if loc.EncodedImmediate != nil {
+		value, err := machine.value(loc.EncodedImmediate)
+		if err != nil {
+			panic(err) // label references are bound by the type checker
+		}
+		return value
+	}
+
+	srcType := op.Instruction.Sources()[idx].Type()
+	return normalize(srcType, uint64(machine.readChunk(frame, loc, 0)))
+}
+
+// Returns the instruction's idx-th source value's chunks, normalized to the
+// source's type.
+func (machine *Machine) sourceChunks(
+	frame *machineFrame,
+	op arch.Operation,
+	idx int,
+) []Value {
+	loc := op.Sources[idx]
+	chunks := make([]Value, numChunks(loc))
+	for chunk := range chunks {
+		chunks[chunk] = machine.readChunk(frame, loc, chunk)
+	}
+
+	srcType := op.Instruction.Sources()[idx].Type()
+	return normalizeChunks(srcType, chunks)
+}
+
+// The allocator selects register destination after the instruction's
+// execution (the destination may reuse source registers).  Hence, the
+// instruction's destination registers are only known from the location
+// allocation that immediately follows the instruction (after the dead
+// locations are freed).  This returns nil if the destination is never used.
+//
+// NOTE: Unused destinations are not allocated, and the following operations
+// may belong to the next instruction, which may define a variable with the
+// same name (e.g., a constant copy).
+func allocatedDestination(
+	def *ast.VariableDefinition,
+	following []arch.Operation,
+) *arch.DataLocation {
+	if len(def.DefUses) == 0 {
+		return nil
+	}
+
+	for _, op := range following {
+		if op.Kind == arch.FreeLocation {
+			continue
+		}
+
+		if op.Kind == arch.AllocateLocation &&
+			op.Destination.Name == def.Name &&
+			!op.Destination.IsOnStack() {
+
+			return op.Destination
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func (machine *Machine) executeInstruction(
+	frame *machineFrame,
+	op arch.Operation,
+	following []arch.Operation,
+) error {
+	inst := op.Instruction
+	constraints := machine.platform.InstructionConstraints(inst)
+
+	var result Value
+	var resultChunks []Value // set by instructions which operate on chunks
+	var err error
+	switch inst := inst.(type) {
+	case *ast.UnaryOperation:
+		result, err = EvaluateUnaryOperation(inst, machine.source(frame, op, 0))
+	case *ast.BinaryOperation:
+		result, err = EvaluateBinaryOperation(
+			inst,
+			machine.source(frame, op, 0),
+			machine.source(frame, op, 1))
+	case *ast.LoadOperation:
+		result, err = EvaluateLoadOperation(
+			machine.Memory,
+			inst,
+			machine.source(frame, op, 0))
+	case *ast.StoreOperation:
+		err = EvaluateStoreOperation(
+			machine.Memory,
+			inst,
+			machine.source(frame, op, 0),
+			machine.source(frame, op, 1))
+	case *ast.ExtractOperation:
+		resultChunks = EvaluateExtractOperation(
+			inst,
+			machine.sourceChunks(frame, op, 0))
+	case *ast.InsertOperation:
+		resultChunks = EvaluateInsertOperation(
+			inst,
+			machine.sourceChunks(frame, op, 0),
+			machine.sourceChunks(frame, op, 1))
+	case *ast.GetElementOperation:
+		result, err = EvaluateGetElementOperation(
+			inst,
+			machine.sourceChunks(frame, op, 0),
+			machine.source(frame, op, 1))
+	case *ast.SetElementOperation:
+		resultChunks, err = EvaluateSetElementOperation(
+			inst,
+			machine.sourceChunks(frame, op, 0),
+			machine.source(frame, op, 1),
+			machine.source(frame, op, 2))
+	case *ast.SelectOperation:
+		result = EvaluateSelectOperation(
+			inst,
+			machine.source(frame, op, 0),
+			machine.source(frame, op, 1),
+			machine.source(frame, op, 2))
+	case *ast.FuncCall:
+		result, err = machine.executeCall(frame, op, constraints, inst)
+	default:
+		return fmt.Errorf("%s: unexpected instruction", inst.Loc())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	destRegisters := map[*arch.Register]struct{}{}
+	var destConstraints []*arch.RegisterConstraint
+	if constraints.Destination != nil {
+		destConstraints = constraints.Destination.Registers
+		for _, regConst := range destConstraints {
+			if regConst.Require != nil {
+				destRegisters[regConst.Require] = struct{}{}
+			}
+		}
+	}
+
+	// Clobbered source and required registers no longer hold meaningful data.
+	for reg, clobbered := range constraints.RequiredRegisters {
+		_, isDest := destRegisters[reg]
+		if clobbered && !isDest {
+			machine.registers[reg] = machine.junk()
+		}
+	}
+
+	for idx, srcConst := range constraints.Sources {
+		if idx >= len(op.Sources) || !srcConst.ClobberedByInstruction() {
+			continue
+		}
+
+		loc := op.Sources[idx]
+		if loc.EncodedImmediate != nil {
+			continue
+		}
+
+		for chunk := 0; chunk < numChunks(loc); chunk++ {
+			if !loc.IsOnStack() {
+				_, isDest := destRegisters[loc.Registers[chunk]]
+				if isDest {
+					continue
+				}
+			}
+			machine.writeChunk(frame, loc, chunk, machine.junk())
+		}
+	}
+
+	def := inst.Destination()
+	if def == nil {
+		return nil
+	}
+
+	if op.Destination != nil && op.Destination.IsOnStack() {
+		// Call's stack destination is written by the callee.
+		for idx, chunk := range resultChunks {
+			machine.writeChunk(frame, op.Destination, idx, chunk)
+		}
+		return nil
+	}
+
+	dest := allocatedDestination(def, following)
+	if dest == nil {
+		return nil
+	}
+
+	// Destination register constraints which are shared with source register
+	// constraints must reuse the source registers, and required destination
+	// registers must match exactly.
+	for chunk, destConst := range destConstraints {
+		reg := dest.Registers[chunk]
+		if destConst.Require != nil && destConst.Require != reg {
+			return fmt.Errorf(
+				"%s: destination (%s) not in required register (%s != %s)",
+				inst.Loc(),
+				def.Name,
+				reg.Name,
+				destConst.Require.Name)
+		}
+
+		for idx, srcConst := range constraints.Sources {
+			for srcChunk, regConst := range srcConst.Registers {
+				if regConst != destConst {
+					continue
+				}
+
+				srcReg := op.Sources[idx].Registers[srcChunk]
+				if srcReg != reg {
+					return fmt.Errorf(
+						"%s: destination (%s) does not reuse source register (%s != %s)",
+						inst.Loc(),
+						def.Name,
+						reg.Name,
+						srcReg.Name)
+				}
+			}
+		}
+	}
+
+	_, ok := inst.(*ast.FuncCall)
+	if ok {
+		// The result is already in the (required) destination registers.
+		return nil
+	}
+
+	if resultChunks != nil {
+		for idx, chunk := range resultChunks {
+			machine.writeChunk(frame, dest, idx, chunk)
+		}
+		return nil
+	}
+
+	machine.writeChunk(frame, dest, 0, normalize(def.Type, uint64(result)))
+	return nil
+}
+
+func (machine *Machine) executeCall(
+	frame *machineFrame,
+	op arch.Operation,
+	constraints *arch.InstructionConstraints,
+	call *ast.FuncCall,
+) (
+	Value,
+	error,
+) {
+	funcValue := machine.source(frame, op, 0)
+
+	if call.Kind == ast.SysCall {
+		args := make([]Value, 0, len(call.Args))
+		for idx := range call.Args {
+			args = append(args, machine.source(frame, op, idx+1))
+		}
+
+		record, err := emulateSysCall(machine.platform, call, funcValue, args)
+		if err == nil || isExit(err) {
+			machine.SysCalls = append(machine.SysCalls, record)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		// The syscall's destination is always a register.
+		reg := constraints.Destination.Registers[0].Require
+		machine.registers[reg] = record.Result
+		return record.Result, nil
+	}
+
+	label, err := machine.callee(call, call.Func, funcValue)
+	if err != nil {
+		return 0, err
+	}
+
+	// The callee places the result in the call convention's destination.
+	return 0, machine.call(constraints, label, frame.depth+1)
+}
+
+func (machine *Machine) callee(
+	call ast.Instruction,
+	funcLoc ast.Value,
+	funcValue Value,
+) (
+	string,
+	error,
+) {
+	ref, ok := funcLoc.(*ast.GlobalLabelReference)
+	if ok {
+		return ref.Label, nil
+	}
+
+	label, ok := machine.labels[funcValue]
+	if !ok {
+		return "", fmt.Errorf(
+			"%s: invalid function address (%#x)",
+			call.Loc(),
+			uint64(funcValue))
+	}
+	return label, nil
+}
@@ -0,0 +1,98 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// A sparse byte addressable memory which backs load / store instructions.
+// Only mapped bytes are accessible; accessing unmapped bytes is an error
+// (similar to a segmentation fault).
+//
+// The memory is separate from the machine's stack memory since the program
+// can't reference stack locations.
+type Memory map[uint64]byte
+
+// Maps the bytes starting at the address, and initializes them with the data.
+func (memory Memory) Write(address uint64, data []byte) {
+	for idx, b := range data {
+		memory[address+uint64(idx)] = b
+	}
+}
+
+// Returns a copy of the mapped bytes starting at the address.
+func (memory Memory) Read(address uint64, size int) ([]byte, error) {
+	result := make([]byte, 0, size)
+	for idx := 0; idx < size; idx++ {
+		b, ok := memory[address+uint64(idx)]
+		if !ok {
+			return nil, fmt.Errorf(
+				"access to unmapped address (%#x)",
+				address+uint64(idx))
+		}
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// Loads the type's value (in little endian) from the address.
+func (memory Memory) load(valueType ast.Type, address Value) (Value, error) {
+	data, err := memory.Read(uint64(address), bitSize(valueType)/8)
+	if err != nil {
+		return 0, err
+	}
+
+	bits := uint64(0)
+	for idx, b := range data {
+		bits |= uint64(b) << (8 * idx)
+	}
+	return normalize(valueType, bits), nil
+}
+
+// Stores the type's value (in little endian) to the address.  The address
+// must be mapped.
+func (memory Memory) store(
+	valueType ast.Type,
+	address Value,
+	value Value,
+) error {
+	size := bitSize(valueType) / 8
+	_, err := memory.Read(uint64(address), size)
+	if err != nil {
+		return err
+	}
+
+	for idx := 0; idx < size; idx++ {
+		memory[uint64(address)+uint64(idx)] = byte(uint64(value) >> (8 * idx))
+	}
+	return nil
+}
+
+func EvaluateLoadOperation(
+	memory Memory,
+	inst *ast.LoadOperation,
+	address Value,
+) (
+	Value,
+	error,
+) {
+	result, err := memory.load(inst.Dest.Type, address)
+	if err != nil {
+		return 0, fmt.Errorf("%s: load %w", inst.Loc(), err)
+	}
+	return result, nil
+}
+
+func EvaluateStoreOperation(
+	memory Memory,
+	inst *ast.StoreOperation,
+	address Value,
+	src Value,
+) error {
+	err := memory.store(inst.Src.Type(), address, src)
+	if err != nil {
+		return fmt.Errorf("%s: store %w", inst.Loc(), err)
+	}
+	return nil
+}
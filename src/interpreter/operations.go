@@ -0,0 +1,447 @@
+package interpreter
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// Returns the immediate's canonical value.  This returns false if the value
+// is not an int / float / bool immediate.
+func ImmediateValue(value ast.Value) (Value, bool) {
+	switch imm := value.(type) {
+	case *ast.BoolImmediate:
+		return newBoolValue(imm.Value), true
+	case *ast.IntImmediate:
+		bits := imm.Value
+		if imm.IsNegative {
+			bits = -bits
+		}
+
+		valueType := imm.Type()
+		if imm.BindedType == nil {
+			valueType = ast.NewU64(imm.StartEnd())
+			if imm.IsNegative {
+				valueType = ast.NewI64(imm.StartEnd())
+			}
+		}
+		return normalize(valueType, bits), true
+	case *ast.FloatImmediate:
+		valueType := imm.Type()
+		if imm.BindedType == nil {
+			valueType = ast.NewF64(imm.StartEnd())
+		}
+		return newFloatValue(valueType, imm.Value), true
+	default:
+		return 0, false
+	}
+}
+
+func EvaluateUnaryOperation(
+	inst *ast.UnaryOperation,
+	src Value,
+) (
+	Value,
+	error,
+) {
+	srcType := inst.Src.Type()
+	destType := inst.Dest.Type
+
+	switch inst.Kind {
+	case ast.Neg:
+		if ast.IsFloatSubType(destType) {
+			return newFloatValue(destType, -src.float(srcType)), nil
+		}
+		return normalize(destType, -uint64(src)), nil
+	case ast.Not:
+		if ast.IsBoolType(destType) {
+			return src ^ 1, nil
+		}
+		return normalize(destType, ^uint64(src)), nil
+	case ast.ToI8, ast.ToI16, ast.ToI32, ast.ToI64,
+		ast.ToU8, ast.ToU16, ast.ToU32, ast.ToU64:
+
+		if !ast.IsFloatSubType(srcType) {
+			return normalize(destType, uint64(src)), nil
+		}
+
+		return truncateFloat(inst, src.float(srcType))
+	case ast.ToF32, ast.ToF64:
+		if ast.IsFloatSubType(srcType) {
+			return newFloatValue(destType, src.float(srcType)), nil
+		}
+
+		// NOTE: int to F32 conversion must round directly from the int value
+		// (rounding through float64 could round twice).
+		is32 := bitSize(destType) == 32
+		if ast.IsSignedIntSubType(srcType) {
+			if is32 {
+				return Value(math.Float32bits(float32(int64(src)))), nil
+			}
+			return Value(math.Float64bits(float64(int64(src)))), nil
+		}
+
+		if is32 {
+			return Value(math.Float32bits(float32(uint64(src)))), nil
+		}
+		return Value(math.Float64bits(float64(uint64(src)))), nil
+	default:
+		panic("unhandled unary operation kind: " + inst.Kind)
+	}
+}
+
+// Float to int conversion truncates toward zero.  NaN and out of range
+// values are errors.
+//
+// NOTE: the conversion's result is undefined for NaN and out of range values.
+// Native x64 code never traps on these values; cvttss2si / cvttsd2si yields
+// the integer indefinite value (which is then truncated to the destination's
+// size).  The interpreter is intentionally stricter than native code.
+func truncateFloat(inst *ast.UnaryOperation, value float64) (Value, error) {
+	destType := inst.Dest.Type
+	size := bitSize(destType)
+
+	truncated := math.Trunc(value)
+
+	var min float64
+	var max float64 // exclusive
+	if ast.IsSignedIntSubType(destType) {
+		min = -math.Ldexp(1, size-1)
+		max = math.Ldexp(1, size-1)
+	} else {
+		min = 0
+		max = math.Ldexp(1, size)
+	}
+
+	if math.IsNaN(value) || truncated < min || truncated >= max {
+		return 0, fmt.Errorf(
+			"%s: %v out of %s's range",
+			inst.Loc(),
+			value,
+			destType)
+	}
+
+	if ast.IsSignedIntSubType(destType) {
+		return normalize(destType, uint64(int64(truncated))), nil
+	}
+	return normalize(destType, uint64(truncated)), nil
+}
+
+func EvaluateBinaryOperation(
+	inst *ast.BinaryOperation,
+	src1 Value,
+	src2 Value,
+) (
+	Value,
+	error,
+) {
+	if inst.Kind.IsComparison() {
+		return evaluateComparison(inst, src1, src2), nil
+	}
+
+	opType := inst.Dest.Type
+	if ast.IsFloatSubType(opType) {
+		return evaluateFloatBinaryOperation(inst, src1, src2), nil
+	}
+
+	isSigned := ast.IsSignedIntSubType(opType)
+
+	switch inst.Kind {
+	case ast.Add:
+		return normalize(opType, uint64(src1)+uint64(src2)), nil
+	case ast.Sub:
+		return normalize(opType, uint64(src1)-uint64(src2)), nil
+	case ast.Mul:
+		return normalize(opType, uint64(src1)*uint64(src2)), nil
+	case ast.Div, ast.Rem:
+		if src2 == 0 {
+			return 0, fmt.Errorf("%s: integer division by zero", inst.Loc())
+		}
+
+		if isSigned {
+			dividend := int64(src1)
+			divisor := int64(src2)
+
+			minInt := -int64(1) << (bitSize(opType) - 1)
+			if dividend == minInt && divisor == -1 {
+				return 0, fmt.Errorf("%s: integer division overflow", inst.Loc())
+			}
+
+			if inst.Kind == ast.Div {
+				return normalize(opType, uint64(dividend/divisor)), nil
+			}
+			return normalize(opType, uint64(dividend%divisor)), nil
+		}
+
+		if inst.Kind == ast.Div {
+			return normalize(opType, uint64(src1)/uint64(src2)), nil
+		}
+		return normalize(opType, uint64(src1)%uint64(src2)), nil
+	case ast.Xor:
+		return normalize(opType, uint64(src1^src2)), nil
+	case ast.Or:
+		return normalize(opType, uint64(src1|src2)), nil
+	case ast.And:
+		return normalize(opType, uint64(src1&src2)), nil
+	case ast.Shl, ast.Shr:
+		// Following most hardware, the shift count is masked to the operand
+		// size (5 bits for 8/16/32-bit operands, 6 bits for 64-bit operands).
+		mask := uint64(31)
+		if bitSize(opType) == 64 {
+			mask = 63
+		}
+		count := uint64(src2) & mask
+
+		if inst.Kind == ast.Shl {
+			return normalize(opType, uint64(src1)<<count), nil
+		} else if isSigned {
+			return normalize(opType, uint64(int64(src1)>>count)), nil
+		}
+		return normalize(opType, uint64(src1)>>count), nil
+	default:
+		panic("unhandled binary operation kind: " + inst.Kind)
+	}
+}
+
+func evaluateFloatBinaryOperation(
+	inst *ast.BinaryOperation,
+	src1 Value,
+	src2 Value,
+) Value {
+	if bitSize(inst.Dest.Type) == 32 {
+		a := src1.float32()
+		b := src2.float32()
+
+		var result float32
+		switch inst.Kind {
+		case ast.Add:
+			result = a + b
+		case ast.Sub:
+			result = a - b
+		case ast.Mul:
+			result = a * b
+		case ast.Div:
+			result = a / b
+		default:
+			panic("unhandled float binary operation kind: " + inst.Kind)
+		}
+		return Value(math.Float32bits(result))
+	}
+
+	a := src1.float64()
+	b := src2.float64()
+
+	var result float64
+	switch inst.Kind {
+	case ast.Add:
+		result = a + b
+	case ast.Sub:
+		result = a - b
+	case ast.Mul:
+		result = a * b
+	case ast.Div:
+		result = a / b
+	default:
+		panic("unhandled float binary operation kind: " + inst.Kind)
+	}
+	return Value(math.Float64bits(result))
+}
+
+// Returns whether src1 is equal to, less than, or greater than src2.  Float
+// comparisons follow IEEE 754 semantics (NaN is unordered, i.e., all
+// comparisons involving NaN are false).
+func compare(opType ast.Type, src1 Value, src2 Value) (bool, bool, bool) {
+	if ast.IsFloatSubType(opType) {
+		a := src1.float(opType)
+		b := src2.float(opType)
+		return a == b, a < b, a > b
+	}
+
+	if ast.IsSignedIntSubType(opType) {
+		a := int64(src1)
+		b := int64(src2)
+		return a == b, a < b, a > b
+	}
+
+	return src1 == src2, src1 < src2, src1 > src2
+}
+
+func evaluateComparison(
+	inst *ast.BinaryOperation,
+	src1 Value,
+	src2 Value,
+) Value {
+	isEqual, isLessThan, isGreaterThan := compare(inst.Src1.Type(), src1, src2)
+
+	switch inst.Kind {
+	case ast.Eq:
+		return newBoolValue(isEqual)
+	case ast.Ne:
+		return newBoolValue(!isEqual)
+	case ast.Lt:
+		return newBoolValue(isLessThan)
+	case ast.Le:
+		return newBoolValue(isLessThan || isEqual)
+	case ast.Gt:
+		return newBoolValue(isGreaterThan)
+	case ast.Ge:
+		return newBoolValue(isGreaterThan || isEqual)
+	default:
+		panic("unhandled comparison kind: " + inst.Kind)
+	}
+}
+
+// Returns true if the jump is taken.
+func EvaluateConditionalJump(
+	inst *ast.ConditionalJump,
+	src1 Value,
+	src2 Value,
+) bool {
+	isEqual, isLessThan, isGreaterThan := compare(inst.Src1.Type(), src1, src2)
+
+	switch inst.Kind {
+	case ast.Jeq:
+		return isEqual
+	case ast.Jne:
+		return !isEqual
+	case ast.Jlt:
+		return isLessThan
+	case ast.Jge:
+		return isGreaterThan || isEqual
+	default:
+		panic("unhandled conditional jump kind: " + inst.Kind)
+	}
+}
+
+// Returns the taken target's index in inst.Targets(), which is also the
+// taken child's index in the switch's parent block.
+func EvaluateSwitch(inst *ast.Switch, src Value) int {
+	label := inst.DefaultLabel
+	for _, switchCase := range inst.Cases {
+		caseValue, ok := ImmediateValue(switchCase.Value)
+		if !ok {
+			panic("should never happen")
+		}
+
+		isEqual, _, _ := compare(inst.Src.Type(), src, caseValue)
+		if isEqual {
+			label = switchCase.Label
+			break
+		}
+	}
+
+	for idx, target := range inst.Targets() {
+		if target == label {
+			return idx
+		}
+	}
+	panic("should never happen")
+}
+
+// Returns the field's chunk range within the struct.
+func fieldChunks(structType *ast.StructType, fieldName string) (int, int) {
+	idx := structType.FieldIndex(fieldName)
+	start := architecture.FieldOffset(structType, idx) /
+		architecture.RegisterByteSize
+	return start, start + architecture.NumRegisters(structType.Fields[idx].Type)
+}
+
+func EvaluateExtractOperation(
+	inst *ast.ExtractOperation,
+	src []Value,
+) []Value {
+	start, end := fieldChunks(inst.Src.Type().(*ast.StructType), inst.Field)
+	return append([]Value{}, src[start:end]...)
+}
+
+func EvaluateInsertOperation(
+	inst *ast.InsertOperation,
+	src []Value,
+	value []Value,
+) []Value {
+	start, _ := fieldChunks(inst.Dest.Type.(*ast.StructType), inst.Field)
+	result := append([]Value{}, src...)
+	copy(result[start:], value)
+	return result
+}
+
+// Returns the array index, or an error if the index is out of bounds.
+func arrayIndex(
+	inst ast.Instruction,
+	arrayType *ast.ArrayType,
+	indexType ast.Type,
+	index Value,
+) (
+	int,
+	error,
+) {
+	inBounds := uint64(index) < uint64(arrayType.Length)
+	if ast.IsSignedIntSubType(indexType) {
+		inBounds = 0 <= int64(index) && int64(index) < int64(arrayType.Length)
+	}
+
+	if !inBounds {
+		return 0, fmt.Errorf(
+			"%s: array index (%v) out of range [0, %d)",
+			inst.Loc(),
+			index.Interface(indexType),
+			arrayType.Length)
+	}
+	return int(index), nil
+}
+
+func EvaluateGetElementOperation(
+	inst *ast.GetElementOperation,
+	array []Value,
+	index Value,
+) (
+	Value,
+	error,
+) {
+	idx, err := arrayIndex(
+		inst,
+		inst.Src.Type().(*ast.ArrayType),
+		inst.Index.Type(),
+		index)
+	if err != nil {
+		return 0, err
+	}
+	return array[idx], nil
+}
+
+func EvaluateSetElementOperation(
+	inst *ast.SetElementOperation,
+	array []Value,
+	index Value,
+	value Value,
+) (
+	[]Value,
+	error,
+) {
+	idx, err := arrayIndex(
+		inst,
+		inst.Dest.Type.(*ast.ArrayType),
+		inst.Index.Type(),
+		index)
+	if err != nil {
+		return nil, err
+	}
+
+	result := append([]Value{}, array...)
+	result[idx] = value
+	return result, nil
+}
+
+func EvaluateSelectOperation(
+	inst *ast.SelectOperation,
+	cond Value,
+	trueValue Value,
+	falseValue Value,
+) Value {
+	if cond != 0 {
+		return trueValue
+	}
+	return falseValue
+}
@@ -0,0 +1,62 @@
+package interpreter
+
+import (
+	"math/rand"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+// Dynamically cross-checks the optimization passes against the unoptimized
+// ssa semantics.  Each function definition is called with numTrials random
+// argument vectors, first by interpreting the unoptimized ssa, then by
+// interpreting the optimized ssa.  The two runs' return values and syscalls
+// must match.
+//
+// The unoptimized and optimized sources must be separately parsed copies of
+// the same source (the analyzer modifies the function definitions).  The
+// sources are analyzed by this function.  This returns nil if the sources have
+// errors.
+func CheckOptimization(
+	unoptimized []ast.SourceEntry,
+	optimized []ast.SourceEntry,
+	targetPlatform platform.Platform,
+	emitter *parseutil.Emitter,
+	numTrials int,
+	random *rand.Rand,
+) *CheckResult {
+	analyzer.AnalyzeSemantics(unoptimized, targetPlatform, emitter, false)
+	if emitter.HasErrors() {
+		return nil
+	}
+
+	analyzer.AnalyzeSemantics(optimized, targetPlatform, emitter, true)
+	if emitter.HasErrors() {
+		return nil
+	}
+
+	checkResult := &CheckResult{}
+
+	expected := NewInterpreter(targetPlatform, unoptimized)
+	expected.MaxSteps = defaultCheckMaxSteps
+
+	trials := checkResult.generateTrials(
+		unoptimized,
+		expected,
+		numTrials,
+		random)
+
+	actual := NewInterpreter(targetPlatform, optimized)
+	actual.MaxSteps = defaultCheckMaxSteps
+
+	checkResult.checkTrials(
+		trials,
+		func(label string, args []Value) callOutcome {
+			return interpretCall(actual, label, args)
+		})
+
+	return checkResult
+}
@@ -0,0 +1,90 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+)
+
+// Linux amd64 syscall numbers.
+const (
+	linuxAmd64Write     = 1
+	linuxAmd64Exit      = 60
+	linuxAmd64ExitGroup = 231
+)
+
+type SysCallRecord struct {
+	Number Value
+	Args   []Value
+	Result Value
+}
+
+// Returned (wrapped) by Call when the program terminates via an exit syscall.
+type ExitError struct {
+	Status int32
+}
+
+func (err *ExitError) Error() string {
+	return fmt.Sprintf("exit with status %d", err.Status)
+}
+
+// Emulates the syscall.  Only the side effect-free subset of syscalls is
+// supported:
+//   - exit / exit_group terminates the program.
+//   - write pretends all bytes were written and returns the byte count (the
+//     buffer is not read).
+//
+// The record is valid for both successful and exit syscalls.
+func emulateSysCall(
+	targetPlatform platform.Platform,
+	call *ast.FuncCall,
+	number Value,
+	args []Value,
+) (
+	SysCallRecord,
+	error,
+) {
+	record := SysCallRecord{
+		Number: number,
+		Args:   args,
+	}
+
+	if targetPlatform.ArchitectureName() != platform.Amd64 ||
+		targetPlatform.OperatingSystemName() != platform.Linux {
+
+		return record, fmt.Errorf(
+			"%s: syscall emulation is not supported on %s %s",
+			call.Loc(),
+			targetPlatform.OperatingSystemName(),
+			targetPlatform.ArchitectureName())
+	}
+
+	switch number {
+	case linuxAmd64Exit, linuxAmd64ExitGroup:
+		if len(args) < 1 {
+			return record, fmt.Errorf(
+				"%s: exit syscall requires a status",
+				call.Loc())
+		}
+		return record, &ExitError{Status: int32(args[0])}
+	case linuxAmd64Write:
+		if len(args) < 3 {
+			return record, fmt.Errorf(
+				"%s: write syscall requires 3 arguments",
+				call.Loc())
+		}
+		record.Result = normalize(call.Dest.Type, uint64(args[2]))
+		return record, nil
+	default:
+		return record, fmt.Errorf(
+			"%s: unsupported syscall (%d)",
+			call.Loc(),
+			number)
+	}
+}
+
+func isExit(err error) bool {
+	_, ok := err.(*ExitError)
+	return ok
+}
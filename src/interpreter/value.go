@@ -0,0 +1,184 @@
+package interpreter
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+)
+
+// A value's canonical 64-bit representation:
+//   - int values are sign / zero extended to 64 bits based on their types.
+//   - F32 values are stored in the lower 32 bits (the upper bits are zeros).
+//   - F64 values use all 64 bits.
+//   - function values are the functions' pseudo addresses.
+//   - pointer values are the referenced memory addresses.
+//
+// Struct values are represented by a list of register sized chunks, where
+// each field occupies the chunks starting at the field's offset (see
+// architecture.FieldOffset).  Array values are represented by a list of
+// chunks, one chunk per element.  Scalar values occupy a single chunk.
+type Value uint64
+
+func isScalarFunctionType(funcType *ast.FunctionType) bool {
+	for _, paramType := range funcType.ParameterTypes {
+		if ast.IsAggregateType(paramType) {
+			return false
+		}
+	}
+	return !ast.IsAggregateType(funcType.ReturnType)
+}
+
+// Returns the chunks with every scalar field / element normalized to its
+// field / element type.
+func normalizeChunks(valueType ast.Type, chunks []Value) []Value {
+	arrayType, ok := valueType.(*ast.ArrayType)
+	if ok {
+		result := make([]Value, 0, len(chunks))
+		for _, chunk := range chunks {
+			result = append(result, normalize(arrayType.ElementType, uint64(chunk)))
+		}
+		return result
+	}
+
+	structType, ok := valueType.(*ast.StructType)
+	if !ok {
+		return []Value{normalize(valueType, uint64(chunks[0]))}
+	}
+
+	result := make([]Value, 0, len(chunks))
+	for idx, field := range structType.Fields {
+		start := architecture.FieldOffset(structType, idx) /
+			architecture.RegisterByteSize
+		end := start + architecture.NumRegisters(field.Type)
+		result = append(result, normalizeChunks(field.Type, chunks[start:end])...)
+	}
+	return result
+}
+
+func bitSize(valueType ast.Type) int {
+	return 8 * architecture.ByteSize(valueType)
+}
+
+// Truncates the bits to the type's size, then sign / zero extends the result
+// to 64 bits.
+func normalize(valueType ast.Type, bits uint64) Value {
+	size := bitSize(valueType)
+	if size == 64 {
+		return Value(bits)
+	}
+
+	shift := 64 - size
+	if ast.IsSignedIntSubType(valueType) {
+		return Value(uint64(int64(bits<<shift) >> shift))
+	}
+
+	return Value(bits << shift >> shift)
+}
+
+func newFloatValue(valueType ast.Type, value float64) Value {
+	if bitSize(valueType) == 32 {
+		return Value(math.Float32bits(float32(value)))
+	}
+	return Value(math.Float64bits(value))
+}
+
+// Bool values are represented by 0 (false) / 1 (true).
+func newBoolValue(value bool) Value {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func (value Value) float32() float32 {
+	return math.Float32frombits(uint32(value))
+}
+
+func (value Value) float64() float64 {
+	return math.Float64frombits(uint64(value))
+}
+
+// Returns the value as float64 (F32 values are widened).
+func (value Value) float(valueType ast.Type) float64 {
+	if bitSize(valueType) == 32 {
+		return float64(value.float32())
+	}
+	return value.float64()
+}
+
+// Converts a go value into the given type's canonical representation.  Int
+// types accept go integers, float types accept go floats, bool type accepts
+// go bools, and pointer types accept go unsigned integers.
+func NewValue(valueType ast.Type, arg interface{}) (Value, error) {
+	value := reflect.ValueOf(arg)
+	switch value.Kind() {
+	case reflect.Bool:
+		if !ast.IsBoolType(valueType) {
+			break
+		}
+
+		return newBoolValue(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !ast.IsIntSubType(valueType) {
+			break
+		}
+
+		val := value.Int()
+		result := normalize(valueType, uint64(val))
+		if ast.IsSignedIntSubType(valueType) {
+			if int64(result) != val {
+				return 0, fmt.Errorf("%d overflows %s", val, valueType)
+			}
+		} else if val < 0 || uint64(result) != uint64(val) {
+			return 0, fmt.Errorf("%d overflows %s", val, valueType)
+		}
+		return result, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+
+		if !ast.IsIntSubType(valueType) && !ast.IsPointerType(valueType) {
+			break
+		}
+
+		val := value.Uint()
+		result := normalize(valueType, val)
+		if uint64(result) != val ||
+			(ast.IsSignedIntSubType(valueType) && int64(result) < 0) {
+
+			return 0, fmt.Errorf("%d overflows %s", val, valueType)
+		}
+		return result, nil
+	case reflect.Float32, reflect.Float64:
+		if !ast.IsFloatSubType(valueType) {
+			break
+		}
+
+		return newFloatValue(valueType, value.Float()), nil
+	}
+
+	return 0, fmt.Errorf("cannot use %v (%T) as %s", arg, arg, valueType)
+}
+
+// Converts the value into a go value.  Signed int values are returned as
+// int64, unsigned int values are returned as uint64, float values are
+// returned as float64, bool values are returned as bool, function values are
+// returned as uint64 pseudo addresses, and pointer values are returned as
+// uint64 addresses.
+func (value Value) Interface(valueType ast.Type) interface{} {
+	if ast.IsFloatSubType(valueType) {
+		return value.float(valueType)
+	}
+
+	if ast.IsBoolType(valueType) {
+		return value != 0
+	}
+
+	if ast.IsSignedIntSubType(valueType) {
+		return int64(value)
+	}
+
+	return uint64(value)
+}
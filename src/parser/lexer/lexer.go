@@ -21,7 +21,8 @@ func NewLexer(
 		[]lr.SymbolId{
 			lr.IdentifierToken,
 			lr.IntegerLiteralToken, lr.FloatLiteralToken, lr.StringLiteralToken,
-			lr.RparenToken,
+			lr.ZeroToken, lr.TrueToken, lr.FalseToken,
+			lr.RparenToken, lr.RbracketToken,
 			lr.LbraceToken, lr.RbraceToken,
 		})
 }
@@ -17,8 +17,24 @@ const (
 
 var (
 	keywords = map[string]lr.SymbolId{
-		"define": lr.DefineToken,
-		"func":   lr.FuncToken,
+		"declare":  lr.DeclareToken,
+		"define":   lr.DefineToken,
+		"func":     lr.FuncToken,
+		"data":     lr.DataToken,
+		"var":      lr.VarToken,
+		"load":     lr.LoadToken,
+		"store":    lr.StoreToken,
+		"extract":  lr.ExtractToken,
+		"insert":   lr.InsertToken,
+		"struct":   lr.StructToken,
+		"zero":     lr.ZeroToken,
+		"getelem":  lr.GetelemToken,
+		"setelem":  lr.SetelemToken,
+		"true":     lr.TrueToken,
+		"false":    lr.FalseToken,
+		"select":   lr.SelectToken,
+		"switch":   lr.SwitchToken,
+		"tailcall": lr.TailcallToken,
 	}
 )
 
@@ -77,6 +93,8 @@ func (lexer *RawLexer) peekNextToken() (lr.SymbolId, string, error) {
 		return lr.CommaToken, ",", nil
 	case '=':
 		return lr.EqualToken, "=", nil
+	case '*':
+		return lr.StarToken, "*", nil
 	case '@':
 		return lr.AtToken, "@", nil
 	case ':':
@@ -89,6 +107,10 @@ func (lexer *RawLexer) peekNextToken() (lr.SymbolId, string, error) {
 		return lr.LbraceToken, "{", nil
 	case '}':
 		return lr.RbraceToken, "}", nil
+	case '[':
+		return lr.LbracketToken, "[", nil
+	case ']':
+		return lr.RbracketToken, "]", nil
 	case '/':
 		if len(peeked) > 1 {
 			if peeked[1] == '/' {
@@ -246,11 +268,7 @@ func (lexer *RawLexer) lexIdentifierOrKeywords() (
 	lr.Token,
 	error,
 ) {
-	token, err := parseutil.MaybeTokenizeIdentifier(
-		lexer.BufferedByteLocationReader,
-		initialPeekWindowSize,
-		lexer.InternPool,
-		lr.IdentifierToken)
+	token, err := lexer.lexIdentifier()
 	if err != nil {
 		return nil, err
 	}
@@ -267,6 +285,71 @@ func (lexer *RawLexer) lexIdentifierOrKeywords() (
 	return token, nil
 }
 
+// Identifiers may contain hyphens between identifier segments (e.g., call
+// convention names such as SystemV-lite).  Note that each segment must start
+// with a letter or an underscore.  Hence, %a-1 is lexed as an identifier
+// followed by a negative integer.
+func (lexer *RawLexer) lexIdentifier() (*lr.TokenValue, error) {
+	size, err := parseutil.PeekIdentifier(
+		lexer.BufferedByteLocationReader,
+		0,
+		initialPeekWindowSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if size == 0 {
+		return nil, nil
+	}
+
+	for {
+		peeked, err := lexer.Peek(size + 1)
+		if len(peeked) > 0 && err == io.EOF {
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(peeked) <= size || peeked[size] != '-' {
+			break
+		}
+
+		segmentSize, err := parseutil.PeekIdentifier(
+			lexer.BufferedByteLocationReader,
+			size+1,
+			initialPeekWindowSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if segmentSize == 0 {
+			break
+		}
+
+		size += 1 + segmentSize
+	}
+
+	loc := lexer.Location
+
+	bytes, err := lexer.Peek(size)
+	if err != nil || len(bytes) != size {
+		panic("should never happen")
+	}
+	value := lexer.InternBytes(bytes)
+
+	_, err = lexer.Discard(size)
+	if err != nil {
+		panic("should never happen")
+	}
+
+	return &lr.TokenValue{
+		SymbolId:    lr.IdentifierToken,
+		StartEndPos: parseutil.NewStartEndPos(loc, lexer.Location),
+		Value:       value,
+	}, nil
+}
+
 func (lexer *RawLexer) Next() (lr.Token, error) {
 	symbolId, value, err := lexer.peekNextToken()
 	if err != nil {
@@ -0,0 +1,138 @@
+package lexer
+
+import (
+	"io"
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/parser/lr"
+)
+
+type lexedToken struct {
+	id    lr.SymbolId
+	value string
+}
+
+func lexAll(content string) ([]lexedToken, error) {
+	lexer := NewRawLexer(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(content)))
+
+	tokens := []lexedToken{}
+	for {
+		token, err := lexer.Next()
+		if err == io.EOF {
+			return tokens, nil
+		}
+		if err != nil {
+			return tokens, err
+		}
+
+		switch token.Id() {
+		case lr.SpacesToken, lr.NewlinesToken:
+			continue
+		}
+
+		value := ""
+		tokenValue, ok := token.(*lr.TokenValue)
+		if ok {
+			value = tokenValue.Value
+		}
+
+		tokens = append(tokens, lexedToken{token.Id(), value})
+	}
+}
+
+func TestHyphenatedCallConventionName(t *testing.T) {
+	tokens, err := lexAll("define func{SystemV-lite} @f")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.DefineToken, "define"},
+			{lr.FuncToken, "func"},
+			{lr.LbraceToken, "{"},
+			{lr.IdentifierToken, "SystemV-lite"},
+			{lr.RbraceToken, "}"},
+			{lr.AtToken, "@"},
+			{lr.IdentifierToken, "f"},
+		},
+		tokens)
+
+	tokens, err = lexAll("func /* c */ {\n internal-caller-saved }(I32)")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.FuncToken, "func"},
+			{lr.BlockCommentToken, ""},
+			{lr.LbraceToken, "{"},
+			{lr.IdentifierToken, "internal-caller-saved"},
+			{lr.RbraceToken, "}"},
+			{lr.LparenToken, "("},
+			{lr.IdentifierToken, "I32"},
+			{lr.RparenToken, ")"},
+		},
+		tokens)
+}
+
+func TestIdentifierFollowedByNegativeInteger(t *testing.T) {
+	tokens, err := lexAll("%a-1")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.PercentToken, "%"},
+			{lr.IdentifierToken, "a"},
+			{lr.IntegerLiteralToken, "-1"},
+		},
+		tokens)
+
+	// Hyphen segments must start with a letter or an underscore.
+	tokens, err = lexAll("{a-1 %b-2}")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.LbraceToken, "{"},
+			{lr.IdentifierToken, "a"},
+			{lr.IntegerLiteralToken, "-1"},
+			{lr.PercentToken, "%"},
+			{lr.IdentifierToken, "b"},
+			{lr.IntegerLiteralToken, "-2"},
+			{lr.RbraceToken, "}"},
+		},
+		tokens)
+}
+
+// Hyphenated identifiers are lexed independent of the surrounding tokens.
+func TestHyphenatedIdentifier(t *testing.T) {
+	tokens, err := lexAll("%a-b :x-_y @f-g-1")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.PercentToken, "%"},
+			{lr.IdentifierToken, "a-b"},
+			{lr.ColonToken, ":"},
+			{lr.IdentifierToken, "x-_y"},
+			{lr.AtToken, "@"},
+			{lr.IdentifierToken, "f-g"},
+			{lr.IntegerLiteralToken, "-1"},
+		},
+		tokens)
+
+	// Keywords are not hyphenated.
+	tokens, err = lexAll("func-x func")
+	expect.Nil(t, err)
+	expect.Equal(
+		t,
+		[]lexedToken{
+			{lr.IdentifierToken, "func-x"},
+			{lr.FuncToken, "func"},
+		},
+		tokens)
+}
@@ -20,174 +20,379 @@ const (
 	RparenToken         = SymbolId(261)
 	LbraceToken         = SymbolId(262)
 	RbraceToken         = SymbolId(263)
-	CommaToken          = SymbolId(264)
-	ColonToken          = SymbolId(265)
-	AtToken             = SymbolId(266)
-	PercentToken        = SymbolId(267)
-	EqualToken          = SymbolId(268)
-	DefineToken         = SymbolId(269)
-	FuncToken           = SymbolId(270)
+	LbracketToken       = SymbolId(264)
+	RbracketToken       = SymbolId(265)
+	CommaToken          = SymbolId(266)
+	ColonToken          = SymbolId(267)
+	AtToken             = SymbolId(268)
+	PercentToken        = SymbolId(269)
+	EqualToken          = SymbolId(270)
+	StarToken           = SymbolId(271)
+	DefineToken         = SymbolId(272)
+	DeclareToken        = SymbolId(273)
+	FuncToken           = SymbolId(274)
+	DataToken           = SymbolId(275)
+	VarToken            = SymbolId(276)
+	LoadToken           = SymbolId(277)
+	StoreToken          = SymbolId(278)
+	ExtractToken        = SymbolId(279)
+	InsertToken         = SymbolId(280)
+	StructToken         = SymbolId(281)
+	ZeroToken           = SymbolId(282)
+	GetelemToken        = SymbolId(283)
+	SetelemToken        = SymbolId(284)
+	TrueToken           = SymbolId(285)
+	FalseToken          = SymbolId(286)
+	SelectToken         = SymbolId(287)
+	SwitchToken         = SymbolId(288)
+	TailcallToken       = SymbolId(289)
 )
 
 type DefinitionReducer interface {
-	// 24:2: definition -> func: ...
-	FuncToDefinition(Define_ *TokenValue, Func_ *TokenValue, GlobalLabel_ *ast.GlobalLabelReference, Lparen_ *TokenValue, Parameters_ []*ast.VariableDefinition, Rparen_ *TokenValue, Type_ ast.Type, Lbrace_ *TokenValue) (ast.Line, error)
+	// 40:2: definition -> func: ...
+	FuncToDefinition(Define_ *TokenValue, Func_ *TokenValue, CallConvention_ *TokenValue, GlobalLabel_ *ast.GlobalLabelReference, Lparen_ *TokenValue, Parameters_ []*ast.VariableDefinition, Rparen_ *TokenValue, ReturnType_ ast.Type, Lbrace_ *TokenValue) (ast.Line, error)
+
+	// 42:2: definition -> data: ...
+	DataToDefinition(Define_ *TokenValue, Data_ *TokenValue, GlobalLabel_ *ast.GlobalLabelReference, Type_ ast.Type, Equal_ *TokenValue, DataValues_ []ast.DataValue) (ast.Line, error)
+
+	// 43:2: definition -> var: ...
+	VarToDefinition(Define_ *TokenValue, Var_ *TokenValue, GlobalLabel_ *ast.GlobalLabelReference, Type_ ast.Type, Equal_ *TokenValue, DataValues_ []ast.DataValue) (ast.Line, error)
+}
+
+type DeclarationReducer interface {
+	// 47:2: declaration -> func: ...
+	FuncToDeclaration(Declare_ *TokenValue, Func_ *TokenValue, CallConvention_ *TokenValue, GlobalLabel_ *ast.GlobalLabelReference, Lparen_ *TokenValue, Types_ []ast.Type, Rparen_ *TokenValue, ReturnType_ ast.Type) (ast.Line, error)
 }
 
 type RbraceReducer interface {
-	// 26:16: rbrace -> ...
+	// 50:16: rbrace -> ...
 	ToRbrace(Rbrace_ *TokenValue) (ast.Line, error)
 }
 
+type CallConventionReducer interface {
+	// 55:2: call_convention -> named: ...
+	NamedToCallConvention(Lbrace_ *TokenValue, Identifier_ *TokenValue, Rbrace_ *TokenValue) (*TokenValue, error)
+
+	// 56:2: call_convention -> default: ...
+	DefaultToCallConvention() (*TokenValue, error)
+}
+
+type ReturnTypeReducer interface {
+
+	// 63:2: return_type -> tuple: ...
+	TupleToReturnType(Lparen_ *TokenValue, Type_ ast.Type, Comma_ *TokenValue, ProperTypes_ []ast.Type, Rparen_ *TokenValue) (ast.Type, error)
+
+	// 64:2: return_type -> unit: ...
+	UnitToReturnType() (ast.Type, error)
+}
+
 type GlobalLabelReducer interface {
-	// 32:38: global_label -> ...
+	// 70:38: global_label -> ...
 	ToGlobalLabel(At_ *TokenValue, Identifier_ *TokenValue) (*ast.GlobalLabelReference, error)
 }
 
 type LocalLabelReducer interface {
-	// 34:27: local_label -> ...
+	// 72:27: local_label -> ...
 	ToLocalLabel(Colon_ *TokenValue, Identifier_ *TokenValue) (ParsedLocalLabel, error)
 }
 
 type VariableReferenceReducer interface {
-	// 36:41: variable_reference -> ...
+	// 74:41: variable_reference -> ...
 	ToVariableReference(Percent_ *TokenValue, Identifier_ *TokenValue) (*ast.VariableReference, error)
 }
 
 type IdentifierReducer interface {
 
-	// 40:2: identifier -> string: ...
+	// 78:2: identifier -> string: ...
 	StringToIdentifier(StringLiteral_ *TokenValue) (*TokenValue, error)
 }
 
 type IntImmediateReducer interface {
-	// 46:26: int_immediate -> ...
+	// 85:26: int_immediate -> ...
 	ToIntImmediate(IntegerLiteral_ *TokenValue) (ast.Value, error)
 }
 
 type FloatImmediateReducer interface {
-	// 48:28: float_immediate -> ...
+	// 87:28: float_immediate -> ...
 	ToFloatImmediate(FloatLiteral_ *TokenValue) (ast.Value, error)
 }
 
+type BoolImmediateReducer interface {
+	// 90:2: bool_immediate -> true: ...
+	TrueToBoolImmediate(True_ *TokenValue) (ast.Value, error)
+
+	// 91:2: bool_immediate -> false: ...
+	FalseToBoolImmediate(False_ *TokenValue) (ast.Value, error)
+}
+
+type ZeroImmediateReducer interface {
+	// 94:27: zero_immediate -> ...
+	ToZeroImmediate(Zero_ *TokenValue) (ast.Value, error)
+}
+
 type TypedVariableDefinitionReducer interface {
-	// 50:49: typed_variable_definition -> ...
+	// 96:49: typed_variable_definition -> ...
 	ToTypedVariableDefinition(VariableReference_ *ast.VariableReference, Type_ ast.Type) (*ast.VariableDefinition, error)
 }
 
 type VariableDefinitionReducer interface {
 
-	// 54:2: variable_definition -> inferred: ...
+	// 100:2: variable_definition -> inferred: ...
 	InferredToVariableDefinition(VariableReference_ *ast.VariableReference) (*ast.VariableDefinition, error)
 }
 
 type ParametersReducer interface {
 
-	// 67:2: parameters -> improper: ...
+	// 114:2: parameters -> improper: ...
 	ImproperToParameters(ProperParameters_ []*ast.VariableDefinition, Comma_ *TokenValue) ([]*ast.VariableDefinition, error)
 
-	// 68:2: parameters -> nil: ...
+	// 115:2: parameters -> nil: ...
 	NilToParameters() ([]*ast.VariableDefinition, error)
 }
 
 type ProperParametersReducer interface {
-	// 71:2: proper_parameters -> add: ...
+	// 118:2: proper_parameters -> add: ...
 	AddToProperParameters(ProperParameters_ []*ast.VariableDefinition, Comma_ *TokenValue, TypedVariableDefinition_ *ast.VariableDefinition) ([]*ast.VariableDefinition, error)
 
-	// 72:2: proper_parameters -> new: ...
+	// 119:2: proper_parameters -> new: ...
 	NewToProperParameters(TypedVariableDefinition_ *ast.VariableDefinition) ([]*ast.VariableDefinition, error)
 }
 
 type ArgumentsReducer interface {
 
-	// 76:2: arguments -> improper: ...
+	// 123:2: arguments -> improper: ...
 	ImproperToArguments(ProperArguments_ []ast.Value, Comma_ *TokenValue) ([]ast.Value, error)
 
-	// 77:2: arguments -> nil: ...
+	// 124:2: arguments -> nil: ...
 	NilToArguments() ([]ast.Value, error)
 }
 
 type ProperArgumentsReducer interface {
-	// 80:2: proper_arguments -> add: ...
+	// 127:2: proper_arguments -> add: ...
 	AddToProperArguments(ProperArguments_ []ast.Value, Comma_ *TokenValue, Value_ ast.Value) ([]ast.Value, error)
 
-	// 81:2: proper_arguments -> new: ...
+	// 128:2: proper_arguments -> new: ...
 	NewToProperArguments(Value_ ast.Value) ([]ast.Value, error)
 }
 
+type TupleVariableDefinitionsReducer interface {
+	// 132:2: tuple_variable_definitions -> add: ...
+	AddToTupleVariableDefinitions(TupleVariableDefinitions_ []*ast.VariableDefinition, Comma_ *TokenValue, VariableDefinition_ *ast.VariableDefinition) ([]*ast.VariableDefinition, error)
+
+	// 133:2: tuple_variable_definitions -> new: ...
+	NewToTupleVariableDefinitions(VariableDefinition_ *ast.VariableDefinition, Comma_ *TokenValue, VariableDefinition_2 *ast.VariableDefinition) ([]*ast.VariableDefinition, error)
+}
+
+type DataValuesReducer interface {
+	// 136:2: data_values -> add: ...
+	AddToDataValues(DataValues_ []ast.DataValue, Comma_ *TokenValue, DataValue_ ast.DataValue) ([]ast.DataValue, error)
+
+	// 137:2: data_values -> new: ...
+	NewToDataValues(DataValue_ ast.DataValue) ([]ast.DataValue, error)
+}
+
 type TypesReducer interface {
 
-	// 85:2: types -> improper: ...
+	// 141:2: types -> improper: ...
 	ImproperToTypes(ProperTypes_ []ast.Type, Comma_ *TokenValue) ([]ast.Type, error)
 
-	// 86:2: types -> nil: ...
+	// 142:2: types -> nil: ...
 	NilToTypes() ([]ast.Type, error)
 }
 
 type ProperTypesReducer interface {
-	// 89:2: proper_types -> add: ...
+	// 145:2: proper_types -> add: ...
 	AddToProperTypes(ProperTypes_ []ast.Type, Comma_ *TokenValue, Type_ ast.Type) ([]ast.Type, error)
 
-	// 90:2: proper_types -> new: ...
+	// 146:2: proper_types -> new: ...
 	NewToProperTypes(Type_ ast.Type) ([]ast.Type, error)
 }
 
+type StructFieldsReducer interface {
+
+	// 150:2: struct_fields -> improper: ...
+	ImproperToStructFields(ProperStructFields_ []*ast.StructField, Comma_ *TokenValue) ([]*ast.StructField, error)
+
+	// 151:2: struct_fields -> nil: ...
+	NilToStructFields() ([]*ast.StructField, error)
+}
+
+type ProperStructFieldsReducer interface {
+	// 154:2: proper_struct_fields -> add: ...
+	AddToProperStructFields(ProperStructFields_ []*ast.StructField, Comma_ *TokenValue, StructField_ *ast.StructField) ([]*ast.StructField, error)
+
+	// 155:2: proper_struct_fields -> new: ...
+	NewToProperStructFields(StructField_ *ast.StructField) ([]*ast.StructField, error)
+}
+
+type SwitchCasesReducer interface {
+
+	// 159:2: switch_cases -> improper: ...
+	ImproperToSwitchCases(ProperSwitchCases_ []*ast.SwitchCase, Comma_ *TokenValue) ([]*ast.SwitchCase, error)
+
+	// 160:2: switch_cases -> nil: ...
+	NilToSwitchCases() ([]*ast.SwitchCase, error)
+}
+
+type ProperSwitchCasesReducer interface {
+	// 163:2: proper_switch_cases -> add: ...
+	AddToProperSwitchCases(ProperSwitchCases_ []*ast.SwitchCase, Comma_ *TokenValue, SwitchCase_ *ast.SwitchCase) ([]*ast.SwitchCase, error)
+
+	// 164:2: proper_switch_cases -> new: ...
+	NewToProperSwitchCases(SwitchCase_ *ast.SwitchCase) ([]*ast.SwitchCase, error)
+}
+
+type SwitchCaseReducer interface {
+	// 167:27: switch_case -> ...
+	ToSwitchCase(IntImmediate_ ast.Value, Colon_ *TokenValue, LocalLabel_ ParsedLocalLabel) (*ast.SwitchCase, error)
+}
+
+type DataValueReducer interface {
+	// 175:2: data_value -> immediate: ...
+	ImmediateToDataValue(Immediate_ ast.Value) (ast.DataValue, error)
+
+	// 176:2: data_value -> string: ...
+	StringToDataValue(StringLiteral_ *TokenValue) (ast.DataValue, error)
+
+	// 177:2: data_value -> repeated: ...
+	RepeatedToDataValue(Immediate_ ast.Value, Star_ *TokenValue, IntegerLiteral_ *TokenValue) (ast.DataValue, error)
+}
+
 type OperationInstructionReducer interface {
-	// 97:2: operation_instruction -> assign: ...
+	// 184:2: operation_instruction -> assign: ...
 	AssignToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Value_ ast.Value) (ast.Instruction, error)
 
-	// 98:2: operation_instruction -> unary: ...
+	// 185:2: operation_instruction -> unary: ...
 	UnaryToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Identifier_ *TokenValue, Value_ ast.Value) (ast.Instruction, error)
 
-	// 99:2: operation_instruction -> binary: ...
+	// 186:2: operation_instruction -> binary: ...
 	BinaryToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Identifier_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Value_2 ast.Value) (ast.Instruction, error)
 
-	// 100:2: operation_instruction -> call: ...
+	// 187:2: operation_instruction -> call: ...
 	CallToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Identifier_ *TokenValue, Value_ ast.Value, Lparen_ *TokenValue, Arguments_ []ast.Value, Rparen_ *TokenValue) (ast.Instruction, error)
+
+	// 188:2: operation_instruction -> tuple_call: ...
+	TupleCallToOperationInstruction(TupleVariableDefinitions_ []*ast.VariableDefinition, Equal_ *TokenValue, Identifier_ *TokenValue, Value_ ast.Value, Lparen_ *TokenValue, Arguments_ []ast.Value, Rparen_ *TokenValue) (ast.Instruction, error)
+
+	// 190:2: operation_instruction -> ignored_call: ...
+	IgnoredCallToOperationInstruction(Identifier_ *TokenValue, Value_ ast.Value, Lparen_ *TokenValue, Arguments_ []ast.Value, Rparen_ *TokenValue) (ast.Instruction, error)
+
+	// 191:2: operation_instruction -> load: ...
+	LoadToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Load_ *TokenValue, Value_ ast.Value) (ast.Instruction, error)
+
+	// 192:2: operation_instruction -> store: ...
+	StoreToOperationInstruction(Store_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Value_2 ast.Value) (ast.Instruction, error)
+
+	// 193:2: operation_instruction -> extract: ...
+	ExtractToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Extract_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Identifier_ *TokenValue) (ast.Instruction, error)
+
+	// 194:2: operation_instruction -> insert: ...
+	InsertToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Insert_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Identifier_ *TokenValue, Comma_2 *TokenValue, Value_2 ast.Value) (ast.Instruction, error)
+
+	// 195:2: operation_instruction -> get_element: ...
+	GetElementToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Getelem_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Value_2 ast.Value) (ast.Instruction, error)
+
+	// 196:2: operation_instruction -> set_element: ...
+	SetElementToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Setelem_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Value_2 ast.Value, Comma_2 *TokenValue, Value_3 ast.Value) (ast.Instruction, error)
+
+	// 197:2: operation_instruction -> select: ...
+	SelectToOperationInstruction(VariableDefinition_ *ast.VariableDefinition, Equal_ *TokenValue, Select_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, Value_2 ast.Value, Comma_2 *TokenValue, Value_3 ast.Value) (ast.Instruction, error)
 }
 
 type ControlFlowInstructionReducer interface {
-	// 103:2: control_flow_instruction -> unconditional: ...
+	// 200:2: control_flow_instruction -> unconditional: ...
 	UnconditionalToControlFlowInstruction(Identifier_ *TokenValue, LocalLabel_ ParsedLocalLabel) (ast.Instruction, error)
 
-	// 104:2: control_flow_instruction -> conditional: ...
+	// 201:2: control_flow_instruction -> conditional: ...
 	ConditionalToControlFlowInstruction(Identifier_ *TokenValue, LocalLabel_ ParsedLocalLabel, Comma_ *TokenValue, Value_ ast.Value, Comma_2 *TokenValue, Value_2 ast.Value) (ast.Instruction, error)
 
-	// 105:2: control_flow_instruction -> terminal: ...
+	// 203:2: control_flow_instruction -> bool_conditional: ...
+	BoolConditionalToControlFlowInstruction(Identifier_ *TokenValue, LocalLabel_ ParsedLocalLabel, Comma_ *TokenValue, Value_ ast.Value) (ast.Instruction, error)
+
+	// 205:2: control_flow_instruction -> switch: ...
+	SwitchToControlFlowInstruction(Switch_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, LocalLabel_ ParsedLocalLabel, Comma_2 *TokenValue, Lbracket_ *TokenValue, SwitchCases_ []*ast.SwitchCase, Rbracket_ *TokenValue) (ast.Instruction, error)
+
+	// 207:2: control_flow_instruction -> tail_call: ...
+	TailCallToControlFlowInstruction(Tailcall_ *TokenValue, Value_ ast.Value, Lparen_ *TokenValue, Arguments_ []ast.Value, Rparen_ *TokenValue) (ast.Instruction, error)
+
+	// 208:2: control_flow_instruction -> terminal: ...
 	TerminalToControlFlowInstruction(Identifier_ *TokenValue, Value_ ast.Value) (ast.Instruction, error)
+
+	// 209:2: control_flow_instruction -> tuple_terminal: ...
+	TupleTerminalToControlFlowInstruction(Identifier_ *TokenValue, Value_ ast.Value, Comma_ *TokenValue, ProperArguments_ []ast.Value) (ast.Instruction, error)
+
+	// 210:2: control_flow_instruction -> unit_terminal: ...
+	UnitTerminalToControlFlowInstruction(Identifier_ *TokenValue) (ast.Instruction, error)
 }
 
 type NumberTypeReducer interface {
-	// 116:21: number_type -> ...
+	// 225:21: number_type -> ...
 	ToNumberType(Identifier_ *TokenValue) (ast.Type, error)
 }
 
 type FuncTypeReducer interface {
-	// 118:19: func_type -> ...
-	ToFuncType(Func_ *TokenValue, Lparen_ *TokenValue, Types_ []ast.Type, Rparen_ *TokenValue, Type_ ast.Type) (ast.Type, error)
+	// 227:19: func_type -> ...
+	ToFuncType(Func_ *TokenValue, CallConvention_ *TokenValue, Lparen_ *TokenValue, Types_ []ast.Type, Rparen_ *TokenValue, ReturnType_ ast.Type) (ast.Type, error)
+}
+
+type PointerTypeReducer interface {
+	// 229:22: pointer_type -> ...
+	ToPointerType(Star_ *TokenValue, Type_ ast.Type) (ast.Type, error)
+}
+
+type StructTypeReducer interface {
+	// 232:21: struct_type -> ...
+	ToStructType(Struct_ *TokenValue, Lbrace_ *TokenValue, StructFields_ []*ast.StructField, Rbrace_ *TokenValue) (ast.Type, error)
+}
+
+type StructFieldReducer interface {
+	// 234:29: struct_field -> ...
+	ToStructField(Identifier_ *TokenValue, Type_ ast.Type) (*ast.StructField, error)
+}
+
+type ArrayTypeReducer interface {
+	// 237:20: array_type -> ...
+	ToArrayType(Lbracket_ *TokenValue, IntegerLiteral_ *TokenValue, Rbracket_ *TokenValue, Type_ ast.Type) (ast.Type, error)
 }
 
 type Reducer interface {
 	DefinitionReducer
+	DeclarationReducer
 	RbraceReducer
+	CallConventionReducer
+	ReturnTypeReducer
 	GlobalLabelReducer
 	LocalLabelReducer
 	VariableReferenceReducer
 	IdentifierReducer
 	IntImmediateReducer
 	FloatImmediateReducer
+	BoolImmediateReducer
+	ZeroImmediateReducer
 	TypedVariableDefinitionReducer
 	VariableDefinitionReducer
 	ParametersReducer
 	ProperParametersReducer
 	ArgumentsReducer
 	ProperArgumentsReducer
+	TupleVariableDefinitionsReducer
+	DataValuesReducer
 	TypesReducer
 	ProperTypesReducer
+	StructFieldsReducer
+	ProperStructFieldsReducer
+	SwitchCasesReducer
+	ProperSwitchCasesReducer
+	SwitchCaseReducer
+	DataValueReducer
 	OperationInstructionReducer
 	ControlFlowInstructionReducer
 	NumberTypeReducer
 	FuncTypeReducer
+	PointerTypeReducer
+	StructTypeReducer
+	StructFieldReducer
+	ArrayTypeReducer
 }
 
 type ParseErrorHandler interface {
@@ -207,7 +412,7 @@ func (DefaultParseErrorHandler) Error(nextToken parseutil.Token[SymbolId], stack
 func ExpectedTerminals(id _StateId) []SymbolId {
 	switch id {
 	case _State1:
-		return []SymbolId{IdentifierToken, RbraceToken, ColonToken, PercentToken, DefineToken}
+		return []SymbolId{IdentifierToken, RbraceToken, ColonToken, PercentToken, DefineToken, DeclareToken, StoreToken, SwitchToken, TailcallToken}
 	case _State2:
 		return []SymbolId{_EndMarker}
 	case _State3:
@@ -215,45 +420,185 @@ func ExpectedTerminals(id _StateId) []SymbolId {
 	case _State4:
 		return []SymbolId{FuncToken}
 	case _State5:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, ColonToken, AtToken, PercentToken}
-	case _State6:
-		return []SymbolId{StringLiteralToken, IdentifierToken}
+		return []SymbolId{FuncToken, DataToken, VarToken}
 	case _State7:
-		return []SymbolId{EqualToken}
+		return []SymbolId{StringLiteralToken, IdentifierToken}
+	case _State8:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
 	case _State9:
-		return []SymbolId{AtToken}
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
 	case _State10:
-		return []SymbolId{StringLiteralToken, IdentifierToken}
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State11:
+		return []SymbolId{CommaToken, EqualToken}
 	case _State12:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, IdentifierToken, AtToken, PercentToken}
-	case _State13:
-		return []SymbolId{LparenToken}
-	case _State14:
-		return []SymbolId{LparenToken}
+		return []SymbolId{CommaToken, EqualToken}
 	case _State15:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken}
-	case _State16:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken}
-	case _State19:
+		return []SymbolId{AtToken}
+	case _State17:
+		return []SymbolId{AtToken}
+	case _State18:
+		return []SymbolId{StringLiteralToken, IdentifierToken}
+	case _State21:
 		return []SymbolId{CommaToken}
 	case _State22:
-		return []SymbolId{RparenToken}
+		return []SymbolId{CommaToken}
 	case _State23:
-		return []SymbolId{RparenToken}
+		return []SymbolId{LparenToken}
+	case _State24:
+		return []SymbolId{PercentToken}
 	case _State25:
-		return []SymbolId{IdentifierToken, FuncToken}
+		return []SymbolId{IdentifierToken}
 	case _State26:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken}
+		return []SymbolId{PercentToken}
 	case _State27:
-		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken}
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, IdentifierToken, AtToken, PercentToken, LoadToken, ExtractToken, InsertToken, ZeroToken, GetelemToken, SetelemToken, TrueToken, FalseToken, SelectToken}
+	case _State29:
+		return []SymbolId{IntegerLiteralToken}
 	case _State30:
-		return []SymbolId{IdentifierToken, FuncToken}
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
 	case _State31:
-		return []SymbolId{IdentifierToken, FuncToken}
+		return []SymbolId{LbraceToken}
+	case _State32:
+		return []SymbolId{StringLiteralToken, IdentifierToken}
 	case _State33:
-		return []SymbolId{RparenToken}
+		return []SymbolId{AtToken}
+	case _State34:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
 	case _State35:
+		return []SymbolId{AtToken}
+	case _State36:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State37:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State38:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State40:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State41:
+		return []SymbolId{ColonToken}
+	case _State43:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State44:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State45:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State46:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State47:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State48:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State49:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State50:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State51:
+		return []SymbolId{LparenToken}
+	case _State52:
+		return []SymbolId{RbracketToken}
+	case _State54:
+		return []SymbolId{RbraceToken}
+	case _State55:
+		return []SymbolId{LparenToken}
+	case _State56:
+		return []SymbolId{EqualToken}
+	case _State57:
+		return []SymbolId{LparenToken}
+	case _State58:
+		return []SymbolId{EqualToken}
+	case _State61:
+		return []SymbolId{RparenToken}
+	case _State63:
+		return []SymbolId{CommaToken}
+	case _State64:
+		return []SymbolId{RparenToken}
+	case _State65:
+		return []SymbolId{LparenToken}
+	case _State66:
+		return []SymbolId{CommaToken}
+	case _State67:
+		return []SymbolId{CommaToken}
+	case _State69:
+		return []SymbolId{CommaToken}
+	case _State70:
+		return []SymbolId{CommaToken}
+	case _State71:
+		return []SymbolId{CommaToken}
+	case _State73:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State74:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State76:
+		return []SymbolId{RbraceToken}
+	case _State78:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, TrueToken, FalseToken}
+	case _State80:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, TrueToken, FalseToken}
+	case _State81:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State82:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State84:
+		return []SymbolId{LbracketToken}
+	case _State86:
+		return []SymbolId{StringLiteralToken, IdentifierToken}
+	case _State87:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State88:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State90:
+		return []SymbolId{StringLiteralToken, IdentifierToken}
+	case _State91:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State92:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State94:
+		return []SymbolId{RparenToken}
+	case _State96:
+		return []SymbolId{RparenToken}
+	case _State99:
+		return []SymbolId{RparenToken}
+	case _State101:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State104:
+		return []SymbolId{RparenToken}
+	case _State105:
+		return []SymbolId{RparenToken}
+	case _State106:
+		return []SymbolId{CommaToken}
+	case _State107:
+		return []SymbolId{CommaToken}
+	case _State108:
+		return []SymbolId{CommaToken}
+	case _State112:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, TrueToken, FalseToken}
+	case _State113:
+		return []SymbolId{IntegerLiteralToken}
+	case _State116:
+		return []SymbolId{ColonToken}
+	case _State118:
+		return []SymbolId{RbracketToken}
+	case _State119:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State120:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State121:
+		return []SymbolId{IntegerLiteralToken, FloatLiteralToken, AtToken, PercentToken, ZeroToken, TrueToken, FalseToken}
+	case _State122:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State123:
 		return []SymbolId{LbraceToken}
+	case _State124:
+		return []SymbolId{ColonToken}
+	case _State126:
+		return []SymbolId{CommaToken}
+	case _State127:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
+	case _State128:
+		return []SymbolId{RparenToken, CommaToken}
+	case _State129:
+		return []SymbolId{IdentifierToken, LbracketToken, StarToken, FuncToken, StructToken}
 	}
 
 	return nil
@@ -385,6 +730,10 @@ func (i SymbolId) String() string {
 		return "LBRACE"
 	case RbraceToken:
 		return "RBRACE"
+	case LbracketToken:
+		return "LBRACKET"
+	case RbracketToken:
+		return "RBRACKET"
 	case CommaToken:
 		return "COMMA"
 	case ColonToken:
@@ -395,16 +744,56 @@ func (i SymbolId) String() string {
 		return "PERCENT"
 	case EqualToken:
 		return "EQUAL"
+	case StarToken:
+		return "STAR"
 	case DefineToken:
 		return "DEFINE"
+	case DeclareToken:
+		return "DECLARE"
 	case FuncToken:
 		return "FUNC"
+	case DataToken:
+		return "DATA"
+	case VarToken:
+		return "VAR"
+	case LoadToken:
+		return "LOAD"
+	case StoreToken:
+		return "STORE"
+	case ExtractToken:
+		return "EXTRACT"
+	case InsertToken:
+		return "INSERT"
+	case StructToken:
+		return "STRUCT"
+	case ZeroToken:
+		return "ZERO"
+	case GetelemToken:
+		return "GETELEM"
+	case SetelemToken:
+		return "SETELEM"
+	case TrueToken:
+		return "TRUE"
+	case FalseToken:
+		return "FALSE"
+	case SelectToken:
+		return "SELECT"
+	case SwitchToken:
+		return "SWITCH"
+	case TailcallToken:
+		return "TAILCALL"
 	case LineType:
 		return "line"
 	case DefinitionType:
 		return "definition"
+	case DeclarationType:
+		return "declaration"
 	case RbraceType:
 		return "rbrace"
+	case CallConventionType:
+		return "call_convention"
+	case ReturnTypeType:
+		return "return_type"
 	case GlobalLabelType:
 		return "global_label"
 	case LocalLabelType:
@@ -419,6 +808,10 @@ func (i SymbolId) String() string {
 		return "int_immediate"
 	case FloatImmediateType:
 		return "float_immediate"
+	case BoolImmediateType:
+		return "bool_immediate"
+	case ZeroImmediateType:
+		return "zero_immediate"
 	case TypedVariableDefinitionType:
 		return "typed_variable_definition"
 	case VariableDefinitionType:
@@ -433,10 +826,26 @@ func (i SymbolId) String() string {
 		return "arguments"
 	case ProperArgumentsType:
 		return "proper_arguments"
+	case TupleVariableDefinitionsType:
+		return "tuple_variable_definitions"
+	case DataValuesType:
+		return "data_values"
 	case TypesType:
 		return "types"
 	case ProperTypesType:
 		return "proper_types"
+	case StructFieldsType:
+		return "struct_fields"
+	case ProperStructFieldsType:
+		return "proper_struct_fields"
+	case SwitchCasesType:
+		return "switch_cases"
+	case ProperSwitchCasesType:
+		return "proper_switch_cases"
+	case SwitchCaseType:
+		return "switch_case"
+	case DataValueType:
+		return "data_value"
 	case OperationInstructionType:
 		return "operation_instruction"
 	case ControlFlowInstructionType:
@@ -447,6 +856,14 @@ func (i SymbolId) String() string {
 		return "number_type"
 	case FuncTypeType:
 		return "func_type"
+	case PointerTypeType:
+		return "pointer_type"
+	case StructTypeType:
+		return "struct_type"
+	case StructFieldType:
+		return "struct_field"
+	case ArrayTypeType:
+		return "array_type"
 	default:
 		return fmt.Sprintf("?unknown symbol %d?", int(i))
 	}
@@ -456,30 +873,47 @@ const (
 	_EndMarker      = SymbolId(0)
 	_WildcardMarker = SymbolId(-1)
 
-	LineType                    = SymbolId(271)
-	DefinitionType              = SymbolId(272)
-	RbraceType                  = SymbolId(273)
-	GlobalLabelType             = SymbolId(274)
-	LocalLabelType              = SymbolId(275)
-	VariableReferenceType       = SymbolId(276)
-	IdentifierType              = SymbolId(277)
-	ImmediateType               = SymbolId(278)
-	IntImmediateType            = SymbolId(279)
-	FloatImmediateType          = SymbolId(280)
-	TypedVariableDefinitionType = SymbolId(281)
-	VariableDefinitionType      = SymbolId(282)
-	ValueType                   = SymbolId(283)
-	ParametersType              = SymbolId(284)
-	ProperParametersType        = SymbolId(285)
-	ArgumentsType               = SymbolId(286)
-	ProperArgumentsType         = SymbolId(287)
-	TypesType                   = SymbolId(288)
-	ProperTypesType             = SymbolId(289)
-	OperationInstructionType    = SymbolId(290)
-	ControlFlowInstructionType  = SymbolId(291)
-	TypeType                    = SymbolId(292)
-	NumberTypeType              = SymbolId(293)
-	FuncTypeType                = SymbolId(294)
+	LineType                     = SymbolId(290)
+	DefinitionType               = SymbolId(291)
+	DeclarationType              = SymbolId(292)
+	RbraceType                   = SymbolId(293)
+	CallConventionType           = SymbolId(294)
+	ReturnTypeType               = SymbolId(295)
+	GlobalLabelType              = SymbolId(296)
+	LocalLabelType               = SymbolId(297)
+	VariableReferenceType        = SymbolId(298)
+	IdentifierType               = SymbolId(299)
+	ImmediateType                = SymbolId(300)
+	IntImmediateType             = SymbolId(301)
+	FloatImmediateType           = SymbolId(302)
+	BoolImmediateType            = SymbolId(303)
+	ZeroImmediateType            = SymbolId(304)
+	TypedVariableDefinitionType  = SymbolId(305)
+	VariableDefinitionType       = SymbolId(306)
+	ValueType                    = SymbolId(307)
+	ParametersType               = SymbolId(308)
+	ProperParametersType         = SymbolId(309)
+	ArgumentsType                = SymbolId(310)
+	ProperArgumentsType          = SymbolId(311)
+	TupleVariableDefinitionsType = SymbolId(312)
+	DataValuesType               = SymbolId(313)
+	TypesType                    = SymbolId(314)
+	ProperTypesType              = SymbolId(315)
+	StructFieldsType             = SymbolId(316)
+	ProperStructFieldsType       = SymbolId(317)
+	SwitchCasesType              = SymbolId(318)
+	ProperSwitchCasesType        = SymbolId(319)
+	SwitchCaseType               = SymbolId(320)
+	DataValueType                = SymbolId(321)
+	OperationInstructionType     = SymbolId(322)
+	ControlFlowInstructionType   = SymbolId(323)
+	TypeType                     = SymbolId(324)
+	NumberTypeType               = SymbolId(325)
+	FuncTypeType                 = SymbolId(326)
+	PointerTypeType              = SymbolId(327)
+	StructTypeType               = SymbolId(328)
+	StructFieldType              = SymbolId(329)
+	ArrayTypeType                = SymbolId(330)
 )
 
 type _ActionType int
@@ -511,59 +945,114 @@ type _ReduceType int
 
 const (
 	_ReduceDefinitionToLine                            = _ReduceType(1)
-	_ReduceRbraceToLine                                = _ReduceType(2)
-	_ReduceLocalLabelToLine                            = _ReduceType(3)
-	_ReduceOperationInstructionToLine                  = _ReduceType(4)
-	_ReduceControlFlowInstructionToLine                = _ReduceType(5)
-	_ReduceFuncToDefinition                            = _ReduceType(6)
-	_ReduceToRbrace                                    = _ReduceType(7)
-	_ReduceToGlobalLabel                               = _ReduceType(8)
-	_ReduceToLocalLabel                                = _ReduceType(9)
-	_ReduceToVariableReference                         = _ReduceType(10)
-	_ReduceIdentifierToIdentifier                      = _ReduceType(11)
-	_ReduceStringToIdentifier                          = _ReduceType(12)
-	_ReduceIntImmediateToImmediate                     = _ReduceType(13)
-	_ReduceFloatImmediateToImmediate                   = _ReduceType(14)
-	_ReduceToIntImmediate                              = _ReduceType(15)
-	_ReduceToFloatImmediate                            = _ReduceType(16)
-	_ReduceToTypedVariableDefinition                   = _ReduceType(17)
-	_ReduceTypedVariableDefinitionToVariableDefinition = _ReduceType(18)
-	_ReduceInferredToVariableDefinition                = _ReduceType(19)
-	_ReduceVariableReferenceToValue                    = _ReduceType(20)
-	_ReduceGlobalLabelToValue                          = _ReduceType(21)
-	_ReduceImmediateToValue                            = _ReduceType(22)
-	_ReduceProperParametersToParameters                = _ReduceType(23)
-	_ReduceImproperToParameters                        = _ReduceType(24)
-	_ReduceNilToParameters                             = _ReduceType(25)
-	_ReduceAddToProperParameters                       = _ReduceType(26)
-	_ReduceNewToProperParameters                       = _ReduceType(27)
-	_ReduceProperArgumentsToArguments                  = _ReduceType(28)
-	_ReduceImproperToArguments                         = _ReduceType(29)
-	_ReduceNilToArguments                              = _ReduceType(30)
-	_ReduceAddToProperArguments                        = _ReduceType(31)
-	_ReduceNewToProperArguments                        = _ReduceType(32)
-	_ReduceProperTypesToTypes                          = _ReduceType(33)
-	_ReduceImproperToTypes                             = _ReduceType(34)
-	_ReduceNilToTypes                                  = _ReduceType(35)
-	_ReduceAddToProperTypes                            = _ReduceType(36)
-	_ReduceNewToProperTypes                            = _ReduceType(37)
-	_ReduceAssignToOperationInstruction                = _ReduceType(38)
-	_ReduceUnaryToOperationInstruction                 = _ReduceType(39)
-	_ReduceBinaryToOperationInstruction                = _ReduceType(40)
-	_ReduceCallToOperationInstruction                  = _ReduceType(41)
-	_ReduceUnconditionalToControlFlowInstruction       = _ReduceType(42)
-	_ReduceConditionalToControlFlowInstruction         = _ReduceType(43)
-	_ReduceTerminalToControlFlowInstruction            = _ReduceType(44)
-	_ReduceNumberTypeToType                            = _ReduceType(45)
-	_ReduceFuncTypeToType                              = _ReduceType(46)
-	_ReduceToNumberType                                = _ReduceType(47)
-	_ReduceToFuncType                                  = _ReduceType(48)
+	_ReduceDeclarationToLine                           = _ReduceType(2)
+	_ReduceRbraceToLine                                = _ReduceType(3)
+	_ReduceLocalLabelToLine                            = _ReduceType(4)
+	_ReduceOperationInstructionToLine                  = _ReduceType(5)
+	_ReduceControlFlowInstructionToLine                = _ReduceType(6)
+	_ReduceFuncToDefinition                            = _ReduceType(7)
+	_ReduceDataToDefinition                            = _ReduceType(8)
+	_ReduceVarToDefinition                             = _ReduceType(9)
+	_ReduceFuncToDeclaration                           = _ReduceType(10)
+	_ReduceToRbrace                                    = _ReduceType(11)
+	_ReduceNamedToCallConvention                       = _ReduceType(12)
+	_ReduceDefaultToCallConvention                     = _ReduceType(13)
+	_ReduceTypeToReturnType                            = _ReduceType(14)
+	_ReduceTupleToReturnType                           = _ReduceType(15)
+	_ReduceUnitToReturnType                            = _ReduceType(16)
+	_ReduceToGlobalLabel                               = _ReduceType(17)
+	_ReduceToLocalLabel                                = _ReduceType(18)
+	_ReduceToVariableReference                         = _ReduceType(19)
+	_ReduceIdentifierToIdentifier                      = _ReduceType(20)
+	_ReduceStringToIdentifier                          = _ReduceType(21)
+	_ReduceIntImmediateToImmediate                     = _ReduceType(22)
+	_ReduceFloatImmediateToImmediate                   = _ReduceType(23)
+	_ReduceBoolImmediateToImmediate                    = _ReduceType(24)
+	_ReduceToIntImmediate                              = _ReduceType(25)
+	_ReduceToFloatImmediate                            = _ReduceType(26)
+	_ReduceTrueToBoolImmediate                         = _ReduceType(27)
+	_ReduceFalseToBoolImmediate                        = _ReduceType(28)
+	_ReduceToZeroImmediate                             = _ReduceType(29)
+	_ReduceToTypedVariableDefinition                   = _ReduceType(30)
+	_ReduceTypedVariableDefinitionToVariableDefinition = _ReduceType(31)
+	_ReduceInferredToVariableDefinition                = _ReduceType(32)
+	_ReduceVariableReferenceToValue                    = _ReduceType(33)
+	_ReduceGlobalLabelToValue                          = _ReduceType(34)
+	_ReduceImmediateToValue                            = _ReduceType(35)
+	_ReduceZeroImmediateToValue                        = _ReduceType(36)
+	_ReduceProperParametersToParameters                = _ReduceType(37)
+	_ReduceImproperToParameters                        = _ReduceType(38)
+	_ReduceNilToParameters                             = _ReduceType(39)
+	_ReduceAddToProperParameters                       = _ReduceType(40)
+	_ReduceNewToProperParameters                       = _ReduceType(41)
+	_ReduceProperArgumentsToArguments                  = _ReduceType(42)
+	_ReduceImproperToArguments                         = _ReduceType(43)
+	_ReduceNilToArguments                              = _ReduceType(44)
+	_ReduceAddToProperArguments                        = _ReduceType(45)
+	_ReduceNewToProperArguments                        = _ReduceType(46)
+	_ReduceAddToTupleVariableDefinitions               = _ReduceType(47)
+	_ReduceNewToTupleVariableDefinitions               = _ReduceType(48)
+	_ReduceAddToDataValues                             = _ReduceType(49)
+	_ReduceNewToDataValues                             = _ReduceType(50)
+	_ReduceProperTypesToTypes                          = _ReduceType(51)
+	_ReduceImproperToTypes                             = _ReduceType(52)
+	_ReduceNilToTypes                                  = _ReduceType(53)
+	_ReduceAddToProperTypes                            = _ReduceType(54)
+	_ReduceNewToProperTypes                            = _ReduceType(55)
+	_ReduceProperStructFieldsToStructFields            = _ReduceType(56)
+	_ReduceImproperToStructFields                      = _ReduceType(57)
+	_ReduceNilToStructFields                           = _ReduceType(58)
+	_ReduceAddToProperStructFields                     = _ReduceType(59)
+	_ReduceNewToProperStructFields                     = _ReduceType(60)
+	_ReduceProperSwitchCasesToSwitchCases              = _ReduceType(61)
+	_ReduceImproperToSwitchCases                       = _ReduceType(62)
+	_ReduceNilToSwitchCases                            = _ReduceType(63)
+	_ReduceAddToProperSwitchCases                      = _ReduceType(64)
+	_ReduceNewToProperSwitchCases                      = _ReduceType(65)
+	_ReduceToSwitchCase                                = _ReduceType(66)
+	_ReduceImmediateToDataValue                        = _ReduceType(67)
+	_ReduceStringToDataValue                           = _ReduceType(68)
+	_ReduceRepeatedToDataValue                         = _ReduceType(69)
+	_ReduceAssignToOperationInstruction                = _ReduceType(70)
+	_ReduceUnaryToOperationInstruction                 = _ReduceType(71)
+	_ReduceBinaryToOperationInstruction                = _ReduceType(72)
+	_ReduceCallToOperationInstruction                  = _ReduceType(73)
+	_ReduceTupleCallToOperationInstruction             = _ReduceType(74)
+	_ReduceIgnoredCallToOperationInstruction           = _ReduceType(75)
+	_ReduceLoadToOperationInstruction                  = _ReduceType(76)
+	_ReduceStoreToOperationInstruction                 = _ReduceType(77)
+	_ReduceExtractToOperationInstruction               = _ReduceType(78)
+	_ReduceInsertToOperationInstruction                = _ReduceType(79)
+	_ReduceGetElementToOperationInstruction            = _ReduceType(80)
+	_ReduceSetElementToOperationInstruction            = _ReduceType(81)
+	_ReduceSelectToOperationInstruction                = _ReduceType(82)
+	_ReduceUnconditionalToControlFlowInstruction       = _ReduceType(83)
+	_ReduceConditionalToControlFlowInstruction         = _ReduceType(84)
+	_ReduceBoolConditionalToControlFlowInstruction     = _ReduceType(85)
+	_ReduceSwitchToControlFlowInstruction              = _ReduceType(86)
+	_ReduceTailCallToControlFlowInstruction            = _ReduceType(87)
+	_ReduceTerminalToControlFlowInstruction            = _ReduceType(88)
+	_ReduceTupleTerminalToControlFlowInstruction       = _ReduceType(89)
+	_ReduceUnitTerminalToControlFlowInstruction        = _ReduceType(90)
+	_ReduceNumberTypeToType                            = _ReduceType(91)
+	_ReduceFuncTypeToType                              = _ReduceType(92)
+	_ReducePointerTypeToType                           = _ReduceType(93)
+	_ReduceStructTypeToType                            = _ReduceType(94)
+	_ReduceArrayTypeToType                             = _ReduceType(95)
+	_ReduceToNumberType                                = _ReduceType(96)
+	_ReduceToFuncType                                  = _ReduceType(97)
+	_ReduceToPointerType                               = _ReduceType(98)
+	_ReduceToStructType                                = _ReduceType(99)
+	_ReduceToStructField                               = _ReduceType(100)
+	_ReduceToArrayType                                 = _ReduceType(101)
 )
 
 func (i _ReduceType) String() string {
 	switch i {
 	case _ReduceDefinitionToLine:
 		return "DefinitionToLine"
+	case _ReduceDeclarationToLine:
+		return "DeclarationToLine"
 	case _ReduceRbraceToLine:
 		return "RbraceToLine"
 	case _ReduceLocalLabelToLine:
@@ -574,8 +1063,24 @@ func (i _ReduceType) String() string {
 		return "ControlFlowInstructionToLine"
 	case _ReduceFuncToDefinition:
 		return "FuncToDefinition"
+	case _ReduceDataToDefinition:
+		return "DataToDefinition"
+	case _ReduceVarToDefinition:
+		return "VarToDefinition"
+	case _ReduceFuncToDeclaration:
+		return "FuncToDeclaration"
 	case _ReduceToRbrace:
 		return "ToRbrace"
+	case _ReduceNamedToCallConvention:
+		return "NamedToCallConvention"
+	case _ReduceDefaultToCallConvention:
+		return "DefaultToCallConvention"
+	case _ReduceTypeToReturnType:
+		return "TypeToReturnType"
+	case _ReduceTupleToReturnType:
+		return "TupleToReturnType"
+	case _ReduceUnitToReturnType:
+		return "UnitToReturnType"
 	case _ReduceToGlobalLabel:
 		return "ToGlobalLabel"
 	case _ReduceToLocalLabel:
@@ -590,10 +1095,18 @@ func (i _ReduceType) String() string {
 		return "IntImmediateToImmediate"
 	case _ReduceFloatImmediateToImmediate:
 		return "FloatImmediateToImmediate"
+	case _ReduceBoolImmediateToImmediate:
+		return "BoolImmediateToImmediate"
 	case _ReduceToIntImmediate:
 		return "ToIntImmediate"
 	case _ReduceToFloatImmediate:
 		return "ToFloatImmediate"
+	case _ReduceTrueToBoolImmediate:
+		return "TrueToBoolImmediate"
+	case _ReduceFalseToBoolImmediate:
+		return "FalseToBoolImmediate"
+	case _ReduceToZeroImmediate:
+		return "ToZeroImmediate"
 	case _ReduceToTypedVariableDefinition:
 		return "ToTypedVariableDefinition"
 	case _ReduceTypedVariableDefinitionToVariableDefinition:
@@ -606,6 +1119,8 @@ func (i _ReduceType) String() string {
 		return "GlobalLabelToValue"
 	case _ReduceImmediateToValue:
 		return "ImmediateToValue"
+	case _ReduceZeroImmediateToValue:
+		return "ZeroImmediateToValue"
 	case _ReduceProperParametersToParameters:
 		return "ProperParametersToParameters"
 	case _ReduceImproperToParameters:
@@ -626,6 +1141,14 @@ func (i _ReduceType) String() string {
 		return "AddToProperArguments"
 	case _ReduceNewToProperArguments:
 		return "NewToProperArguments"
+	case _ReduceAddToTupleVariableDefinitions:
+		return "AddToTupleVariableDefinitions"
+	case _ReduceNewToTupleVariableDefinitions:
+		return "NewToTupleVariableDefinitions"
+	case _ReduceAddToDataValues:
+		return "AddToDataValues"
+	case _ReduceNewToDataValues:
+		return "NewToDataValues"
 	case _ReduceProperTypesToTypes:
 		return "ProperTypesToTypes"
 	case _ReduceImproperToTypes:
@@ -636,6 +1159,34 @@ func (i _ReduceType) String() string {
 		return "AddToProperTypes"
 	case _ReduceNewToProperTypes:
 		return "NewToProperTypes"
+	case _ReduceProperStructFieldsToStructFields:
+		return "ProperStructFieldsToStructFields"
+	case _ReduceImproperToStructFields:
+		return "ImproperToStructFields"
+	case _ReduceNilToStructFields:
+		return "NilToStructFields"
+	case _ReduceAddToProperStructFields:
+		return "AddToProperStructFields"
+	case _ReduceNewToProperStructFields:
+		return "NewToProperStructFields"
+	case _ReduceProperSwitchCasesToSwitchCases:
+		return "ProperSwitchCasesToSwitchCases"
+	case _ReduceImproperToSwitchCases:
+		return "ImproperToSwitchCases"
+	case _ReduceNilToSwitchCases:
+		return "NilToSwitchCases"
+	case _ReduceAddToProperSwitchCases:
+		return "AddToProperSwitchCases"
+	case _ReduceNewToProperSwitchCases:
+		return "NewToProperSwitchCases"
+	case _ReduceToSwitchCase:
+		return "ToSwitchCase"
+	case _ReduceImmediateToDataValue:
+		return "ImmediateToDataValue"
+	case _ReduceStringToDataValue:
+		return "StringToDataValue"
+	case _ReduceRepeatedToDataValue:
+		return "RepeatedToDataValue"
 	case _ReduceAssignToOperationInstruction:
 		return "AssignToOperationInstruction"
 	case _ReduceUnaryToOperationInstruction:
@@ -644,20 +1195,62 @@ func (i _ReduceType) String() string {
 		return "BinaryToOperationInstruction"
 	case _ReduceCallToOperationInstruction:
 		return "CallToOperationInstruction"
+	case _ReduceTupleCallToOperationInstruction:
+		return "TupleCallToOperationInstruction"
+	case _ReduceIgnoredCallToOperationInstruction:
+		return "IgnoredCallToOperationInstruction"
+	case _ReduceLoadToOperationInstruction:
+		return "LoadToOperationInstruction"
+	case _ReduceStoreToOperationInstruction:
+		return "StoreToOperationInstruction"
+	case _ReduceExtractToOperationInstruction:
+		return "ExtractToOperationInstruction"
+	case _ReduceInsertToOperationInstruction:
+		return "InsertToOperationInstruction"
+	case _ReduceGetElementToOperationInstruction:
+		return "GetElementToOperationInstruction"
+	case _ReduceSetElementToOperationInstruction:
+		return "SetElementToOperationInstruction"
+	case _ReduceSelectToOperationInstruction:
+		return "SelectToOperationInstruction"
 	case _ReduceUnconditionalToControlFlowInstruction:
 		return "UnconditionalToControlFlowInstruction"
 	case _ReduceConditionalToControlFlowInstruction:
 		return "ConditionalToControlFlowInstruction"
+	case _ReduceBoolConditionalToControlFlowInstruction:
+		return "BoolConditionalToControlFlowInstruction"
+	case _ReduceSwitchToControlFlowInstruction:
+		return "SwitchToControlFlowInstruction"
+	case _ReduceTailCallToControlFlowInstruction:
+		return "TailCallToControlFlowInstruction"
 	case _ReduceTerminalToControlFlowInstruction:
 		return "TerminalToControlFlowInstruction"
+	case _ReduceTupleTerminalToControlFlowInstruction:
+		return "TupleTerminalToControlFlowInstruction"
+	case _ReduceUnitTerminalToControlFlowInstruction:
+		return "UnitTerminalToControlFlowInstruction"
 	case _ReduceNumberTypeToType:
 		return "NumberTypeToType"
 	case _ReduceFuncTypeToType:
 		return "FuncTypeToType"
+	case _ReducePointerTypeToType:
+		return "PointerTypeToType"
+	case _ReduceStructTypeToType:
+		return "StructTypeToType"
+	case _ReduceArrayTypeToType:
+		return "ArrayTypeToType"
 	case _ReduceToNumberType:
 		return "ToNumberType"
 	case _ReduceToFuncType:
 		return "ToFuncType"
+	case _ReduceToPointerType:
+		return "ToPointerType"
+	case _ReduceToStructType:
+		return "ToStructType"
+	case _ReduceToStructField:
+		return "ToStructField"
+	case _ReduceToArrayType:
+		return "ToArrayType"
 	default:
 		return fmt.Sprintf("?unknown reduce type %d?", int(i))
 	}
@@ -670,42 +1263,135 @@ func (id _StateId) String() string {
 }
 
 const (
-	_State1  = _StateId(1)
-	_State2  = _StateId(2)
-	_State3  = _StateId(3)
-	_State4  = _StateId(4)
-	_State5  = _StateId(5)
-	_State6  = _StateId(6)
-	_State7  = _StateId(7)
-	_State8  = _StateId(8)
-	_State9  = _StateId(9)
-	_State10 = _StateId(10)
-	_State11 = _StateId(11)
-	_State12 = _StateId(12)
-	_State13 = _StateId(13)
-	_State14 = _StateId(14)
-	_State15 = _StateId(15)
-	_State16 = _StateId(16)
-	_State17 = _StateId(17)
-	_State18 = _StateId(18)
-	_State19 = _StateId(19)
-	_State20 = _StateId(20)
-	_State21 = _StateId(21)
-	_State22 = _StateId(22)
-	_State23 = _StateId(23)
-	_State24 = _StateId(24)
-	_State25 = _StateId(25)
-	_State26 = _StateId(26)
-	_State27 = _StateId(27)
-	_State28 = _StateId(28)
-	_State29 = _StateId(29)
-	_State30 = _StateId(30)
-	_State31 = _StateId(31)
-	_State32 = _StateId(32)
-	_State33 = _StateId(33)
-	_State34 = _StateId(34)
-	_State35 = _StateId(35)
-	_State36 = _StateId(36)
+	_State1   = _StateId(1)
+	_State2   = _StateId(2)
+	_State3   = _StateId(3)
+	_State4   = _StateId(4)
+	_State5   = _StateId(5)
+	_State6   = _StateId(6)
+	_State7   = _StateId(7)
+	_State8   = _StateId(8)
+	_State9   = _StateId(9)
+	_State10  = _StateId(10)
+	_State11  = _StateId(11)
+	_State12  = _StateId(12)
+	_State13  = _StateId(13)
+	_State14  = _StateId(14)
+	_State15  = _StateId(15)
+	_State16  = _StateId(16)
+	_State17  = _StateId(17)
+	_State18  = _StateId(18)
+	_State19  = _StateId(19)
+	_State20  = _StateId(20)
+	_State21  = _StateId(21)
+	_State22  = _StateId(22)
+	_State23  = _StateId(23)
+	_State24  = _StateId(24)
+	_State25  = _StateId(25)
+	_State26  = _StateId(26)
+	_State27  = _StateId(27)
+	_State28  = _StateId(28)
+	_State29  = _StateId(29)
+	_State30  = _StateId(30)
+	_State31  = _StateId(31)
+	_State32  = _StateId(32)
+	_State33  = _StateId(33)
+	_State34  = _StateId(34)
+	_State35  = _StateId(35)
+	_State36  = _StateId(36)
+	_State37  = _StateId(37)
+	_State38  = _StateId(38)
+	_State39  = _StateId(39)
+	_State40  = _StateId(40)
+	_State41  = _StateId(41)
+	_State42  = _StateId(42)
+	_State43  = _StateId(43)
+	_State44  = _StateId(44)
+	_State45  = _StateId(45)
+	_State46  = _StateId(46)
+	_State47  = _StateId(47)
+	_State48  = _StateId(48)
+	_State49  = _StateId(49)
+	_State50  = _StateId(50)
+	_State51  = _StateId(51)
+	_State52  = _StateId(52)
+	_State53  = _StateId(53)
+	_State54  = _StateId(54)
+	_State55  = _StateId(55)
+	_State56  = _StateId(56)
+	_State57  = _StateId(57)
+	_State58  = _StateId(58)
+	_State59  = _StateId(59)
+	_State60  = _StateId(60)
+	_State61  = _StateId(61)
+	_State62  = _StateId(62)
+	_State63  = _StateId(63)
+	_State64  = _StateId(64)
+	_State65  = _StateId(65)
+	_State66  = _StateId(66)
+	_State67  = _StateId(67)
+	_State68  = _StateId(68)
+	_State69  = _StateId(69)
+	_State70  = _StateId(70)
+	_State71  = _StateId(71)
+	_State72  = _StateId(72)
+	_State73  = _StateId(73)
+	_State74  = _StateId(74)
+	_State75  = _StateId(75)
+	_State76  = _StateId(76)
+	_State77  = _StateId(77)
+	_State78  = _StateId(78)
+	_State79  = _StateId(79)
+	_State80  = _StateId(80)
+	_State81  = _StateId(81)
+	_State82  = _StateId(82)
+	_State83  = _StateId(83)
+	_State84  = _StateId(84)
+	_State85  = _StateId(85)
+	_State86  = _StateId(86)
+	_State87  = _StateId(87)
+	_State88  = _StateId(88)
+	_State89  = _StateId(89)
+	_State90  = _StateId(90)
+	_State91  = _StateId(91)
+	_State92  = _StateId(92)
+	_State93  = _StateId(93)
+	_State94  = _StateId(94)
+	_State95  = _StateId(95)
+	_State96  = _StateId(96)
+	_State97  = _StateId(97)
+	_State98  = _StateId(98)
+	_State99  = _StateId(99)
+	_State100 = _StateId(100)
+	_State101 = _StateId(101)
+	_State102 = _StateId(102)
+	_State103 = _StateId(103)
+	_State104 = _StateId(104)
+	_State105 = _StateId(105)
+	_State106 = _StateId(106)
+	_State107 = _StateId(107)
+	_State108 = _StateId(108)
+	_State109 = _StateId(109)
+	_State110 = _StateId(110)
+	_State111 = _StateId(111)
+	_State112 = _StateId(112)
+	_State113 = _StateId(113)
+	_State114 = _StateId(114)
+	_State115 = _StateId(115)
+	_State116 = _StateId(116)
+	_State117 = _StateId(117)
+	_State118 = _StateId(118)
+	_State119 = _StateId(119)
+	_State120 = _StateId(120)
+	_State121 = _StateId(121)
+	_State122 = _StateId(122)
+	_State123 = _StateId(123)
+	_State124 = _StateId(124)
+	_State125 = _StateId(125)
+	_State126 = _StateId(126)
+	_State127 = _StateId(127)
+	_State128 = _StateId(128)
+	_State129 = _StateId(129)
 )
 
 type Symbol struct {
@@ -715,12 +1401,18 @@ type Symbol struct {
 
 	Arguments            []ast.Value
 	Count                *TokenCount
+	DataValue            ast.DataValue
+	DataValues           []ast.DataValue
 	GlobalLabelReference *ast.GlobalLabelReference
 	Instruction          ast.Instruction
 	Line                 ast.Line
 	LocalLabel           ParsedLocalLabel
 	OpValue              ast.Value
 	Parameters           []*ast.VariableDefinition
+	StructField          *ast.StructField
+	StructFields         []*ast.StructField
+	SwitchCase           *ast.SwitchCase
+	SwitchCases          []*ast.SwitchCase
 	Type                 ast.Type
 	Types                []ast.Type
 	Value                *TokenValue
@@ -746,7 +1438,7 @@ func NewSymbol(token parseutil.Token[SymbolId]) (*Symbol, error) {
 				token.Id())
 		}
 		symbol.Generic_ = val
-	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, DefineToken, FuncToken:
+	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, LbracketToken, RbracketToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, StarToken, DefineToken, DeclareToken, FuncToken, DataToken, VarToken, LoadToken, StoreToken, ExtractToken, InsertToken, StructToken, ZeroToken, GetelemToken, SetelemToken, TrueToken, FalseToken, SelectToken, SwitchToken, TailcallToken:
 		val, ok := token.(*TokenValue)
 		if !ok {
 			return nil, parseutil.NewLocationError(
@@ -777,6 +1469,16 @@ func (s *Symbol) StartEnd() parseutil.StartEndPos {
 		if ok {
 			return loc.StartEnd()
 		}
+	case DataValueType:
+		loc, ok := interface{}(s.DataValue).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
+	case DataValuesType:
+		loc, ok := interface{}(s.DataValues).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
 	case GlobalLabelType:
 		loc, ok := interface{}(s.GlobalLabelReference).(locator)
 		if ok {
@@ -787,7 +1489,7 @@ func (s *Symbol) StartEnd() parseutil.StartEndPos {
 		if ok {
 			return loc.StartEnd()
 		}
-	case LineType, DefinitionType, RbraceType:
+	case LineType, DefinitionType, DeclarationType, RbraceType:
 		loc, ok := interface{}(s.Line).(locator)
 		if ok {
 			return loc.StartEnd()
@@ -797,17 +1499,37 @@ func (s *Symbol) StartEnd() parseutil.StartEndPos {
 		if ok {
 			return loc.StartEnd()
 		}
-	case ImmediateType, IntImmediateType, FloatImmediateType, ValueType:
+	case ImmediateType, IntImmediateType, FloatImmediateType, BoolImmediateType, ZeroImmediateType, ValueType:
 		loc, ok := interface{}(s.OpValue).(locator)
 		if ok {
 			return loc.StartEnd()
 		}
-	case ParametersType, ProperParametersType:
+	case ParametersType, ProperParametersType, TupleVariableDefinitionsType:
 		loc, ok := interface{}(s.Parameters).(locator)
 		if ok {
 			return loc.StartEnd()
 		}
-	case TypeType, NumberTypeType, FuncTypeType:
+	case StructFieldType:
+		loc, ok := interface{}(s.StructField).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
+	case StructFieldsType, ProperStructFieldsType:
+		loc, ok := interface{}(s.StructFields).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
+	case SwitchCaseType:
+		loc, ok := interface{}(s.SwitchCase).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
+	case SwitchCasesType, ProperSwitchCasesType:
+		loc, ok := interface{}(s.SwitchCases).(locator)
+		if ok {
+			return loc.StartEnd()
+		}
+	case ReturnTypeType, TypeType, NumberTypeType, FuncTypeType, PointerTypeType, StructTypeType, ArrayTypeType:
 		loc, ok := interface{}(s.Type).(locator)
 		if ok {
 			return loc.StartEnd()
@@ -817,7 +1539,7 @@ func (s *Symbol) StartEnd() parseutil.StartEndPos {
 		if ok {
 			return loc.StartEnd()
 		}
-	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, DefineToken, FuncToken, IdentifierType:
+	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, LbracketToken, RbracketToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, StarToken, DefineToken, DeclareToken, FuncToken, DataToken, VarToken, LoadToken, StoreToken, ExtractToken, InsertToken, StructToken, ZeroToken, GetelemToken, SetelemToken, TrueToken, FalseToken, SelectToken, SwitchToken, TailcallToken, CallConventionType, IdentifierType:
 		loc, ok := interface{}(s.Value).(locator)
 		if ok {
 			return loc.StartEnd()
@@ -844,6 +1566,16 @@ func (s *Symbol) Loc() parseutil.Location {
 		if ok {
 			return loc.Loc()
 		}
+	case DataValueType:
+		loc, ok := interface{}(s.DataValue).(locator)
+		if ok {
+			return loc.Loc()
+		}
+	case DataValuesType:
+		loc, ok := interface{}(s.DataValues).(locator)
+		if ok {
+			return loc.Loc()
+		}
 	case GlobalLabelType:
 		loc, ok := interface{}(s.GlobalLabelReference).(locator)
 		if ok {
@@ -854,7 +1586,7 @@ func (s *Symbol) Loc() parseutil.Location {
 		if ok {
 			return loc.Loc()
 		}
-	case LineType, DefinitionType, RbraceType:
+	case LineType, DefinitionType, DeclarationType, RbraceType:
 		loc, ok := interface{}(s.Line).(locator)
 		if ok {
 			return loc.Loc()
@@ -864,17 +1596,37 @@ func (s *Symbol) Loc() parseutil.Location {
 		if ok {
 			return loc.Loc()
 		}
-	case ImmediateType, IntImmediateType, FloatImmediateType, ValueType:
+	case ImmediateType, IntImmediateType, FloatImmediateType, BoolImmediateType, ZeroImmediateType, ValueType:
 		loc, ok := interface{}(s.OpValue).(locator)
 		if ok {
 			return loc.Loc()
 		}
-	case ParametersType, ProperParametersType:
+	case ParametersType, ProperParametersType, TupleVariableDefinitionsType:
 		loc, ok := interface{}(s.Parameters).(locator)
 		if ok {
 			return loc.Loc()
 		}
-	case TypeType, NumberTypeType, FuncTypeType:
+	case StructFieldType:
+		loc, ok := interface{}(s.StructField).(locator)
+		if ok {
+			return loc.Loc()
+		}
+	case StructFieldsType, ProperStructFieldsType:
+		loc, ok := interface{}(s.StructFields).(locator)
+		if ok {
+			return loc.Loc()
+		}
+	case SwitchCaseType:
+		loc, ok := interface{}(s.SwitchCase).(locator)
+		if ok {
+			return loc.Loc()
+		}
+	case SwitchCasesType, ProperSwitchCasesType:
+		loc, ok := interface{}(s.SwitchCases).(locator)
+		if ok {
+			return loc.Loc()
+		}
+	case ReturnTypeType, TypeType, NumberTypeType, FuncTypeType, PointerTypeType, StructTypeType, ArrayTypeType:
 		loc, ok := interface{}(s.Type).(locator)
 		if ok {
 			return loc.Loc()
@@ -884,7 +1636,7 @@ func (s *Symbol) Loc() parseutil.Location {
 		if ok {
 			return loc.Loc()
 		}
-	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, DefineToken, FuncToken, IdentifierType:
+	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, LbracketToken, RbracketToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, StarToken, DefineToken, DeclareToken, FuncToken, DataToken, VarToken, LoadToken, StoreToken, ExtractToken, InsertToken, StructToken, ZeroToken, GetelemToken, SetelemToken, TrueToken, FalseToken, SelectToken, SwitchToken, TailcallToken, CallConventionType, IdentifierType:
 		loc, ok := interface{}(s.Value).(locator)
 		if ok {
 			return loc.Loc()
@@ -911,6 +1663,16 @@ func (s *Symbol) End() parseutil.Location {
 		if ok {
 			return loc.End()
 		}
+	case DataValueType:
+		loc, ok := interface{}(s.DataValue).(locator)
+		if ok {
+			return loc.End()
+		}
+	case DataValuesType:
+		loc, ok := interface{}(s.DataValues).(locator)
+		if ok {
+			return loc.End()
+		}
 	case GlobalLabelType:
 		loc, ok := interface{}(s.GlobalLabelReference).(locator)
 		if ok {
@@ -921,7 +1683,7 @@ func (s *Symbol) End() parseutil.Location {
 		if ok {
 			return loc.End()
 		}
-	case LineType, DefinitionType, RbraceType:
+	case LineType, DefinitionType, DeclarationType, RbraceType:
 		loc, ok := interface{}(s.Line).(locator)
 		if ok {
 			return loc.End()
@@ -931,17 +1693,37 @@ func (s *Symbol) End() parseutil.Location {
 		if ok {
 			return loc.End()
 		}
-	case ImmediateType, IntImmediateType, FloatImmediateType, ValueType:
+	case ImmediateType, IntImmediateType, FloatImmediateType, BoolImmediateType, ZeroImmediateType, ValueType:
 		loc, ok := interface{}(s.OpValue).(locator)
 		if ok {
 			return loc.End()
 		}
-	case ParametersType, ProperParametersType:
+	case ParametersType, ProperParametersType, TupleVariableDefinitionsType:
 		loc, ok := interface{}(s.Parameters).(locator)
 		if ok {
 			return loc.End()
 		}
-	case TypeType, NumberTypeType, FuncTypeType:
+	case StructFieldType:
+		loc, ok := interface{}(s.StructField).(locator)
+		if ok {
+			return loc.End()
+		}
+	case StructFieldsType, ProperStructFieldsType:
+		loc, ok := interface{}(s.StructFields).(locator)
+		if ok {
+			return loc.End()
+		}
+	case SwitchCaseType:
+		loc, ok := interface{}(s.SwitchCase).(locator)
+		if ok {
+			return loc.End()
+		}
+	case SwitchCasesType, ProperSwitchCasesType:
+		loc, ok := interface{}(s.SwitchCases).(locator)
+		if ok {
+			return loc.End()
+		}
+	case ReturnTypeType, TypeType, NumberTypeType, FuncTypeType, PointerTypeType, StructTypeType, ArrayTypeType:
 		loc, ok := interface{}(s.Type).(locator)
 		if ok {
 			return loc.End()
@@ -951,7 +1733,7 @@ func (s *Symbol) End() parseutil.Location {
 		if ok {
 			return loc.End()
 		}
-	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, DefineToken, FuncToken, IdentifierType:
+	case IntegerLiteralToken, FloatLiteralToken, StringLiteralToken, IdentifierToken, LparenToken, RparenToken, LbraceToken, RbraceToken, LbracketToken, RbracketToken, CommaToken, ColonToken, AtToken, PercentToken, EqualToken, StarToken, DefineToken, DeclareToken, FuncToken, DataToken, VarToken, LoadToken, StoreToken, ExtractToken, InsertToken, StructToken, ZeroToken, GetelemToken, SetelemToken, TrueToken, FalseToken, SelectToken, SwitchToken, TailcallToken, CallConventionType, IdentifierType:
 		loc, ok := interface{}(s.Value).(locator)
 		if ok {
 			return loc.End()
@@ -1049,47 +1831,92 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = LineType
-		//line grammar.lr:14:4
+		//line grammar.lr:31:4
+		symbol.Line = args[0].Line
+		err = nil
+	case _ReduceDeclarationToLine:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = LineType
+		//line grammar.lr:32:4
 		symbol.Line = args[0].Line
 		err = nil
 	case _ReduceRbraceToLine:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = LineType
-		//line grammar.lr:15:4
+		//line grammar.lr:33:4
 		symbol.Line = args[0].Line
 		err = nil
 	case _ReduceLocalLabelToLine:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = LineType
-		//line grammar.lr:16:4
+		//line grammar.lr:34:4
 		symbol.Line = args[0].LocalLabel
 		err = nil
 	case _ReduceOperationInstructionToLine:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = LineType
-		//line grammar.lr:17:4
+		//line grammar.lr:35:4
 		symbol.Line = args[0].Instruction
 		err = nil
 	case _ReduceControlFlowInstructionToLine:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = LineType
-		//line grammar.lr:18:4
+		//line grammar.lr:36:4
 		symbol.Line = args[0].Instruction
 		err = nil
 	case _ReduceFuncToDefinition:
+		args := stack[len(stack)-9:]
+		stack = stack[:len(stack)-9]
+		symbol.SymbolId_ = DefinitionType
+		symbol.Line, err = reducer.FuncToDefinition(args[0].Value, args[1].Value, args[2].Value, args[3].GlobalLabelReference, args[4].Value, args[5].Parameters, args[6].Value, args[7].Type, args[8].Value)
+	case _ReduceDataToDefinition:
+		args := stack[len(stack)-6:]
+		stack = stack[:len(stack)-6]
+		symbol.SymbolId_ = DefinitionType
+		symbol.Line, err = reducer.DataToDefinition(args[0].Value, args[1].Value, args[2].GlobalLabelReference, args[3].Type, args[4].Value, args[5].DataValues)
+	case _ReduceVarToDefinition:
+		args := stack[len(stack)-6:]
+		stack = stack[:len(stack)-6]
+		symbol.SymbolId_ = DefinitionType
+		symbol.Line, err = reducer.VarToDefinition(args[0].Value, args[1].Value, args[2].GlobalLabelReference, args[3].Type, args[4].Value, args[5].DataValues)
+	case _ReduceFuncToDeclaration:
 		args := stack[len(stack)-8:]
 		stack = stack[:len(stack)-8]
-		symbol.SymbolId_ = DefinitionType
-		symbol.Line, err = reducer.FuncToDefinition(args[0].Value, args[1].Value, args[2].GlobalLabelReference, args[3].Value, args[4].Parameters, args[5].Value, args[6].Type, args[7].Value)
+		symbol.SymbolId_ = DeclarationType
+		symbol.Line, err = reducer.FuncToDeclaration(args[0].Value, args[1].Value, args[2].Value, args[3].GlobalLabelReference, args[4].Value, args[5].Types, args[6].Value, args[7].Type)
 	case _ReduceToRbrace:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = RbraceType
 		symbol.Line, err = reducer.ToRbrace(args[0].Value)
+	case _ReduceNamedToCallConvention:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = CallConventionType
+		symbol.Value, err = reducer.NamedToCallConvention(args[0].Value, args[1].Value, args[2].Value)
+	case _ReduceDefaultToCallConvention:
+		symbol.SymbolId_ = CallConventionType
+		symbol.Value, err = reducer.DefaultToCallConvention()
+	case _ReduceTypeToReturnType:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ReturnTypeType
+		//line grammar.lr:62:4
+		symbol.Type = args[0].Type
+		err = nil
+	case _ReduceTupleToReturnType:
+		args := stack[len(stack)-5:]
+		stack = stack[:len(stack)-5]
+		symbol.SymbolId_ = ReturnTypeType
+		symbol.Type, err = reducer.TupleToReturnType(args[0].Value, args[1].Type, args[2].Value, args[3].Types, args[4].Value)
+	case _ReduceUnitToReturnType:
+		symbol.SymbolId_ = ReturnTypeType
+		symbol.Type, err = reducer.UnitToReturnType()
 	case _ReduceToGlobalLabel:
 		args := stack[len(stack)-2:]
 		stack = stack[:len(stack)-2]
@@ -1109,7 +1936,7 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = IdentifierType
-		//line grammar.lr:39:4
+		//line grammar.lr:77:4
 		symbol.Value = args[0].Value
 		err = nil
 	case _ReduceStringToIdentifier:
@@ -1121,14 +1948,21 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ImmediateType
-		//line grammar.lr:43:4
+		//line grammar.lr:81:4
 		symbol.OpValue = args[0].OpValue
 		err = nil
 	case _ReduceFloatImmediateToImmediate:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ImmediateType
-		//line grammar.lr:44:4
+		//line grammar.lr:82:4
+		symbol.OpValue = args[0].OpValue
+		err = nil
+	case _ReduceBoolImmediateToImmediate:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ImmediateType
+		//line grammar.lr:83:4
 		symbol.OpValue = args[0].OpValue
 		err = nil
 	case _ReduceToIntImmediate:
@@ -1141,6 +1975,21 @@ func (act *_Action) ReduceSymbol(
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = FloatImmediateType
 		symbol.OpValue, err = reducer.ToFloatImmediate(args[0].Value)
+	case _ReduceTrueToBoolImmediate:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = BoolImmediateType
+		symbol.OpValue, err = reducer.TrueToBoolImmediate(args[0].Value)
+	case _ReduceFalseToBoolImmediate:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = BoolImmediateType
+		symbol.OpValue, err = reducer.FalseToBoolImmediate(args[0].Value)
+	case _ReduceToZeroImmediate:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ZeroImmediateType
+		symbol.OpValue, err = reducer.ToZeroImmediate(args[0].Value)
 	case _ReduceToTypedVariableDefinition:
 		args := stack[len(stack)-2:]
 		stack = stack[:len(stack)-2]
@@ -1150,7 +1999,7 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = VariableDefinitionType
-		//line grammar.lr:53:4
+		//line grammar.lr:99:4
 		symbol.VariableDefinition = args[0].VariableDefinition
 		err = nil
 	case _ReduceInferredToVariableDefinition:
@@ -1162,28 +2011,35 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ValueType
-		//line grammar.lr:57:4
+		//line grammar.lr:103:4
 		symbol.OpValue = args[0].VariableReference
 		err = nil
 	case _ReduceGlobalLabelToValue:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ValueType
-		//line grammar.lr:58:4
+		//line grammar.lr:104:4
 		symbol.OpValue = args[0].GlobalLabelReference
 		err = nil
 	case _ReduceImmediateToValue:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ValueType
-		//line grammar.lr:59:4
+		//line grammar.lr:105:4
+		symbol.OpValue = args[0].OpValue
+		err = nil
+	case _ReduceZeroImmediateToValue:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ValueType
+		//line grammar.lr:106:4
 		symbol.OpValue = args[0].OpValue
 		err = nil
 	case _ReduceProperParametersToParameters:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ParametersType
-		//line grammar.lr:66:4
+		//line grammar.lr:113:4
 		symbol.Parameters = args[0].Parameters
 		err = nil
 	case _ReduceImproperToParameters:
@@ -1208,7 +2064,7 @@ func (act *_Action) ReduceSymbol(
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ArgumentsType
-		//line grammar.lr:75:4
+		//line grammar.lr:122:4
 		symbol.Arguments = args[0].Arguments
 		err = nil
 	case _ReduceImproperToArguments:
@@ -1229,11 +2085,31 @@ func (act *_Action) ReduceSymbol(
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ProperArgumentsType
 		symbol.Arguments, err = reducer.NewToProperArguments(args[0].OpValue)
+	case _ReduceAddToTupleVariableDefinitions:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = TupleVariableDefinitionsType
+		symbol.Parameters, err = reducer.AddToTupleVariableDefinitions(args[0].Parameters, args[1].Value, args[2].VariableDefinition)
+	case _ReduceNewToTupleVariableDefinitions:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = TupleVariableDefinitionsType
+		symbol.Parameters, err = reducer.NewToTupleVariableDefinitions(args[0].VariableDefinition, args[1].Value, args[2].VariableDefinition)
+	case _ReduceAddToDataValues:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = DataValuesType
+		symbol.DataValues, err = reducer.AddToDataValues(args[0].DataValues, args[1].Value, args[2].DataValue)
+	case _ReduceNewToDataValues:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = DataValuesType
+		symbol.DataValues, err = reducer.NewToDataValues(args[0].DataValue)
 	case _ReduceProperTypesToTypes:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = TypesType
-		//line grammar.lr:84:4
+		//line grammar.lr:140:4
 		symbol.Types = args[0].Types
 		err = nil
 	case _ReduceImproperToTypes:
@@ -1254,6 +2130,76 @@ func (act *_Action) ReduceSymbol(
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = ProperTypesType
 		symbol.Types, err = reducer.NewToProperTypes(args[0].Type)
+	case _ReduceProperStructFieldsToStructFields:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = StructFieldsType
+		//line grammar.lr:149:4
+		symbol.StructFields = args[0].StructFields
+		err = nil
+	case _ReduceImproperToStructFields:
+		args := stack[len(stack)-2:]
+		stack = stack[:len(stack)-2]
+		symbol.SymbolId_ = StructFieldsType
+		symbol.StructFields, err = reducer.ImproperToStructFields(args[0].StructFields, args[1].Value)
+	case _ReduceNilToStructFields:
+		symbol.SymbolId_ = StructFieldsType
+		symbol.StructFields, err = reducer.NilToStructFields()
+	case _ReduceAddToProperStructFields:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = ProperStructFieldsType
+		symbol.StructFields, err = reducer.AddToProperStructFields(args[0].StructFields, args[1].Value, args[2].StructField)
+	case _ReduceNewToProperStructFields:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ProperStructFieldsType
+		symbol.StructFields, err = reducer.NewToProperStructFields(args[0].StructField)
+	case _ReduceProperSwitchCasesToSwitchCases:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = SwitchCasesType
+		//line grammar.lr:158:4
+		symbol.SwitchCases = args[0].SwitchCases
+		err = nil
+	case _ReduceImproperToSwitchCases:
+		args := stack[len(stack)-2:]
+		stack = stack[:len(stack)-2]
+		symbol.SymbolId_ = SwitchCasesType
+		symbol.SwitchCases, err = reducer.ImproperToSwitchCases(args[0].SwitchCases, args[1].Value)
+	case _ReduceNilToSwitchCases:
+		symbol.SymbolId_ = SwitchCasesType
+		symbol.SwitchCases, err = reducer.NilToSwitchCases()
+	case _ReduceAddToProperSwitchCases:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = ProperSwitchCasesType
+		symbol.SwitchCases, err = reducer.AddToProperSwitchCases(args[0].SwitchCases, args[1].Value, args[2].SwitchCase)
+	case _ReduceNewToProperSwitchCases:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ProperSwitchCasesType
+		symbol.SwitchCases, err = reducer.NewToProperSwitchCases(args[0].SwitchCase)
+	case _ReduceToSwitchCase:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = SwitchCaseType
+		symbol.SwitchCase, err = reducer.ToSwitchCase(args[0].OpValue, args[1].Value, args[2].LocalLabel)
+	case _ReduceImmediateToDataValue:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = DataValueType
+		symbol.DataValue, err = reducer.ImmediateToDataValue(args[0].OpValue)
+	case _ReduceStringToDataValue:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = DataValueType
+		symbol.DataValue, err = reducer.StringToDataValue(args[0].Value)
+	case _ReduceRepeatedToDataValue:
+		args := stack[len(stack)-3:]
+		stack = stack[:len(stack)-3]
+		symbol.SymbolId_ = DataValueType
+		symbol.DataValue, err = reducer.RepeatedToDataValue(args[0].OpValue, args[1].Value, args[2].Value)
 	case _ReduceAssignToOperationInstruction:
 		args := stack[len(stack)-3:]
 		stack = stack[:len(stack)-3]
@@ -1274,33 +2220,124 @@ func (act *_Action) ReduceSymbol(
 		stack = stack[:len(stack)-7]
 		symbol.SymbolId_ = OperationInstructionType
 		symbol.Instruction, err = reducer.CallToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].Arguments, args[6].Value)
-	case _ReduceUnconditionalToControlFlowInstruction:
-		args := stack[len(stack)-2:]
-		stack = stack[:len(stack)-2]
-		symbol.SymbolId_ = ControlFlowInstructionType
-		symbol.Instruction, err = reducer.UnconditionalToControlFlowInstruction(args[0].Value, args[1].LocalLabel)
-	case _ReduceConditionalToControlFlowInstruction:
-		args := stack[len(stack)-6:]
+	case _ReduceTupleCallToOperationInstruction:
+		args := stack[len(stack)-7:]
+		stack = stack[:len(stack)-7]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.TupleCallToOperationInstruction(args[0].Parameters, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].Arguments, args[6].Value)
+	case _ReduceIgnoredCallToOperationInstruction:
+		args := stack[len(stack)-5:]
+		stack = stack[:len(stack)-5]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.IgnoredCallToOperationInstruction(args[0].Value, args[1].OpValue, args[2].Value, args[3].Arguments, args[4].Value)
+	case _ReduceLoadToOperationInstruction:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.LoadToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue)
+	case _ReduceStoreToOperationInstruction:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.StoreToOperationInstruction(args[0].Value, args[1].OpValue, args[2].Value, args[3].OpValue)
+	case _ReduceExtractToOperationInstruction:
+		args := stack[len(stack)-6:]
+		stack = stack[:len(stack)-6]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.ExtractToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].Value)
+	case _ReduceInsertToOperationInstruction:
+		args := stack[len(stack)-8:]
+		stack = stack[:len(stack)-8]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.InsertToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].Value, args[6].Value, args[7].OpValue)
+	case _ReduceGetElementToOperationInstruction:
+		args := stack[len(stack)-6:]
+		stack = stack[:len(stack)-6]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.GetElementToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].OpValue)
+	case _ReduceSetElementToOperationInstruction:
+		args := stack[len(stack)-8:]
+		stack = stack[:len(stack)-8]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.SetElementToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].OpValue, args[6].Value, args[7].OpValue)
+	case _ReduceSelectToOperationInstruction:
+		args := stack[len(stack)-8:]
+		stack = stack[:len(stack)-8]
+		symbol.SymbolId_ = OperationInstructionType
+		symbol.Instruction, err = reducer.SelectToOperationInstruction(args[0].VariableDefinition, args[1].Value, args[2].Value, args[3].OpValue, args[4].Value, args[5].OpValue, args[6].Value, args[7].OpValue)
+	case _ReduceUnconditionalToControlFlowInstruction:
+		args := stack[len(stack)-2:]
+		stack = stack[:len(stack)-2]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.UnconditionalToControlFlowInstruction(args[0].Value, args[1].LocalLabel)
+	case _ReduceConditionalToControlFlowInstruction:
+		args := stack[len(stack)-6:]
 		stack = stack[:len(stack)-6]
 		symbol.SymbolId_ = ControlFlowInstructionType
 		symbol.Instruction, err = reducer.ConditionalToControlFlowInstruction(args[0].Value, args[1].LocalLabel, args[2].Value, args[3].OpValue, args[4].Value, args[5].OpValue)
+	case _ReduceBoolConditionalToControlFlowInstruction:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.BoolConditionalToControlFlowInstruction(args[0].Value, args[1].LocalLabel, args[2].Value, args[3].OpValue)
+	case _ReduceSwitchToControlFlowInstruction:
+		args := stack[len(stack)-8:]
+		stack = stack[:len(stack)-8]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.SwitchToControlFlowInstruction(args[0].Value, args[1].OpValue, args[2].Value, args[3].LocalLabel, args[4].Value, args[5].Value, args[6].SwitchCases, args[7].Value)
+	case _ReduceTailCallToControlFlowInstruction:
+		args := stack[len(stack)-5:]
+		stack = stack[:len(stack)-5]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.TailCallToControlFlowInstruction(args[0].Value, args[1].OpValue, args[2].Value, args[3].Arguments, args[4].Value)
 	case _ReduceTerminalToControlFlowInstruction:
 		args := stack[len(stack)-2:]
 		stack = stack[:len(stack)-2]
 		symbol.SymbolId_ = ControlFlowInstructionType
 		symbol.Instruction, err = reducer.TerminalToControlFlowInstruction(args[0].Value, args[1].OpValue)
+	case _ReduceTupleTerminalToControlFlowInstruction:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.TupleTerminalToControlFlowInstruction(args[0].Value, args[1].OpValue, args[2].Value, args[3].Arguments)
+	case _ReduceUnitTerminalToControlFlowInstruction:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = ControlFlowInstructionType
+		symbol.Instruction, err = reducer.UnitTerminalToControlFlowInstruction(args[0].Value)
 	case _ReduceNumberTypeToType:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = TypeType
-		//line grammar.lr:113:4
+		//line grammar.lr:218:4
 		symbol.Type = args[0].Type
 		err = nil
 	case _ReduceFuncTypeToType:
 		args := stack[len(stack)-1:]
 		stack = stack[:len(stack)-1]
 		symbol.SymbolId_ = TypeType
-		//line grammar.lr:114:4
+		//line grammar.lr:219:4
+		symbol.Type = args[0].Type
+		err = nil
+	case _ReducePointerTypeToType:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = TypeType
+		//line grammar.lr:220:4
+		symbol.Type = args[0].Type
+		err = nil
+	case _ReduceStructTypeToType:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = TypeType
+		//line grammar.lr:221:4
+		symbol.Type = args[0].Type
+		err = nil
+	case _ReduceArrayTypeToType:
+		args := stack[len(stack)-1:]
+		stack = stack[:len(stack)-1]
+		symbol.SymbolId_ = TypeType
+		//line grammar.lr:222:4
 		symbol.Type = args[0].Type
 		err = nil
 	case _ReduceToNumberType:
@@ -1309,10 +2346,30 @@ func (act *_Action) ReduceSymbol(
 		symbol.SymbolId_ = NumberTypeType
 		symbol.Type, err = reducer.ToNumberType(args[0].Value)
 	case _ReduceToFuncType:
-		args := stack[len(stack)-5:]
-		stack = stack[:len(stack)-5]
+		args := stack[len(stack)-6:]
+		stack = stack[:len(stack)-6]
 		symbol.SymbolId_ = FuncTypeType
-		symbol.Type, err = reducer.ToFuncType(args[0].Value, args[1].Value, args[2].Types, args[3].Value, args[4].Type)
+		symbol.Type, err = reducer.ToFuncType(args[0].Value, args[1].Value, args[2].Value, args[3].Types, args[4].Value, args[5].Type)
+	case _ReduceToPointerType:
+		args := stack[len(stack)-2:]
+		stack = stack[:len(stack)-2]
+		symbol.SymbolId_ = PointerTypeType
+		symbol.Type, err = reducer.ToPointerType(args[0].Value, args[1].Type)
+	case _ReduceToStructType:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = StructTypeType
+		symbol.Type, err = reducer.ToStructType(args[0].Value, args[1].Value, args[2].StructFields, args[3].Value)
+	case _ReduceToStructField:
+		args := stack[len(stack)-2:]
+		stack = stack[:len(stack)-2]
+		symbol.SymbolId_ = StructFieldType
+		symbol.StructField, err = reducer.ToStructField(args[0].Value, args[1].Type)
+	case _ReduceToArrayType:
+		args := stack[len(stack)-4:]
+		stack = stack[:len(stack)-4]
+		symbol.SymbolId_ = ArrayTypeType
+		symbol.Type, err = reducer.ToArrayType(args[0].Value, args[1].Value, args[2].Value, args[3].Type)
 	default:
 		panic("Unknown reduce type: " + act.ReduceType.String())
 	}
@@ -1342,23 +2399,35 @@ func (_ActionTableType) Get(
 	case _State1:
 		switch symbolId {
 		case IdentifierToken:
-			return _Action{_ShiftAction, _State5, 0}, true
+			return _Action{_ShiftAction, _State6, 0}, true
 		case ColonToken:
 			return _Action{_ShiftAction, _State3, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case DefineToken:
+			return _Action{_ShiftAction, _State5, 0}, true
+		case DeclareToken:
 			return _Action{_ShiftAction, _State4, 0}, true
+		case StoreToken:
+			return _Action{_ShiftAction, _State8, 0}, true
+		case SwitchToken:
+			return _Action{_ShiftAction, _State9, 0}, true
+		case TailcallToken:
+			return _Action{_ShiftAction, _State10, 0}, true
 		case LineType:
 			return _Action{_ShiftAction, _State2, 0}, true
 		case VariableReferenceType:
-			return _Action{_ShiftAction, _State8, 0}, true
+			return _Action{_ShiftAction, _State13, 0}, true
 		case VariableDefinitionType:
-			return _Action{_ShiftAction, _State7, 0}, true
+			return _Action{_ShiftAction, _State12, 0}, true
+		case TupleVariableDefinitionsType:
+			return _Action{_ShiftAction, _State11, 0}, true
 		case RbraceToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToRbrace}, true
 		case DefinitionType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceDefinitionToLine}, true
+		case DeclarationType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceDeclarationToLine}, true
 		case RbraceType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceRbraceToLine}, true
 		case LocalLabelType:
@@ -1387,22 +2456,39 @@ func (_ActionTableType) Get(
 	case _State4:
 		switch symbolId {
 		case FuncToken:
-			return _Action{_ShiftAction, _State9, 0}, true
+			return _Action{_ShiftAction, _State14, 0}, true
 		}
 	case _State5:
+		switch symbolId {
+		case FuncToken:
+			return _Action{_ShiftAction, _State16, 0}, true
+		case DataToken:
+			return _Action{_ShiftAction, _State15, 0}, true
+		case VarToken:
+			return _Action{_ShiftAction, _State17, 0}, true
+		}
+	case _State6:
 		switch symbolId {
 		case ColonToken:
 			return _Action{_ShiftAction, _State3, 0}, true
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case LocalLabelType:
-			return _Action{_ShiftAction, _State11, 0}, true
+			return _Action{_ShiftAction, _State19, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State20, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1413,75 +2499,41 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
-		case ValueType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceTerminalToControlFlowInstruction}, true
-		}
-	case _State6:
-		switch symbolId {
-		case StringLiteralToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
-		case IdentifierToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
-		case IdentifierType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToVariableReference}, true
-		}
-	case _State7:
-		switch symbolId {
-		case EqualToken:
-			return _Action{_ShiftAction, _State12, 0}, true
-		}
-	case _State8:
-		switch symbolId {
-		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
-		case IdentifierToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
-		case TypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToTypedVariableDefinition}, true
-		case NumberTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
-		case FuncTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceInferredToVariableDefinition}, true
+			return _Action{_ReduceAction, 0, _ReduceUnitTerminalToControlFlowInstruction}, true
 		}
-	case _State9:
-		switch symbolId {
-		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
-		case GlobalLabelType:
-			return _Action{_ShiftAction, _State14, 0}, true
-		}
-	case _State10:
+	case _State7:
 		switch symbolId {
 		case StringLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
 		case IdentifierToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
 		case IdentifierType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToGlobalLabel}, true
-		}
-	case _State11:
-		switch symbolId {
-		case CommaToken:
-			return _Action{_ShiftAction, _State15, 0}, true
-
-		default:
-			return _Action{_ReduceAction, 0, _ReduceUnconditionalToControlFlowInstruction}, true
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToVariableReference}, true
 		}
-	case _State12:
+	case _State8:
 		switch symbolId {
-		case IdentifierToken:
-			return _Action{_ShiftAction, _State16, 0}, true
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State21, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1492,31 +2544,29 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
-		case ValueType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceAssignToOperationInstruction}, true
-		}
-	case _State13:
-		switch symbolId {
-		case LparenToken:
-			return _Action{_ShiftAction, _State17, 0}, true
-		}
-	case _State14:
-		switch symbolId {
-		case LparenToken:
-			return _Action{_ShiftAction, _State18, 0}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		}
-	case _State15:
+	case _State9:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case ValueType:
-			return _Action{_ShiftAction, _State19, 0}, true
+			return _Action{_ShiftAction, _State22, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1527,19 +2577,29 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		}
-	case _State16:
+	case _State10:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case ValueType:
-			return _Action{_ShiftAction, _State20, 0}, true
+			return _Action{_ShiftAction, _State23, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1550,107 +2610,339 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		}
-	case _State17:
+	case _State11:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State24, 0}, true
+		case EqualToken:
+			return _Action{_ShiftAction, _State25, 0}, true
+		}
+	case _State12:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State26, 0}, true
+		case EqualToken:
+			return _Action{_ShiftAction, _State27, 0}, true
+		}
+	case _State13:
 		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
 		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
-		case TypesType:
-			return _Action{_ShiftAction, _State22, 0}, true
-		case ProperTypesType:
-			return _Action{_ShiftAction, _State21, 0}, true
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
 		case IdentifierToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
 		case TypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperTypes}, true
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToTypedVariableDefinition}, true
 		case NumberTypeType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
 		case FuncTypeType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceNilToTypes}, true
+			return _Action{_ReduceAction, 0, _ReduceInferredToVariableDefinition}, true
 		}
-	case _State18:
+	case _State14:
 		switch symbolId {
-		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
-		case VariableReferenceType:
-			return _Action{_ShiftAction, _State25, 0}, true
-		case ParametersType:
-			return _Action{_ShiftAction, _State23, 0}, true
-		case ProperParametersType:
-			return _Action{_ShiftAction, _State24, 0}, true
-		case TypedVariableDefinitionType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperParameters}, true
+		case LbraceToken:
+			return _Action{_ShiftAction, _State32, 0}, true
+		case CallConventionType:
+			return _Action{_ShiftAction, _State33, 0}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceNilToParameters}, true
+			return _Action{_ReduceAction, 0, _ReduceDefaultToCallConvention}, true
 		}
-	case _State19:
+	case _State15:
 		switch symbolId {
-		case CommaToken:
-			return _Action{_ShiftAction, _State26, 0}, true
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAction, _State34, 0}, true
 		}
-	case _State20:
+	case _State16:
 		switch symbolId {
-		case LparenToken:
-			return _Action{_ShiftAction, _State28, 0}, true
-		case CommaToken:
-			return _Action{_ShiftAction, _State27, 0}, true
+		case LbraceToken:
+			return _Action{_ShiftAction, _State32, 0}, true
+		case CallConventionType:
+			return _Action{_ShiftAction, _State35, 0}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceUnaryToOperationInstruction}, true
+			return _Action{_ReduceAction, 0, _ReduceDefaultToCallConvention}, true
 		}
-	case _State21:
+	case _State17:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAction, _State36, 0}, true
+		}
+	case _State18:
+		switch symbolId {
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		case IdentifierType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToGlobalLabel}, true
+		}
+	case _State19:
 		switch symbolId {
 		case CommaToken:
-			return _Action{_ShiftAction, _State29, 0}, true
+			return _Action{_ShiftAction, _State37, 0}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceProperTypesToTypes}, true
+			return _Action{_ReduceAction, 0, _ReduceUnconditionalToControlFlowInstruction}, true
+		}
+	case _State20:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State39, 0}, true
+		case CommaToken:
+			return _Action{_ShiftAction, _State38, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceTerminalToControlFlowInstruction}, true
+		}
+	case _State21:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State40, 0}, true
 		}
 	case _State22:
 		switch symbolId {
-		case RparenToken:
-			return _Action{_ShiftAction, _State30, 0}, true
+		case CommaToken:
+			return _Action{_ShiftAction, _State41, 0}, true
 		}
 	case _State23:
 		switch symbolId {
-		case RparenToken:
-			return _Action{_ShiftAction, _State31, 0}, true
+		case LparenToken:
+			return _Action{_ShiftAction, _State42, 0}, true
 		}
 	case _State24:
 		switch symbolId {
-		case CommaToken:
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAction, _State13, 0}, true
+		case TypedVariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTypedVariableDefinitionToVariableDefinition}, true
+		case VariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToTupleVariableDefinitions}, true
+		}
+	case _State25:
+		switch symbolId {
+		case IdentifierToken:
+			return _Action{_ShiftAction, _State43, 0}, true
+		}
+	case _State26:
+		switch symbolId {
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAction, _State13, 0}, true
+		case TypedVariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTypedVariableDefinitionToVariableDefinition}, true
+		case VariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToTupleVariableDefinitions}, true
+		}
+	case _State27:
+		switch symbolId {
+		case IdentifierToken:
+			return _Action{_ShiftAction, _State46, 0}, true
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case LoadToken:
+			return _Action{_ShiftAction, _State48, 0}, true
+		case ExtractToken:
+			return _Action{_ShiftAction, _State44, 0}, true
+		case InsertToken:
+			return _Action{_ShiftAction, _State47, 0}, true
+		case GetelemToken:
+			return _Action{_ShiftAction, _State45, 0}, true
+		case SetelemToken:
+			return _Action{_ShiftAction, _State50, 0}, true
+		case SelectToken:
+			return _Action{_ShiftAction, _State49, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAssignToOperationInstruction}, true
+		}
+	case _State28:
+		switch symbolId {
+		case LbraceToken:
 			return _Action{_ShiftAction, _State32, 0}, true
+		case CallConventionType:
+			return _Action{_ShiftAction, _State51, 0}, true
 
 		default:
-			return _Action{_ReduceAction, 0, _ReduceProperParametersToParameters}, true
+			return _Action{_ReduceAction, 0, _ReduceDefaultToCallConvention}, true
 		}
-	case _State25:
+	case _State29:
+		switch symbolId {
+		case IntegerLiteralToken:
+			return _Action{_ShiftAction, _State52, 0}, true
+		}
+	case _State30:
 		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
 		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
 		case IdentifierToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
 		case TypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToTypedVariableDefinition}, true
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToPointerType}, true
 		case NumberTypeType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
 		case FuncTypeType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
 		}
-	case _State26:
+	case _State31:
+		switch symbolId {
+		case LbraceToken:
+			return _Action{_ShiftAction, _State53, 0}, true
+		}
+	case _State32:
+		switch symbolId {
+		case IdentifierType:
+			return _Action{_ShiftAction, _State54, 0}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		}
+	case _State33:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAction, _State55, 0}, true
+		}
+	case _State34:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case TypeType:
+			return _Action{_ShiftAction, _State56, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State35:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAction, _State57, 0}, true
+		}
+	case _State36:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case TypeType:
+			return _Action{_ShiftAction, _State58, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State37:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State59, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1661,19 +2953,29 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
-		case ValueType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceConditionalToControlFlowInstruction}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		}
-	case _State27:
+	case _State38:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ProperArgumentsType:
+			return _Action{_ShiftAction, _State60, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1684,23 +2986,33 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		case ValueType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceBinaryToOperationInstruction}, true
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperArguments}, true
 		}
-	case _State28:
+	case _State39:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case ArgumentsType:
-			return _Action{_ShiftAction, _State33, 0}, true
+			return _Action{_ShiftAction, _State61, 0}, true
 		case ProperArgumentsType:
-			return _Action{_ShiftAction, _State34, 0}, true
+			return _Action{_ShiftAction, _State62, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1711,94 +3023,32 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		case ValueType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperArguments}, true
 
 		default:
 			return _Action{_ReduceAction, 0, _ReduceNilToArguments}, true
 		}
-	case _State29:
-		switch symbolId {
-		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
-		case IdentifierToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
-		case TypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperTypes}, true
-		case NumberTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
-		case FuncTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
-
-		default:
-			return _Action{_ReduceAction, 0, _ReduceImproperToTypes}, true
-		}
-	case _State30:
-		switch symbolId {
-		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
-		case IdentifierToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
-		case TypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToFuncType}, true
-		case NumberTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
-		case FuncTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
-		}
-	case _State31:
-		switch symbolId {
-		case FuncToken:
-			return _Action{_ShiftAction, _State13, 0}, true
-		case TypeType:
-			return _Action{_ShiftAction, _State35, 0}, true
-		case IdentifierToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
-		case NumberTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
-		case FuncTypeType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
-		}
-	case _State32:
-		switch symbolId {
-		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
-		case VariableReferenceType:
-			return _Action{_ShiftAction, _State25, 0}, true
-		case TypedVariableDefinitionType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperParameters}, true
-
-		default:
-			return _Action{_ReduceAction, 0, _ReduceImproperToParameters}, true
-		}
-	case _State33:
-		switch symbolId {
-		case RparenToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceCallToOperationInstruction}, true
-		}
-	case _State34:
-		switch symbolId {
-		case CommaToken:
-			return _Action{_ShiftAction, _State36, 0}, true
-
-		default:
-			return _Action{_ReduceAction, 0, _ReduceProperArgumentsToArguments}, true
-		}
-	case _State35:
-		switch symbolId {
-		case LbraceToken:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncToDefinition}, true
-		}
-	case _State36:
+	case _State40:
 		switch symbolId {
 		case AtToken:
-			return _Action{_ShiftAction, _State10, 0}, true
+			return _Action{_ShiftAction, _State18, 0}, true
 		case PercentToken:
-			return _Action{_ShiftAction, _State6, 0}, true
+			return _Action{_ShiftAction, _State7, 0}, true
 		case IntegerLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
 		case FloatLiteralToken:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
 		case GlobalLabelType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
 		case VariableReferenceType:
@@ -1809,524 +3059,3529 @@ func (_ActionTableType) Get(
 			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
 		case FloatImmediateType:
 			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
 		case ValueType:
-			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperArguments}, true
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStoreToOperationInstruction}, true
+		}
+	case _State41:
+		switch symbolId {
+		case ColonToken:
+			return _Action{_ShiftAction, _State3, 0}, true
+		case LocalLabelType:
+			return _Action{_ShiftAction, _State63, 0}, true
+		}
+	case _State42:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ArgumentsType:
+			return _Action{_ShiftAction, _State64, 0}, true
+		case ProperArgumentsType:
+			return _Action{_ShiftAction, _State62, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperArguments}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToArguments}, true
+		}
+	case _State43:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State65, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State44:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State66, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State45:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State67, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State46:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State68, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State47:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State69, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State48:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceLoadToOperationInstruction}, true
+		}
+	case _State49:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State70, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State50:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State71, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State51:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State72, 0}, true
+		}
+	case _State52:
+		switch symbolId {
+		case RbracketToken:
+			return _Action{_ShiftAction, _State73, 0}, true
+		}
+	case _State53:
+		switch symbolId {
+		case IdentifierType:
+			return _Action{_ShiftAction, _State74, 0}, true
+		case StructFieldsType:
+			return _Action{_ShiftAction, _State76, 0}, true
+		case ProperStructFieldsType:
+			return _Action{_ShiftAction, _State75, 0}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		case StructFieldType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperStructFields}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToStructFields}, true
+		}
+	case _State54:
+		switch symbolId {
+		case RbraceToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNamedToCallConvention}, true
+		}
+	case _State55:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State77, 0}, true
+		}
+	case _State56:
+		switch symbolId {
+		case EqualToken:
+			return _Action{_ShiftAction, _State78, 0}, true
+		}
+	case _State57:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State79, 0}, true
+		}
+	case _State58:
+		switch symbolId {
+		case EqualToken:
+			return _Action{_ShiftAction, _State80, 0}, true
+		}
+	case _State59:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State81, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceBoolConditionalToControlFlowInstruction}, true
+		}
+	case _State60:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State82, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceTupleTerminalToControlFlowInstruction}, true
+		}
+	case _State61:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIgnoredCallToOperationInstruction}, true
+		}
+	case _State62:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State83, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceProperArgumentsToArguments}, true
+		}
+	case _State63:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State84, 0}, true
+		}
+	case _State64:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTailCallToControlFlowInstruction}, true
+		}
+	case _State65:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State85, 0}, true
+		}
+	case _State66:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State86, 0}, true
+		}
+	case _State67:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State87, 0}, true
+		}
+	case _State68:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State89, 0}, true
+		case CommaToken:
+			return _Action{_ShiftAction, _State88, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceUnaryToOperationInstruction}, true
+		}
+	case _State69:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State90, 0}, true
+		}
+	case _State70:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State91, 0}, true
+		}
+	case _State71:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State92, 0}, true
+		}
+	case _State72:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case TypesType:
+			return _Action{_ShiftAction, _State94, 0}, true
+		case ProperTypesType:
+			return _Action{_ShiftAction, _State93, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperTypes}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToTypes}, true
+		}
+	case _State73:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToArrayType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State74:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToStructField}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State75:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State95, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceProperStructFieldsToStructFields}, true
+		}
+	case _State76:
+		switch symbolId {
+		case RbraceToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToStructType}, true
+		}
+	case _State77:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case TypesType:
+			return _Action{_ShiftAction, _State96, 0}, true
+		case ProperTypesType:
+			return _Action{_ShiftAction, _State93, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperTypes}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToTypes}, true
+		}
+	case _State78:
+		switch symbolId {
+		case ImmediateType:
+			return _Action{_ShiftAction, _State98, 0}, true
+		case DataValuesType:
+			return _Action{_ShiftAction, _State97, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToDataValue}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case DataValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToDataValues}, true
+		}
+	case _State79:
+		switch symbolId {
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAction, _State101, 0}, true
+		case ParametersType:
+			return _Action{_ShiftAction, _State99, 0}, true
+		case ProperParametersType:
+			return _Action{_ShiftAction, _State100, 0}, true
+		case TypedVariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperParameters}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToParameters}, true
+		}
+	case _State80:
+		switch symbolId {
+		case ImmediateType:
+			return _Action{_ShiftAction, _State98, 0}, true
+		case DataValuesType:
+			return _Action{_ShiftAction, _State102, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToDataValue}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case DataValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToDataValues}, true
+		}
+	case _State81:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceConditionalToControlFlowInstruction}, true
+		}
+	case _State82:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperArguments}, true
+		}
+	case _State83:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperArguments}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImproperToArguments}, true
+		}
+	case _State84:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State103, 0}, true
+		}
+	case _State85:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ArgumentsType:
+			return _Action{_ShiftAction, _State104, 0}, true
+		case ProperArgumentsType:
+			return _Action{_ShiftAction, _State62, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperArguments}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToArguments}, true
+		}
+	case _State86:
+		switch symbolId {
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		case IdentifierType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceExtractToOperationInstruction}, true
+		}
+	case _State87:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGetElementToOperationInstruction}, true
+		}
+	case _State88:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBinaryToOperationInstruction}, true
+		}
+	case _State89:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ArgumentsType:
+			return _Action{_ShiftAction, _State105, 0}, true
+		case ProperArgumentsType:
+			return _Action{_ShiftAction, _State62, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperArguments}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToArguments}, true
+		}
+	case _State90:
+		switch symbolId {
+		case IdentifierType:
+			return _Action{_ShiftAction, _State106, 0}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		}
+	case _State91:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State107, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State92:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case ValueType:
+			return _Action{_ShiftAction, _State108, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		}
+	case _State93:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State109, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceProperTypesToTypes}, true
+		}
+	case _State94:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAction, _State110, 0}, true
+		}
+	case _State95:
+		switch symbolId {
+		case IdentifierType:
+			return _Action{_ShiftAction, _State74, 0}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToIdentifier}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIdentifierToIdentifier}, true
+		case StructFieldType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperStructFields}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImproperToStructFields}, true
+		}
+	case _State96:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAction, _State111, 0}, true
+		}
+	case _State97:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State112, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceDataToDefinition}, true
+		}
+	case _State98:
+		switch symbolId {
+		case StarToken:
+			return _Action{_ShiftAction, _State113, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImmediateToDataValue}, true
+		}
+	case _State99:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAction, _State114, 0}, true
+		}
+	case _State100:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State115, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceProperParametersToParameters}, true
+		}
+	case _State101:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToTypedVariableDefinition}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State102:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State112, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceVarToDefinition}, true
+		}
+	case _State103:
+		switch symbolId {
+		case IntImmediateType:
+			return _Action{_ShiftAction, _State116, 0}, true
+		case SwitchCasesType:
+			return _Action{_ShiftAction, _State118, 0}, true
+		case ProperSwitchCasesType:
+			return _Action{_ShiftAction, _State117, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case SwitchCaseType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperSwitchCases}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceNilToSwitchCases}, true
+		}
+	case _State104:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTupleCallToOperationInstruction}, true
+		}
+	case _State105:
+		switch symbolId {
+		case RparenToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceCallToOperationInstruction}, true
+		}
+	case _State106:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State119, 0}, true
+		}
+	case _State107:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State120, 0}, true
+		}
+	case _State108:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State121, 0}, true
+		}
+	case _State109:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperTypes}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImproperToTypes}, true
+		}
+	case _State110:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State122, 0}, true
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case ReturnTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFuncType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTypeToReturnType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceUnitToReturnType}, true
+		}
+	case _State111:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State122, 0}, true
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case ReturnTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncToDeclaration}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTypeToReturnType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceUnitToReturnType}, true
+		}
+	case _State112:
+		switch symbolId {
+		case ImmediateType:
+			return _Action{_ShiftAction, _State98, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case StringLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStringToDataValue}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case DataValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToDataValues}, true
+		}
+	case _State113:
+		switch symbolId {
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceRepeatedToDataValue}, true
+		}
+	case _State114:
+		switch symbolId {
+		case LparenToken:
+			return _Action{_ShiftAction, _State122, 0}, true
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case ReturnTypeType:
+			return _Action{_ShiftAction, _State123, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTypeToReturnType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceUnitToReturnType}, true
+		}
+	case _State115:
+		switch symbolId {
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAction, _State101, 0}, true
+		case TypedVariableDefinitionType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperParameters}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImproperToParameters}, true
+		}
+	case _State116:
+		switch symbolId {
+		case ColonToken:
+			return _Action{_ShiftAction, _State124, 0}, true
+		}
+	case _State117:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State125, 0}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceProperSwitchCasesToSwitchCases}, true
+		}
+	case _State118:
+		switch symbolId {
+		case RbracketToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceSwitchToControlFlowInstruction}, true
+		}
+	case _State119:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceInsertToOperationInstruction}, true
+		}
+	case _State120:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceSelectToOperationInstruction}, true
+		}
+	case _State121:
+		switch symbolId {
+		case AtToken:
+			return _Action{_ShiftAction, _State18, 0}, true
+		case PercentToken:
+			return _Action{_ShiftAction, _State7, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case FloatLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToFloatImmediate}, true
+		case ZeroToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToZeroImmediate}, true
+		case TrueToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTrueToBoolImmediate}, true
+		case FalseToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFalseToBoolImmediate}, true
+		case GlobalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceGlobalLabelToValue}, true
+		case VariableReferenceType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceVariableReferenceToValue}, true
+		case ImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceImmediateToValue}, true
+		case IntImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceIntImmediateToImmediate}, true
+		case FloatImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFloatImmediateToImmediate}, true
+		case BoolImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceBoolImmediateToImmediate}, true
+		case ZeroImmediateType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceZeroImmediateToValue}, true
+		case ValueType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceSetElementToOperationInstruction}, true
+		}
+	case _State122:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case TypeType:
+			return _Action{_ShiftAction, _State126, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State123:
+		switch symbolId {
+		case LbraceToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncToDefinition}, true
+		}
+	case _State124:
+		switch symbolId {
+		case ColonToken:
+			return _Action{_ShiftAction, _State3, 0}, true
+		case LocalLabelType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToSwitchCase}, true
+		}
+	case _State125:
+		switch symbolId {
+		case IntImmediateType:
+			return _Action{_ShiftAction, _State116, 0}, true
+		case IntegerLiteralToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToIntImmediate}, true
+		case SwitchCaseType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperSwitchCases}, true
+
+		default:
+			return _Action{_ReduceAction, 0, _ReduceImproperToSwitchCases}, true
+		}
+	case _State126:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State127, 0}, true
+		}
+	case _State127:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case ProperTypesType:
+			return _Action{_ShiftAction, _State128, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNewToProperTypes}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	case _State128:
+		switch symbolId {
+		case CommaToken:
+			return _Action{_ShiftAction, _State129, 0}, true
+		case RparenToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceTupleToReturnType}, true
+		}
+	case _State129:
+		switch symbolId {
+		case LbracketToken:
+			return _Action{_ShiftAction, _State29, 0}, true
+		case StarToken:
+			return _Action{_ShiftAction, _State30, 0}, true
+		case FuncToken:
+			return _Action{_ShiftAction, _State28, 0}, true
+		case StructToken:
+			return _Action{_ShiftAction, _State31, 0}, true
+		case IdentifierToken:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceToNumberType}, true
+		case TypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceAddToProperTypes}, true
+		case NumberTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceNumberTypeToType}, true
+		case FuncTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceFuncTypeToType}, true
+		case PointerTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReducePointerTypeToType}, true
+		case StructTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceStructTypeToType}, true
+		case ArrayTypeType:
+			return _Action{_ShiftAndReduceAction, 0, _ReduceArrayTypeToType}, true
+		}
+	}
+
+	return _Action{}, false
+}
+
+var _ActionTable = _ActionTableType{}
+
+/*
+Parser Debug States:
+  State 1:
+    Kernel Items:
+      #accept: ^.line
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      RBRACE -> [rbrace]
+      definition -> [line]
+      declaration -> [line]
+      rbrace -> [line]
+      local_label -> [line]
+      typed_variable_definition -> [variable_definition]
+      operation_instruction -> [line]
+      control_flow_instruction -> [line]
+    Goto:
+      IDENTIFIER -> State 6
+      COLON -> State 3
+      PERCENT -> State 7
+      DEFINE -> State 5
+      DECLARE -> State 4
+      STORE -> State 8
+      SWITCH -> State 9
+      TAILCALL -> State 10
+      line -> State 2
+      variable_reference -> State 13
+      variable_definition -> State 12
+      tuple_variable_definitions -> State 11
+
+  State 2:
+    Kernel Items:
+      #accept: ^ line., $
+    Reduce:
+      $ -> [#accept]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      (nil)
+
+  State 3:
+    Kernel Items:
+      local_label: COLON.identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+      identifier -> [local_label]
+    Goto:
+      (nil)
+
+  State 4:
+    Kernel Items:
+      declaration: DECLARE.FUNC call_convention global_label LPAREN types RPAREN return_type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      FUNC -> State 14
+
+  State 5:
+    Kernel Items:
+      definition: DEFINE.FUNC call_convention global_label LPAREN parameters RPAREN return_type LBRACE
+      definition: DEFINE.DATA global_label type EQUAL data_values
+      definition: DEFINE.VAR global_label type EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      FUNC -> State 16
+      DATA -> State 15
+      VAR -> State 17
+
+  State 6:
+    Kernel Items:
+      operation_instruction: IDENTIFIER.value LPAREN arguments RPAREN
+      control_flow_instruction: IDENTIFIER.local_label
+      control_flow_instruction: IDENTIFIER.local_label COMMA value COMMA value
+      control_flow_instruction: IDENTIFIER.local_label COMMA value
+      control_flow_instruction: IDENTIFIER.value
+      control_flow_instruction: IDENTIFIER.value COMMA proper_arguments
+      control_flow_instruction: IDENTIFIER., *
+    Reduce:
+      * -> [control_flow_instruction]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      COLON -> State 3
+      AT -> State 18
+      PERCENT -> State 7
+      local_label -> State 19
+      value -> State 20
+
+  State 7:
+    Kernel Items:
+      variable_reference: PERCENT.identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+      identifier -> [variable_reference]
+    Goto:
+      (nil)
+
+  State 8:
+    Kernel Items:
+      operation_instruction: STORE.value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 21
+
+  State 9:
+    Kernel Items:
+      control_flow_instruction: SWITCH.value COMMA local_label COMMA LBRACKET switch_cases RBRACKET
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 22
+
+  State 10:
+    Kernel Items:
+      control_flow_instruction: TAILCALL.value LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 23
+
+  State 11:
+    Kernel Items:
+      tuple_variable_definitions: tuple_variable_definitions.COMMA variable_definition
+      operation_instruction: tuple_variable_definitions.EQUAL IDENTIFIER value LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 24
+      EQUAL -> State 25
+
+  State 12:
+    Kernel Items:
+      tuple_variable_definitions: variable_definition.COMMA variable_definition
+      operation_instruction: variable_definition.EQUAL value
+      operation_instruction: variable_definition.EQUAL IDENTIFIER value
+      operation_instruction: variable_definition.EQUAL IDENTIFIER value COMMA value
+      operation_instruction: variable_definition.EQUAL IDENTIFIER value LPAREN arguments RPAREN
+      operation_instruction: variable_definition.EQUAL LOAD value
+      operation_instruction: variable_definition.EQUAL EXTRACT value COMMA identifier
+      operation_instruction: variable_definition.EQUAL INSERT value COMMA identifier COMMA value
+      operation_instruction: variable_definition.EQUAL GETELEM value COMMA value
+      operation_instruction: variable_definition.EQUAL SETELEM value COMMA value COMMA value
+      operation_instruction: variable_definition.EQUAL SELECT value COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 26
+      EQUAL -> State 27
+
+  State 13:
+    Kernel Items:
+      typed_variable_definition: variable_reference.type
+      variable_definition: variable_reference., *
+    Reduce:
+      * -> [variable_definition]
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [typed_variable_definition]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+
+  State 14:
+    Kernel Items:
+      declaration: DECLARE FUNC.call_convention global_label LPAREN types RPAREN return_type
+    Reduce:
+      * -> [call_convention]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LBRACE -> State 32
+      call_convention -> State 33
+
+  State 15:
+    Kernel Items:
+      definition: DEFINE DATA.global_label type EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      AT -> State 18
+      global_label -> State 34
+
+  State 16:
+    Kernel Items:
+      definition: DEFINE FUNC.call_convention global_label LPAREN parameters RPAREN return_type LBRACE
+    Reduce:
+      * -> [call_convention]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LBRACE -> State 32
+      call_convention -> State 35
+
+  State 17:
+    Kernel Items:
+      definition: DEFINE VAR.global_label type EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      AT -> State 18
+      global_label -> State 36
+
+  State 18:
+    Kernel Items:
+      global_label: AT.identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+      identifier -> [global_label]
+    Goto:
+      (nil)
+
+  State 19:
+    Kernel Items:
+      control_flow_instruction: IDENTIFIER local_label., *
+      control_flow_instruction: IDENTIFIER local_label.COMMA value COMMA value
+      control_flow_instruction: IDENTIFIER local_label.COMMA value
+    Reduce:
+      * -> [control_flow_instruction]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 37
+
+  State 20:
+    Kernel Items:
+      operation_instruction: IDENTIFIER value.LPAREN arguments RPAREN
+      control_flow_instruction: IDENTIFIER value., *
+      control_flow_instruction: IDENTIFIER value.COMMA proper_arguments
+    Reduce:
+      * -> [control_flow_instruction]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 39
+      COMMA -> State 38
+
+  State 21:
+    Kernel Items:
+      operation_instruction: STORE value.COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 40
+
+  State 22:
+    Kernel Items:
+      control_flow_instruction: SWITCH value.COMMA local_label COMMA LBRACKET switch_cases RBRACKET
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 41
+
+  State 23:
+    Kernel Items:
+      control_flow_instruction: TAILCALL value.LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 42
+
+  State 24:
+    Kernel Items:
+      tuple_variable_definitions: tuple_variable_definitions COMMA.variable_definition
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      typed_variable_definition -> [variable_definition]
+      variable_definition -> [tuple_variable_definitions]
+    Goto:
+      PERCENT -> State 7
+      variable_reference -> State 13
+
+  State 25:
+    Kernel Items:
+      operation_instruction: tuple_variable_definitions EQUAL.IDENTIFIER value LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      IDENTIFIER -> State 43
+
+  State 26:
+    Kernel Items:
+      tuple_variable_definitions: variable_definition COMMA.variable_definition
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      typed_variable_definition -> [variable_definition]
+      variable_definition -> [tuple_variable_definitions]
+    Goto:
+      PERCENT -> State 7
+      variable_reference -> State 13
+
+  State 27:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL.value
+      operation_instruction: variable_definition EQUAL.IDENTIFIER value
+      operation_instruction: variable_definition EQUAL.IDENTIFIER value COMMA value
+      operation_instruction: variable_definition EQUAL.IDENTIFIER value LPAREN arguments RPAREN
+      operation_instruction: variable_definition EQUAL.LOAD value
+      operation_instruction: variable_definition EQUAL.EXTRACT value COMMA identifier
+      operation_instruction: variable_definition EQUAL.INSERT value COMMA identifier COMMA value
+      operation_instruction: variable_definition EQUAL.GETELEM value COMMA value
+      operation_instruction: variable_definition EQUAL.SETELEM value COMMA value COMMA value
+      operation_instruction: variable_definition EQUAL.SELECT value COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
+    Goto:
+      IDENTIFIER -> State 46
+      AT -> State 18
+      PERCENT -> State 7
+      LOAD -> State 48
+      EXTRACT -> State 44
+      INSERT -> State 47
+      GETELEM -> State 45
+      SETELEM -> State 50
+      SELECT -> State 49
+
+  State 28:
+    Kernel Items:
+      func_type: FUNC.call_convention LPAREN types RPAREN return_type
+    Reduce:
+      * -> [call_convention]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LBRACE -> State 32
+      call_convention -> State 51
+
+  State 29:
+    Kernel Items:
+      array_type: LBRACKET.INTEGER_LITERAL RBRACKET type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      INTEGER_LITERAL -> State 52
+
+  State 30:
+    Kernel Items:
+      pointer_type: STAR.type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [pointer_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+
+  State 31:
+    Kernel Items:
+      struct_type: STRUCT.LBRACE struct_fields RBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LBRACE -> State 53
+
+  State 32:
+    Kernel Items:
+      call_convention: LBRACE.identifier RBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+    Goto:
+      identifier -> State 54
+
+  State 33:
+    Kernel Items:
+      declaration: DECLARE FUNC call_convention.global_label LPAREN types RPAREN return_type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      AT -> State 18
+      global_label -> State 55
+
+  State 34:
+    Kernel Items:
+      definition: DEFINE DATA global_label.type EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      type -> State 56
+
+  State 35:
+    Kernel Items:
+      definition: DEFINE FUNC call_convention.global_label LPAREN parameters RPAREN return_type LBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      AT -> State 18
+      global_label -> State 57
+
+  State 36:
+    Kernel Items:
+      definition: DEFINE VAR global_label.type EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      type -> State 58
+
+  State 37:
+    Kernel Items:
+      control_flow_instruction: IDENTIFIER local_label COMMA.value COMMA value
+      control_flow_instruction: IDENTIFIER local_label COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 59
+
+  State 38:
+    Kernel Items:
+      control_flow_instruction: IDENTIFIER value COMMA.proper_arguments
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      proper_arguments -> State 60
+
+  State 39:
+    Kernel Items:
+      operation_instruction: IDENTIFIER value LPAREN.arguments RPAREN
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      arguments -> State 61
+      proper_arguments -> State 62
+
+  State 40:
+    Kernel Items:
+      operation_instruction: STORE value COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 41:
+    Kernel Items:
+      control_flow_instruction: SWITCH value COMMA.local_label COMMA LBRACKET switch_cases RBRACKET
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COLON -> State 3
+      local_label -> State 63
+
+  State 42:
+    Kernel Items:
+      control_flow_instruction: TAILCALL value LPAREN.arguments RPAREN
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      arguments -> State 64
+      proper_arguments -> State 62
+
+  State 43:
+    Kernel Items:
+      operation_instruction: tuple_variable_definitions EQUAL IDENTIFIER.value LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 65
+
+  State 44:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL EXTRACT.value COMMA identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 66
+
+  State 45:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL GETELEM.value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 67
+
+  State 46:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL IDENTIFIER.value
+      operation_instruction: variable_definition EQUAL IDENTIFIER.value COMMA value
+      operation_instruction: variable_definition EQUAL IDENTIFIER.value LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 68
+
+  State 47:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL INSERT.value COMMA identifier COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 69
+
+  State 48:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL LOAD.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 49:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SELECT.value COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 70
+
+  State 50:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SETELEM.value COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 71
+
+  State 51:
+    Kernel Items:
+      func_type: FUNC call_convention.LPAREN types RPAREN return_type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 72
+
+  State 52:
+    Kernel Items:
+      array_type: LBRACKET INTEGER_LITERAL.RBRACKET type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      RBRACKET -> State 73
+
+  State 53:
+    Kernel Items:
+      struct_type: STRUCT LBRACE.struct_fields RBRACE
+    Reduce:
+      * -> [struct_fields]
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+      struct_field -> [proper_struct_fields]
+    Goto:
+      identifier -> State 74
+      struct_fields -> State 76
+      proper_struct_fields -> State 75
+
+  State 54:
+    Kernel Items:
+      call_convention: LBRACE identifier.RBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      RBRACE -> [call_convention]
+    Goto:
+      (nil)
+
+  State 55:
+    Kernel Items:
+      declaration: DECLARE FUNC call_convention global_label.LPAREN types RPAREN return_type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 77
+
+  State 56:
+    Kernel Items:
+      definition: DEFINE DATA global_label type.EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      EQUAL -> State 78
+
+  State 57:
+    Kernel Items:
+      definition: DEFINE FUNC call_convention global_label.LPAREN parameters RPAREN return_type LBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 79
+
+  State 58:
+    Kernel Items:
+      definition: DEFINE VAR global_label type.EQUAL data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      EQUAL -> State 80
+
+  State 59:
+    Kernel Items:
+      control_flow_instruction: IDENTIFIER local_label COMMA value.COMMA value
+      control_flow_instruction: IDENTIFIER local_label COMMA value., *
+    Reduce:
+      * -> [control_flow_instruction]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 81
+
+  State 60:
+    Kernel Items:
+      proper_arguments: proper_arguments.COMMA value
+      control_flow_instruction: IDENTIFIER value COMMA proper_arguments., *
+    Reduce:
+      * -> [control_flow_instruction]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 82
+
+  State 61:
+    Kernel Items:
+      operation_instruction: IDENTIFIER value LPAREN arguments.RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      RPAREN -> [operation_instruction]
+    Goto:
+      (nil)
+
+  State 62:
+    Kernel Items:
+      arguments: proper_arguments., *
+      arguments: proper_arguments.COMMA
+      proper_arguments: proper_arguments.COMMA value
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 83
+
+  State 63:
+    Kernel Items:
+      control_flow_instruction: SWITCH value COMMA local_label.COMMA LBRACKET switch_cases RBRACKET
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 84
+
+  State 64:
+    Kernel Items:
+      control_flow_instruction: TAILCALL value LPAREN arguments.RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      RPAREN -> [control_flow_instruction]
+    Goto:
+      (nil)
+
+  State 65:
+    Kernel Items:
+      operation_instruction: tuple_variable_definitions EQUAL IDENTIFIER value.LPAREN arguments RPAREN
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 85
+
+  State 66:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL EXTRACT value.COMMA identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 86
+
+  State 67:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL GETELEM value.COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 87
+
+  State 68:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL IDENTIFIER value., *
+      operation_instruction: variable_definition EQUAL IDENTIFIER value.COMMA value
+      operation_instruction: variable_definition EQUAL IDENTIFIER value.LPAREN arguments RPAREN
+    Reduce:
+      * -> [operation_instruction]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LPAREN -> State 89
+      COMMA -> State 88
+
+  State 69:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL INSERT value.COMMA identifier COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 90
 
-		default:
-			return _Action{_ReduceAction, 0, _ReduceImproperToArguments}, true
-		}
-	}
+  State 70:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SELECT value.COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 91
 
-	return _Action{}, false
-}
+  State 71:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SETELEM value.COMMA value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 92
 
-var _ActionTable = _ActionTableType{}
+  State 72:
+    Kernel Items:
+      func_type: FUNC call_convention LPAREN.types RPAREN return_type
+    Reduce:
+      * -> [types]
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [proper_types]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      types -> State 94
+      proper_types -> State 93
+
+  State 73:
+    Kernel Items:
+      array_type: LBRACKET INTEGER_LITERAL RBRACKET.type
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [array_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
 
-/*
-Parser Debug States:
-  State 1:
+  State 74:
     Kernel Items:
-      #accept: ^.line
+      struct_field: identifier.type
     Reduce:
       (nil)
     ShiftAndReduce:
-      RBRACE -> [rbrace]
-      definition -> [line]
-      rbrace -> [line]
-      local_label -> [line]
-      typed_variable_definition -> [variable_definition]
-      operation_instruction -> [line]
-      control_flow_instruction -> [line]
+      IDENTIFIER -> [number_type]
+      type -> [struct_field]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+
+  State 75:
+    Kernel Items:
+      struct_fields: proper_struct_fields., *
+      struct_fields: proper_struct_fields.COMMA
+      proper_struct_fields: proper_struct_fields.COMMA struct_field
+    Reduce:
+      * -> [struct_fields]
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      COMMA -> State 95
+
+  State 76:
+    Kernel Items:
+      struct_type: STRUCT LBRACE struct_fields.RBRACE
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      RBRACE -> [struct_type]
+    Goto:
+      (nil)
+
+  State 77:
+    Kernel Items:
+      declaration: DECLARE FUNC call_convention global_label LPAREN.types RPAREN return_type
+    Reduce:
+      * -> [types]
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [proper_types]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
+    Goto:
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      types -> State 96
+      proper_types -> State 93
+
+  State 78:
+    Kernel Items:
+      definition: DEFINE DATA global_label type EQUAL.data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      STRING_LITERAL -> [data_value]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      data_value -> [data_values]
+    Goto:
+      immediate -> State 98
+      data_values -> State 97
+
+  State 79:
+    Kernel Items:
+      definition: DEFINE FUNC call_convention global_label LPAREN.parameters RPAREN return_type LBRACE
+    Reduce:
+      * -> [parameters]
+    ShiftAndReduce:
+      typed_variable_definition -> [proper_parameters]
+    Goto:
+      PERCENT -> State 7
+      variable_reference -> State 101
+      parameters -> State 99
+      proper_parameters -> State 100
+
+  State 80:
+    Kernel Items:
+      definition: DEFINE VAR global_label type EQUAL.data_values
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      STRING_LITERAL -> [data_value]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      data_value -> [data_values]
+    Goto:
+      immediate -> State 98
+      data_values -> State 102
+
+  State 81:
+    Kernel Items:
+      control_flow_instruction: IDENTIFIER local_label COMMA value COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [control_flow_instruction]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 82:
+    Kernel Items:
+      proper_arguments: proper_arguments COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 83:
+    Kernel Items:
+      arguments: proper_arguments COMMA., *
+      proper_arguments: proper_arguments COMMA.value
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 84:
+    Kernel Items:
+      control_flow_instruction: SWITCH value COMMA local_label COMMA.LBRACKET switch_cases RBRACKET
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      LBRACKET -> State 103
+
+  State 85:
+    Kernel Items:
+      operation_instruction: tuple_variable_definitions EQUAL IDENTIFIER value LPAREN.arguments RPAREN
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      arguments -> State 104
+      proper_arguments -> State 62
+
+  State 86:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL EXTRACT value COMMA.identifier
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+      identifier -> [operation_instruction]
+    Goto:
+      (nil)
+
+  State 87:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL GETELEM value COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 88:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL IDENTIFIER value COMMA.value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+
+  State 89:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL IDENTIFIER value LPAREN.arguments RPAREN
+    Reduce:
+      * -> [arguments]
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [proper_arguments]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      arguments -> State 105
+      proper_arguments -> State 62
+
+  State 90:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL INSERT value COMMA.identifier COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      STRING_LITERAL -> [identifier]
+      IDENTIFIER -> [identifier]
+    Goto:
+      identifier -> State 106
+
+  State 91:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SELECT value COMMA.value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+    Goto:
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 107
+
+  State 92:
+    Kernel Items:
+      operation_instruction: variable_definition EQUAL SETELEM value COMMA.value COMMA value
+    Reduce:
+      (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      global_label -> [value]
+      variable_reference -> [value]
+      immediate -> [value]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
     Goto:
-      IDENTIFIER -> State 5
-      COLON -> State 3
-      PERCENT -> State 6
-      DEFINE -> State 4
-      line -> State 2
-      variable_reference -> State 8
-      variable_definition -> State 7
+      AT -> State 18
+      PERCENT -> State 7
+      value -> State 108
 
-  State 2:
+  State 93:
     Kernel Items:
-      #accept: ^ line., $
+      types: proper_types., *
+      types: proper_types.COMMA
+      proper_types: proper_types.COMMA type
     Reduce:
-      $ -> [#accept]
+      * -> [types]
     ShiftAndReduce:
       (nil)
     Goto:
-      (nil)
+      COMMA -> State 109
 
-  State 3:
+  State 94:
     Kernel Items:
-      local_label: COLON.identifier
+      func_type: FUNC call_convention LPAREN types.RPAREN return_type
     Reduce:
       (nil)
+    ShiftAndReduce:
+      (nil)
+    Goto:
+      RPAREN -> State 110
+
+  State 95:
+    Kernel Items:
+      struct_fields: proper_struct_fields COMMA., *
+      proper_struct_fields: proper_struct_fields COMMA.struct_field
+    Reduce:
+      * -> [struct_fields]
     ShiftAndReduce:
       STRING_LITERAL -> [identifier]
       IDENTIFIER -> [identifier]
-      identifier -> [local_label]
+      struct_field -> [proper_struct_fields]
     Goto:
-      (nil)
+      identifier -> State 74
 
-  State 4:
+  State 96:
     Kernel Items:
-      definition: DEFINE.FUNC global_label LPAREN parameters RPAREN type LBRACE
+      declaration: DECLARE FUNC call_convention global_label LPAREN types.RPAREN return_type
     Reduce:
       (nil)
     ShiftAndReduce:
       (nil)
     Goto:
-      FUNC -> State 9
+      RPAREN -> State 111
 
-  State 5:
+  State 97:
     Kernel Items:
-      control_flow_instruction: IDENTIFIER.local_label
-      control_flow_instruction: IDENTIFIER.local_label COMMA value COMMA value
-      control_flow_instruction: IDENTIFIER.value
+      definition: DEFINE DATA global_label type EQUAL data_values., *
+      data_values: data_values.COMMA data_value
     Reduce:
+      * -> [definition]
+    ShiftAndReduce:
       (nil)
+    Goto:
+      COMMA -> State 112
+
+  State 98:
+    Kernel Items:
+      data_value: immediate., *
+      data_value: immediate.STAR INTEGER_LITERAL
+    Reduce:
+      * -> [data_value]
     ShiftAndReduce:
-      INTEGER_LITERAL -> [int_immediate]
-      FLOAT_LITERAL -> [float_immediate]
-      global_label -> [value]
-      variable_reference -> [value]
-      immediate -> [value]
-      int_immediate -> [immediate]
-      float_immediate -> [immediate]
-      value -> [control_flow_instruction]
+      (nil)
     Goto:
-      COLON -> State 3
-      AT -> State 10
-      PERCENT -> State 6
-      local_label -> State 11
+      STAR -> State 113
 
-  State 6:
+  State 99:
     Kernel Items:
-      variable_reference: PERCENT.identifier
+      definition: DEFINE FUNC call_convention global_label LPAREN parameters.RPAREN return_type LBRACE
     Reduce:
       (nil)
     ShiftAndReduce:
-      STRING_LITERAL -> [identifier]
-      IDENTIFIER -> [identifier]
-      identifier -> [variable_reference]
-    Goto:
       (nil)
+    Goto:
+      RPAREN -> State 114
 
-  State 7:
+  State 100:
     Kernel Items:
-      operation_instruction: variable_definition.EQUAL value
-      operation_instruction: variable_definition.EQUAL IDENTIFIER value
-      operation_instruction: variable_definition.EQUAL IDENTIFIER value COMMA value
-      operation_instruction: variable_definition.EQUAL IDENTIFIER value LPAREN arguments RPAREN
+      parameters: proper_parameters., *
+      parameters: proper_parameters.COMMA
+      proper_parameters: proper_parameters.COMMA typed_variable_definition
     Reduce:
-      (nil)
+      * -> [parameters]
     ShiftAndReduce:
       (nil)
     Goto:
-      EQUAL -> State 12
+      COMMA -> State 115
 
-  State 8:
+  State 101:
     Kernel Items:
       typed_variable_definition: variable_reference.type
-      variable_definition: variable_reference., *
     Reduce:
-      * -> [variable_definition]
+      (nil)
     ShiftAndReduce:
       IDENTIFIER -> [number_type]
       type -> [typed_variable_definition]
       number_type -> [type]
       func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      FUNC -> State 13
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
 
-  State 9:
+  State 102:
     Kernel Items:
-      definition: DEFINE FUNC.global_label LPAREN parameters RPAREN type LBRACE
+      definition: DEFINE VAR global_label type EQUAL data_values., *
+      data_values: data_values.COMMA data_value
     Reduce:
-      (nil)
+      * -> [definition]
     ShiftAndReduce:
       (nil)
     Goto:
-      AT -> State 10
-      global_label -> State 14
+      COMMA -> State 112
 
-  State 10:
+  State 103:
     Kernel Items:
-      global_label: AT.identifier
+      control_flow_instruction: SWITCH value COMMA local_label COMMA LBRACKET.switch_cases RBRACKET
     Reduce:
-      (nil)
+      * -> [switch_cases]
     ShiftAndReduce:
-      STRING_LITERAL -> [identifier]
-      IDENTIFIER -> [identifier]
-      identifier -> [global_label]
+      INTEGER_LITERAL -> [int_immediate]
+      switch_case -> [proper_switch_cases]
     Goto:
-      (nil)
+      int_immediate -> State 116
+      switch_cases -> State 118
+      proper_switch_cases -> State 117
 
-  State 11:
+  State 104:
     Kernel Items:
-      control_flow_instruction: IDENTIFIER local_label., *
-      control_flow_instruction: IDENTIFIER local_label.COMMA value COMMA value
+      operation_instruction: tuple_variable_definitions EQUAL IDENTIFIER value LPAREN arguments.RPAREN
     Reduce:
-      * -> [control_flow_instruction]
-    ShiftAndReduce:
       (nil)
+    ShiftAndReduce:
+      RPAREN -> [operation_instruction]
     Goto:
-      COMMA -> State 15
+      (nil)
 
-  State 12:
+  State 105:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL.value
-      operation_instruction: variable_definition EQUAL.IDENTIFIER value
-      operation_instruction: variable_definition EQUAL.IDENTIFIER value COMMA value
-      operation_instruction: variable_definition EQUAL.IDENTIFIER value LPAREN arguments RPAREN
+      operation_instruction: variable_definition EQUAL IDENTIFIER value LPAREN arguments.RPAREN
     Reduce:
       (nil)
     ShiftAndReduce:
-      INTEGER_LITERAL -> [int_immediate]
-      FLOAT_LITERAL -> [float_immediate]
-      global_label -> [value]
-      variable_reference -> [value]
-      immediate -> [value]
-      int_immediate -> [immediate]
-      float_immediate -> [immediate]
-      value -> [operation_instruction]
+      RPAREN -> [operation_instruction]
     Goto:
-      IDENTIFIER -> State 16
-      AT -> State 10
-      PERCENT -> State 6
+      (nil)
 
-  State 13:
+  State 106:
     Kernel Items:
-      func_type: FUNC.LPAREN types RPAREN type
+      operation_instruction: variable_definition EQUAL INSERT value COMMA identifier.COMMA value
     Reduce:
       (nil)
     ShiftAndReduce:
       (nil)
     Goto:
-      LPAREN -> State 17
+      COMMA -> State 119
 
-  State 14:
+  State 107:
     Kernel Items:
-      definition: DEFINE FUNC global_label.LPAREN parameters RPAREN type LBRACE
+      operation_instruction: variable_definition EQUAL SELECT value COMMA value.COMMA value
     Reduce:
       (nil)
     ShiftAndReduce:
       (nil)
     Goto:
-      LPAREN -> State 18
+      COMMA -> State 120
 
-  State 15:
+  State 108:
     Kernel Items:
-      control_flow_instruction: IDENTIFIER local_label COMMA.value COMMA value
+      operation_instruction: variable_definition EQUAL SETELEM value COMMA value.COMMA value
     Reduce:
       (nil)
     ShiftAndReduce:
-      INTEGER_LITERAL -> [int_immediate]
-      FLOAT_LITERAL -> [float_immediate]
-      global_label -> [value]
-      variable_reference -> [value]
-      immediate -> [value]
-      int_immediate -> [immediate]
-      float_immediate -> [immediate]
+      (nil)
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
-      value -> State 19
+      COMMA -> State 121
 
-  State 16:
+  State 109:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL IDENTIFIER.value
-      operation_instruction: variable_definition EQUAL IDENTIFIER.value COMMA value
-      operation_instruction: variable_definition EQUAL IDENTIFIER.value LPAREN arguments RPAREN
+      types: proper_types COMMA., *
+      proper_types: proper_types COMMA.type
     Reduce:
-      (nil)
+      * -> [types]
     ShiftAndReduce:
-      INTEGER_LITERAL -> [int_immediate]
-      FLOAT_LITERAL -> [float_immediate]
-      global_label -> [value]
-      variable_reference -> [value]
-      immediate -> [value]
-      int_immediate -> [immediate]
-      float_immediate -> [immediate]
+      IDENTIFIER -> [number_type]
+      type -> [proper_types]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
-      value -> State 20
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
 
-  State 17:
+  State 110:
     Kernel Items:
-      func_type: FUNC LPAREN.types RPAREN type
+      func_type: FUNC call_convention LPAREN types RPAREN.return_type
     Reduce:
-      * -> [types]
+      * -> [return_type]
     ShiftAndReduce:
       IDENTIFIER -> [number_type]
-      type -> [proper_types]
+      return_type -> [func_type]
+      type -> [return_type]
       number_type -> [type]
       func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      FUNC -> State 13
-      types -> State 22
-      proper_types -> State 21
+      LPAREN -> State 122
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
 
-  State 18:
+  State 111:
     Kernel Items:
-      definition: DEFINE FUNC global_label LPAREN.parameters RPAREN type LBRACE
+      declaration: DECLARE FUNC call_convention global_label LPAREN types RPAREN.return_type
     Reduce:
-      * -> [parameters]
+      * -> [return_type]
     ShiftAndReduce:
-      typed_variable_definition -> [proper_parameters]
+      IDENTIFIER -> [number_type]
+      return_type -> [declaration]
+      type -> [return_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      PERCENT -> State 6
-      variable_reference -> State 25
-      parameters -> State 23
-      proper_parameters -> State 24
+      LPAREN -> State 122
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
 
-  State 19:
+  State 112:
     Kernel Items:
-      control_flow_instruction: IDENTIFIER local_label COMMA value.COMMA value
+      data_values: data_values COMMA.data_value
     Reduce:
       (nil)
     ShiftAndReduce:
-      (nil)
+      INTEGER_LITERAL -> [int_immediate]
+      FLOAT_LITERAL -> [float_immediate]
+      STRING_LITERAL -> [data_value]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
+      int_immediate -> [immediate]
+      float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      data_value -> [data_values]
     Goto:
-      COMMA -> State 26
+      immediate -> State 98
 
-  State 20:
+  State 113:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL IDENTIFIER value., *
-      operation_instruction: variable_definition EQUAL IDENTIFIER value.COMMA value
-      operation_instruction: variable_definition EQUAL IDENTIFIER value.LPAREN arguments RPAREN
+      data_value: immediate STAR.INTEGER_LITERAL
     Reduce:
-      * -> [operation_instruction]
-    ShiftAndReduce:
       (nil)
+    ShiftAndReduce:
+      INTEGER_LITERAL -> [data_value]
     Goto:
-      LPAREN -> State 28
-      COMMA -> State 27
+      (nil)
 
-  State 21:
+  State 114:
     Kernel Items:
-      types: proper_types., *
-      types: proper_types.COMMA
-      proper_types: proper_types.COMMA type
+      definition: DEFINE FUNC call_convention global_label LPAREN parameters RPAREN.return_type LBRACE
     Reduce:
-      * -> [types]
+      * -> [return_type]
     ShiftAndReduce:
-      (nil)
+      IDENTIFIER -> [number_type]
+      type -> [return_type]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      COMMA -> State 29
-
-  State 22:
+      LPAREN -> State 122
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      return_type -> State 123
+
+  State 115:
     Kernel Items:
-      func_type: FUNC LPAREN types.RPAREN type
+      parameters: proper_parameters COMMA., *
+      proper_parameters: proper_parameters COMMA.typed_variable_definition
     Reduce:
-      (nil)
+      * -> [parameters]
     ShiftAndReduce:
-      (nil)
+      typed_variable_definition -> [proper_parameters]
     Goto:
-      RPAREN -> State 30
+      PERCENT -> State 7
+      variable_reference -> State 101
 
-  State 23:
+  State 116:
     Kernel Items:
-      definition: DEFINE FUNC global_label LPAREN parameters.RPAREN type LBRACE
+      switch_case: int_immediate.COLON local_label
     Reduce:
       (nil)
     ShiftAndReduce:
       (nil)
     Goto:
-      RPAREN -> State 31
+      COLON -> State 124
 
-  State 24:
+  State 117:
     Kernel Items:
-      parameters: proper_parameters., *
-      parameters: proper_parameters.COMMA
-      proper_parameters: proper_parameters.COMMA typed_variable_definition
+      switch_cases: proper_switch_cases., *
+      switch_cases: proper_switch_cases.COMMA
+      proper_switch_cases: proper_switch_cases.COMMA switch_case
     Reduce:
-      * -> [parameters]
+      * -> [switch_cases]
     ShiftAndReduce:
       (nil)
     Goto:
-      COMMA -> State 32
+      COMMA -> State 125
 
-  State 25:
+  State 118:
     Kernel Items:
-      typed_variable_definition: variable_reference.type
+      control_flow_instruction: SWITCH value COMMA local_label COMMA LBRACKET switch_cases.RBRACKET
     Reduce:
       (nil)
     ShiftAndReduce:
-      IDENTIFIER -> [number_type]
-      type -> [typed_variable_definition]
-      number_type -> [type]
-      func_type -> [type]
+      RBRACKET -> [control_flow_instruction]
     Goto:
-      FUNC -> State 13
+      (nil)
 
-  State 26:
+  State 119:
     Kernel Items:
-      control_flow_instruction: IDENTIFIER local_label COMMA value COMMA.value
+      operation_instruction: variable_definition EQUAL INSERT value COMMA identifier COMMA.value
     Reduce:
       (nil)
     ShiftAndReduce:
       INTEGER_LITERAL -> [int_immediate]
       FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
       global_label -> [value]
       variable_reference -> [value]
       immediate -> [value]
       int_immediate -> [immediate]
       float_immediate -> [immediate]
-      value -> [control_flow_instruction]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
+      AT -> State 18
+      PERCENT -> State 7
 
-  State 27:
+  State 120:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL IDENTIFIER value COMMA.value
+      operation_instruction: variable_definition EQUAL SELECT value COMMA value COMMA.value
     Reduce:
       (nil)
     ShiftAndReduce:
       INTEGER_LITERAL -> [int_immediate]
       FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
       global_label -> [value]
       variable_reference -> [value]
       immediate -> [value]
       int_immediate -> [immediate]
       float_immediate -> [immediate]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
       value -> [operation_instruction]
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
+      AT -> State 18
+      PERCENT -> State 7
 
-  State 28:
+  State 121:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL IDENTIFIER value LPAREN.arguments RPAREN
+      operation_instruction: variable_definition EQUAL SETELEM value COMMA value COMMA.value
     Reduce:
-      * -> [arguments]
+      (nil)
     ShiftAndReduce:
       INTEGER_LITERAL -> [int_immediate]
       FLOAT_LITERAL -> [float_immediate]
+      ZERO -> [zero_immediate]
+      TRUE -> [bool_immediate]
+      FALSE -> [bool_immediate]
       global_label -> [value]
       variable_reference -> [value]
       immediate -> [value]
       int_immediate -> [immediate]
       float_immediate -> [immediate]
-      value -> [proper_arguments]
+      bool_immediate -> [immediate]
+      zero_immediate -> [value]
+      value -> [operation_instruction]
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
-      arguments -> State 33
-      proper_arguments -> State 34
+      AT -> State 18
+      PERCENT -> State 7
 
-  State 29:
+  State 122:
     Kernel Items:
-      types: proper_types COMMA., *
-      proper_types: proper_types COMMA.type
+      return_type: LPAREN.type COMMA proper_types RPAREN
     Reduce:
-      * -> [types]
+      (nil)
     ShiftAndReduce:
       IDENTIFIER -> [number_type]
-      type -> [proper_types]
       number_type -> [type]
       func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      FUNC -> State 13
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      type -> State 126
 
-  State 30:
+  State 123:
     Kernel Items:
-      func_type: FUNC LPAREN types RPAREN.type
+      definition: DEFINE FUNC call_convention global_label LPAREN parameters RPAREN return_type.LBRACE
     Reduce:
       (nil)
     ShiftAndReduce:
-      IDENTIFIER -> [number_type]
-      type -> [func_type]
-      number_type -> [type]
-      func_type -> [type]
+      LBRACE -> [definition]
     Goto:
-      FUNC -> State 13
+      (nil)
 
-  State 31:
+  State 124:
     Kernel Items:
-      definition: DEFINE FUNC global_label LPAREN parameters RPAREN.type LBRACE
+      switch_case: int_immediate COLON.local_label
     Reduce:
       (nil)
     ShiftAndReduce:
-      IDENTIFIER -> [number_type]
-      number_type -> [type]
-      func_type -> [type]
+      local_label -> [switch_case]
     Goto:
-      FUNC -> State 13
-      type -> State 35
+      COLON -> State 3
 
-  State 32:
+  State 125:
     Kernel Items:
-      parameters: proper_parameters COMMA., *
-      proper_parameters: proper_parameters COMMA.typed_variable_definition
+      switch_cases: proper_switch_cases COMMA., *
+      proper_switch_cases: proper_switch_cases COMMA.switch_case
     Reduce:
-      * -> [parameters]
+      * -> [switch_cases]
     ShiftAndReduce:
-      typed_variable_definition -> [proper_parameters]
+      INTEGER_LITERAL -> [int_immediate]
+      switch_case -> [proper_switch_cases]
     Goto:
-      PERCENT -> State 6
-      variable_reference -> State 25
+      int_immediate -> State 116
 
-  State 33:
+  State 126:
     Kernel Items:
-      operation_instruction: variable_definition EQUAL IDENTIFIER value LPAREN arguments.RPAREN
+      return_type: LPAREN type.COMMA proper_types RPAREN
     Reduce:
       (nil)
     ShiftAndReduce:
-      RPAREN -> [operation_instruction]
-    Goto:
       (nil)
+    Goto:
+      COMMA -> State 127
 
-  State 34:
+  State 127:
     Kernel Items:
-      arguments: proper_arguments., *
-      arguments: proper_arguments.COMMA
-      proper_arguments: proper_arguments.COMMA value
+      return_type: LPAREN type COMMA.proper_types RPAREN
     Reduce:
-      * -> [arguments]
-    ShiftAndReduce:
       (nil)
+    ShiftAndReduce:
+      IDENTIFIER -> [number_type]
+      type -> [proper_types]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      COMMA -> State 36
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+      proper_types -> State 128
 
-  State 35:
+  State 128:
     Kernel Items:
-      definition: DEFINE FUNC global_label LPAREN parameters RPAREN type.LBRACE
+      return_type: LPAREN type COMMA proper_types.RPAREN
+      proper_types: proper_types.COMMA type
     Reduce:
       (nil)
     ShiftAndReduce:
-      LBRACE -> [definition]
+      RPAREN -> [return_type]
     Goto:
-      (nil)
+      COMMA -> State 129
 
-  State 36:
+  State 129:
     Kernel Items:
-      arguments: proper_arguments COMMA., *
-      proper_arguments: proper_arguments COMMA.value
+      proper_types: proper_types COMMA.type
     Reduce:
-      * -> [arguments]
+      (nil)
     ShiftAndReduce:
-      INTEGER_LITERAL -> [int_immediate]
-      FLOAT_LITERAL -> [float_immediate]
-      global_label -> [value]
-      variable_reference -> [value]
-      immediate -> [value]
-      int_immediate -> [immediate]
-      float_immediate -> [immediate]
-      value -> [proper_arguments]
+      IDENTIFIER -> [number_type]
+      type -> [proper_types]
+      number_type -> [type]
+      func_type -> [type]
+      pointer_type -> [type]
+      struct_type -> [type]
+      array_type -> [type]
     Goto:
-      AT -> State 10
-      PERCENT -> State 6
-
-Number of states: 36
-Number of shift actions: 60
-Number of reduce actions: 13
-Number of shift-and-reduce actions: 105
+      LBRACKET -> State 29
+      STAR -> State 30
+      FUNC -> State 28
+      STRUCT -> State 31
+
+Number of states: 129
+Number of shift actions: 274
+Number of reduce actions: 36
+Number of shift-and-reduce actions: 565
 Number of shift/reduce conflicts: 0
 Number of reduce/reduce conflicts: 0
-Number of unoptimized states: 144
-Number of unoptimized shift actions: 223
-Number of unoptimized reduce actions: 161
+Number of unoptimized states: 495
+Number of unoptimized shift actions: 1419
+Number of unoptimized reduce actions: 492
 */
@@ -40,6 +40,95 @@ func (Reducer) ConditionalToControlFlowInstruction(
 	}, nil
 }
 
+// jtrue / jfalse are syntactic sugar for comparing the bool value against
+// false, e.g., "jtrue :label, %b" is equivalent to "jne :label, %b, false".
+func (Reducer) BoolConditionalToControlFlowInstruction(
+	op *lr.TokenValue,
+	label lr.ParsedLocalLabel,
+	comma *lr.TokenValue,
+	src ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	var kind ast.ConditionalJumpKind
+	switch op.Value {
+	case "jtrue":
+		kind = ast.Jne
+	case "jfalse":
+		kind = ast.Jeq
+	default:
+		return nil, parseutil.NewLocationError(
+			op.Loc(),
+			"unexpected single value conditional jump kind (%s)",
+			op.Value)
+	}
+
+	return &ast.ConditionalJump{
+		StartEndPos: parseutil.NewStartEndPos(op.Loc(), src.End()),
+		Kind:        kind,
+		Src1:        src,
+		Src2: &ast.BoolImmediate{
+			StartEndPos: src.StartEnd(),
+			Value:       false,
+		},
+		Label: label.Label,
+	}, nil
+}
+
+func (Reducer) SwitchToControlFlowInstruction(
+	switchKW *lr.TokenValue,
+	src ast.Value,
+	comma1 *lr.TokenValue,
+	defaultLabel lr.ParsedLocalLabel,
+	comma2 *lr.TokenValue,
+	lbracket *lr.TokenValue,
+	cases []*ast.SwitchCase,
+	rbracket *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.Switch{
+		StartEndPos:  parseutil.NewStartEndPos(switchKW.Loc(), rbracket.End()),
+		Src:          src,
+		DefaultLabel: defaultLabel.Label,
+		Cases:        cases,
+	}, nil
+}
+
+func (Reducer) ToSwitchCase(
+	value ast.Value,
+	colon *lr.TokenValue,
+	label lr.ParsedLocalLabel,
+) (
+	*ast.SwitchCase,
+	error,
+) {
+	return &ast.SwitchCase{
+		StartEndPos: parseutil.NewStartEndPos(value.Loc(), label.End()),
+		Value:       value.(*ast.IntImmediate),
+		Label:       label.Label,
+	}, nil
+}
+
+func (Reducer) TailCallToControlFlowInstruction(
+	tailCall *lr.TokenValue,
+	funcLoc ast.Value,
+	lparen *lr.TokenValue,
+	args []ast.Value,
+	rparen *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.TailCall{
+		StartEndPos: parseutil.NewStartEndPos(tailCall.Loc(), rparen.End()),
+		Func:        funcLoc,
+		Args:        args,
+	}, nil
+}
+
 func (Reducer) TerminalToControlFlowInstruction(
 	op *lr.TokenValue,
 	src ast.Value,
@@ -53,3 +142,37 @@ func (Reducer) TerminalToControlFlowInstruction(
 		RetVal:      src,
 	}, nil
 }
+
+func (Reducer) TupleTerminalToControlFlowInstruction(
+	op *lr.TokenValue,
+	src ast.Value,
+	comma *lr.TokenValue,
+	srcs []ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.Terminal{
+		StartEndPos: parseutil.NewStartEndPos(
+			op.Loc(),
+			srcs[len(srcs)-1].End()),
+		Kind:         ast.TerminalKind(op.Value),
+		TupleRetVals: append([]ast.Value{src}, srcs...),
+	}, nil
+}
+
+// Plain ret returns the unit (zero) value.
+func (Reducer) UnitTerminalToControlFlowInstruction(
+	op *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.Terminal{
+		StartEndPos: op.StartEndPos,
+		Kind:        ast.TerminalKind(op.Value),
+		RetVal: &ast.ZeroImmediate{
+			StartEndPos: op.StartEndPos,
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package reducer
+
+import (
+	"strconv"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/parser/lr"
+)
+
+func (Reducer) DataToDefinition(
+	define *lr.TokenValue,
+	data *lr.TokenValue,
+	label *ast.GlobalLabelReference,
+	elementType ast.Type,
+	equal *lr.TokenValue,
+	values []ast.DataValue,
+) (
+	ast.Line,
+	error,
+) {
+	return newDataDefinition(define, false, label, elementType, values), nil
+}
+
+func (Reducer) VarToDefinition(
+	define *lr.TokenValue,
+	variable *lr.TokenValue,
+	label *ast.GlobalLabelReference,
+	elementType ast.Type,
+	equal *lr.TokenValue,
+	values []ast.DataValue,
+) (
+	ast.Line,
+	error,
+) {
+	return newDataDefinition(define, true, label, elementType, values), nil
+}
+
+func newDataDefinition(
+	define *lr.TokenValue,
+	isMutable bool,
+	label *ast.GlobalLabelReference,
+	elementType ast.Type,
+	values []ast.DataValue,
+) *ast.DataDefinition {
+	return &ast.DataDefinition{
+		StartEndPos: parseutil.NewStartEndPos(
+			define.Loc(),
+			values[len(values)-1].End()),
+		IsMutable:   isMutable,
+		Label:       label.Label,
+		ElementType: elementType,
+		Values:      values,
+	}
+}
+
+func (Reducer) ImmediateToDataValue(
+	value ast.Value,
+) (
+	ast.DataValue,
+	error,
+) {
+	return &ast.ImmediateData{
+		StartEndPos: parseutil.NewStartEndPos(value.Loc(), value.End()),
+		Value:       value,
+		Count:       1,
+	}, nil
+}
+
+func (Reducer) StringToDataValue(
+	token *lr.TokenValue,
+) (
+	ast.DataValue,
+	error,
+) {
+	return &ast.StringData{
+		StartEndPos: token.StartEndPos,
+		Value:       parseutil.Unescape(token.Value[1 : len(token.Value)-1]),
+	}, nil
+}
+
+func (Reducer) RepeatedToDataValue(
+	value ast.Value,
+	star *lr.TokenValue,
+	count *lr.TokenValue,
+) (
+	ast.DataValue,
+	error,
+) {
+	parsed, err := strconv.ParseInt(count.Value, 0, 32)
+	if err != nil {
+		return nil, parseutil.NewLocationError(
+			count.Loc(),
+			"failed to parse data repeat count (%s): %w",
+			count.Value,
+			err)
+	}
+
+	return &ast.ImmediateData{
+		StartEndPos: parseutil.NewStartEndPos(value.Loc(), count.End()),
+		Value:       value,
+		Count:       int(parsed),
+	}, nil
+}
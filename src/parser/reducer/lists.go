@@ -42,6 +42,28 @@ func (Reducer) NewToProperParameters(
 	return []*ast.VariableDefinition{def}, nil
 }
 
+func (Reducer) AddToTupleVariableDefinitions(
+	list []*ast.VariableDefinition,
+	comma *lr.TokenValue,
+	def *ast.VariableDefinition,
+) (
+	[]*ast.VariableDefinition,
+	error,
+) {
+	return append(list, def), nil
+}
+
+func (Reducer) NewToTupleVariableDefinitions(
+	first *ast.VariableDefinition,
+	comma *lr.TokenValue,
+	second *ast.VariableDefinition,
+) (
+	[]*ast.VariableDefinition,
+	error,
+) {
+	return []*ast.VariableDefinition{first, second}, nil
+}
+
 func (Reducer) ImproperToArguments(
 	list []ast.Value,
 	comma *lr.TokenValue,
@@ -115,3 +137,97 @@ func (Reducer) NewToProperTypes(
 ) {
 	return []ast.Type{typeExpr}, nil
 }
+
+func (Reducer) AddToDataValues(
+	list []ast.DataValue,
+	comma *lr.TokenValue,
+	value ast.DataValue,
+) (
+	[]ast.DataValue,
+	error,
+) {
+	return append(list, value), nil
+}
+
+func (Reducer) NewToDataValues(
+	value ast.DataValue,
+) (
+	[]ast.DataValue,
+	error,
+) {
+	return []ast.DataValue{value}, nil
+}
+
+func (Reducer) ImproperToStructFields(
+	list []*ast.StructField,
+	comma *lr.TokenValue,
+) (
+	[]*ast.StructField,
+	error,
+) {
+	return list, nil
+}
+
+func (Reducer) NilToStructFields() (
+	[]*ast.StructField,
+	error,
+) {
+	return nil, nil
+}
+
+func (Reducer) AddToProperStructFields(
+	list []*ast.StructField,
+	comma *lr.TokenValue,
+	field *ast.StructField,
+) (
+	[]*ast.StructField,
+	error,
+) {
+	return append(list, field), nil
+}
+
+func (Reducer) NewToProperStructFields(
+	field *ast.StructField,
+) (
+	[]*ast.StructField,
+	error,
+) {
+	return []*ast.StructField{field}, nil
+}
+
+func (Reducer) ImproperToSwitchCases(
+	list []*ast.SwitchCase,
+	comma *lr.TokenValue,
+) (
+	[]*ast.SwitchCase,
+	error,
+) {
+	return list, nil
+}
+
+func (Reducer) NilToSwitchCases() (
+	[]*ast.SwitchCase,
+	error,
+) {
+	return nil, nil
+}
+
+func (Reducer) AddToProperSwitchCases(
+	list []*ast.SwitchCase,
+	comma *lr.TokenValue,
+	switchCase *ast.SwitchCase,
+) (
+	[]*ast.SwitchCase,
+	error,
+) {
+	return append(list, switchCase), nil
+}
+
+func (Reducer) NewToProperSwitchCases(
+	switchCase *ast.SwitchCase,
+) (
+	[]*ast.SwitchCase,
+	error,
+) {
+	return []*ast.SwitchCase{switchCase}, nil
+}
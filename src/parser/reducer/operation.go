@@ -79,3 +79,180 @@ func (Reducer) CallToOperationInstruction(
 		Args:        args,
 	}, nil
 }
+
+func (Reducer) TupleCallToOperationInstruction(
+	dests []*ast.VariableDefinition,
+	equal *lr.TokenValue,
+	callKind *lr.TokenValue,
+	funcLoc ast.Value,
+	lparen *lr.TokenValue,
+	args []ast.Value,
+	rparen *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.FuncCall{
+		StartEndPos: parseutil.NewStartEndPos(dests[0].Loc(), rparen.End()),
+		Kind:        ast.FuncCallKind(callKind.Value),
+		Func:        funcLoc,
+		Args:        args,
+		TupleDests:  dests,
+	}, nil
+}
+
+// The call's return value is ignored.  An internal destination is assigned
+// during control flow graph initialization.
+func (Reducer) IgnoredCallToOperationInstruction(
+	callKind *lr.TokenValue,
+	funcLoc ast.Value,
+	lparen *lr.TokenValue,
+	args []ast.Value,
+	rparen *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.FuncCall{
+		StartEndPos: parseutil.NewStartEndPos(callKind.Loc(), rparen.End()),
+		Kind:        ast.FuncCallKind(callKind.Value),
+		Func:        funcLoc,
+		Args:        args,
+	}, nil
+}
+
+func (Reducer) LoadToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	load *lr.TokenValue,
+	address ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.LoadOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), address.End()),
+		Dest:        dest,
+		Address:     address,
+	}, nil
+}
+
+func (Reducer) StoreToOperationInstruction(
+	store *lr.TokenValue,
+	address ast.Value,
+	comma *lr.TokenValue,
+	src ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.StoreOperation{
+		StartEndPos: parseutil.NewStartEndPos(store.Loc(), src.End()),
+		Address:     address,
+		Src:         src,
+	}, nil
+}
+
+func (Reducer) ExtractToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	extract *lr.TokenValue,
+	src ast.Value,
+	comma *lr.TokenValue,
+	field *lr.TokenValue,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.ExtractOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), field.End()),
+		Dest:        dest,
+		Src:         src,
+		Field:       field.Value,
+	}, nil
+}
+
+func (Reducer) InsertToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	insert *lr.TokenValue,
+	src ast.Value,
+	comma1 *lr.TokenValue,
+	field *lr.TokenValue,
+	comma2 *lr.TokenValue,
+	value ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.InsertOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), value.End()),
+		Dest:        dest,
+		Src:         src,
+		Field:       field.Value,
+		Value:       value,
+	}, nil
+}
+
+func (Reducer) GetElementToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	getElem *lr.TokenValue,
+	src ast.Value,
+	comma *lr.TokenValue,
+	index ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.GetElementOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), index.End()),
+		Dest:        dest,
+		Src:         src,
+		Index:       index,
+	}, nil
+}
+
+func (Reducer) SetElementToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	setElem *lr.TokenValue,
+	src ast.Value,
+	comma1 *lr.TokenValue,
+	index ast.Value,
+	comma2 *lr.TokenValue,
+	value ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.SetElementOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), value.End()),
+		Dest:        dest,
+		Src:         src,
+		Index:       index,
+		Value:       value,
+	}, nil
+}
+
+func (Reducer) SelectToOperationInstruction(
+	dest *ast.VariableDefinition,
+	equal *lr.TokenValue,
+	sel *lr.TokenValue,
+	cond ast.Value,
+	comma1 *lr.TokenValue,
+	trueValue ast.Value,
+	comma2 *lr.TokenValue,
+	falseValue ast.Value,
+) (
+	ast.Instruction,
+	error,
+) {
+	return &ast.SelectOperation{
+		StartEndPos: parseutil.NewStartEndPos(dest.Loc(), falseValue.End()),
+		Dest:        dest,
+		Cond:        cond,
+		TrueValue:   trueValue,
+		FalseValue:  falseValue,
+	}, nil
+}
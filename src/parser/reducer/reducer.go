@@ -16,6 +16,7 @@ var _ lr.Reducer = Reducer{}
 func (Reducer) FuncToDefinition(
 	define *lr.TokenValue,
 	funcKW *lr.TokenValue,
+	callConvention *lr.TokenValue,
 	label *ast.GlobalLabelReference,
 	lparen *lr.TokenValue,
 	parameters []*ast.VariableDefinition,
@@ -27,15 +28,87 @@ func (Reducer) FuncToDefinition(
 	error,
 ) {
 	return &ast.FunctionDefinition{
-		StartEndPos: parseutil.NewStartEndPos(define.Loc(), lbrace.End()),
-		// TODO: make call convention configurable
-		CallConventionName: ast.DefaultCallConvention,
+		StartEndPos:        parseutil.NewStartEndPos(define.Loc(), lbrace.End()),
+		CallConventionName: toCallConventionName(callConvention),
 		Label:              label.Label,
 		Parameters:         parameters,
+		ReturnType:         toReturnType(retType, rparen),
+	}, nil
+}
+
+func (Reducer) FuncToDeclaration(
+	declare *lr.TokenValue,
+	funcKW *lr.TokenValue,
+	callConvention *lr.TokenValue,
+	label *ast.GlobalLabelReference,
+	lparen *lr.TokenValue,
+	parameterTypes []ast.Type,
+	rparen *lr.TokenValue,
+	retType ast.Type,
+) (
+	ast.Line,
+	error,
+) {
+	retType = toReturnType(retType, rparen)
+	return &ast.FunctionDeclaration{
+		StartEndPos:        parseutil.NewStartEndPos(declare.Loc(), retType.End()),
+		CallConventionName: toCallConventionName(callConvention),
+		Label:              label.Label,
+		ParameterTypes:     parameterTypes,
 		ReturnType:         retType,
 	}, nil
 }
 
+func (Reducer) NamedToCallConvention(
+	lbrace *lr.TokenValue,
+	name *lr.TokenValue,
+	rbrace *lr.TokenValue,
+) (
+	*lr.TokenValue,
+	error,
+) {
+	return name, nil
+}
+
+func (Reducer) DefaultToCallConvention() (*lr.TokenValue, error) {
+	return nil, nil
+}
+
+// Note: the call convention name is validated by the ast.
+func toCallConventionName(name *lr.TokenValue) ast.CallConventionName {
+	if name == nil {
+		return ast.DefaultCallConvention
+	}
+	return ast.CallConventionName(name.Value)
+}
+
+func (Reducer) TupleToReturnType(
+	lparen *lr.TokenValue,
+	first ast.Type,
+	comma *lr.TokenValue,
+	rest []ast.Type,
+	rparen *lr.TokenValue,
+) (
+	ast.Type,
+	error,
+) {
+	return ast.NewTupleType(
+		parseutil.NewStartEndPos(lparen.Loc(), rparen.End()),
+		append([]ast.Type{first}, rest...)), nil
+}
+
+func (Reducer) UnitToReturnType() (ast.Type, error) {
+	return nil, nil
+}
+
+// The unit return type is positioned at the parameter list's closing paren.
+func toReturnType(retType ast.Type, rparen *lr.TokenValue) ast.Type {
+	if retType == nil {
+		return ast.NewUnitType(rparen.StartEndPos)
+	}
+	return retType
+}
+
 func (Reducer) ToRbrace(
 	rbrace *lr.TokenValue,
 ) (
@@ -158,6 +231,41 @@ func (Reducer) ToFloatImmediate(
 	}, nil
 }
 
+func (Reducer) TrueToBoolImmediate(
+	token *lr.TokenValue,
+) (
+	ast.Value,
+	error,
+) {
+	return &ast.BoolImmediate{
+		StartEndPos: token.StartEndPos,
+		Value:       true,
+	}, nil
+}
+
+func (Reducer) FalseToBoolImmediate(
+	token *lr.TokenValue,
+) (
+	ast.Value,
+	error,
+) {
+	return &ast.BoolImmediate{
+		StartEndPos: token.StartEndPos,
+		Value:       false,
+	}, nil
+}
+
+func (Reducer) ToZeroImmediate(
+	token *lr.TokenValue,
+) (
+	ast.Value,
+	error,
+) {
+	return &ast.ZeroImmediate{
+		StartEndPos: token.StartEndPos,
+	}, nil
+}
+
 func (Reducer) StringToIdentifier(
 	token *lr.TokenValue,
 ) (
@@ -1,6 +1,7 @@
 package reducer
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/pattyshack/gt/parseutil"
@@ -15,7 +16,9 @@ func (Reducer) ToNumberType(
 	ast.Type,
 	error,
 ) {
-	if strings.HasPrefix(token.Value, "F") {
+	if token.Value == "Bool" {
+		return ast.NewBoolType(token.StartEndPos), nil
+	} else if strings.HasPrefix(token.Value, "F") {
 		return &ast.FloatType{
 			StartEndPos: token.StartEndPos,
 			Kind:        ast.FloatTypeKind(token.Value),
@@ -35,6 +38,7 @@ func (Reducer) ToNumberType(
 
 func (Reducer) ToFuncType(
 	funcKW *lr.TokenValue,
+	callConvention *lr.TokenValue,
 	lparen *lr.TokenValue,
 	parameterTypes []ast.Type,
 	rparen *lr.TokenValue,
@@ -43,11 +47,75 @@ func (Reducer) ToFuncType(
 	ast.Type,
 	error,
 ) {
+	retType = toReturnType(retType, rparen)
 	return &ast.FunctionType{
-		StartEndPos: parseutil.NewStartEndPos(funcKW.Loc(), retType.End()),
-		// TODO: make call convention configurable
-		CallConventionName: ast.DefaultCallConvention,
+		StartEndPos:        parseutil.NewStartEndPos(funcKW.Loc(), retType.End()),
+		CallConventionName: toCallConventionName(callConvention),
 		ParameterTypes:     parameterTypes,
 		ReturnType:         retType,
 	}, nil
 }
+
+func (Reducer) ToPointerType(
+	star *lr.TokenValue,
+	elementType ast.Type,
+) (
+	ast.Type,
+	error,
+) {
+	return ast.NewPointerType(
+		parseutil.NewStartEndPos(star.Loc(), elementType.End()),
+		elementType), nil
+}
+
+func (Reducer) ToStructType(
+	structKW *lr.TokenValue,
+	lbrace *lr.TokenValue,
+	fields []*ast.StructField,
+	rbrace *lr.TokenValue,
+) (
+	ast.Type,
+	error,
+) {
+	return ast.NewStructType(
+		parseutil.NewStartEndPos(structKW.Loc(), rbrace.End()),
+		fields), nil
+}
+
+func (Reducer) ToStructField(
+	name *lr.TokenValue,
+	fieldType ast.Type,
+) (
+	*ast.StructField,
+	error,
+) {
+	return &ast.StructField{
+		StartEndPos: parseutil.NewStartEndPos(name.Loc(), fieldType.End()),
+		Name:        name.Value,
+		Type:        fieldType,
+	}, nil
+}
+
+func (Reducer) ToArrayType(
+	lbracket *lr.TokenValue,
+	length *lr.TokenValue,
+	rbracket *lr.TokenValue,
+	elementType ast.Type,
+) (
+	ast.Type,
+	error,
+) {
+	parsed, err := strconv.ParseInt(length.Value, 0, 32)
+	if err != nil {
+		return nil, parseutil.NewLocationError(
+			length.Loc(),
+			"failed to parse array length (%s): %w",
+			length.Value,
+			err)
+	}
+
+	return ast.NewArrayType(
+		parseutil.NewStartEndPos(lbracket.Loc(), elementType.End()),
+		int(parsed),
+		elementType), nil
+}
@@ -32,12 +32,7 @@ func (InternalCallTypeSpec) IsValidArgType(ast.Type) bool    { return true }
 func (InternalCallTypeSpec) IsValidReturnType(ast.Type) bool { return true }
 
 func isPrimitiveType(t ast.Type) bool {
-	if ast.IsNumberSubType(t) {
-		return true
-	}
-
-	// TODO pointer
-	return false
+	return ast.IsNumberSubType(t) || ast.IsPointerType(t)
 }
 
 type SystemVLiteCallTypeSpec struct {
@@ -47,8 +42,9 @@ func (SystemVLiteCallTypeSpec) IsValidArgType(t ast.Type) bool {
 	return isPrimitiveType(t)
 }
 
+// Unit return type corresponds to C's void return type.
 func (SystemVLiteCallTypeSpec) IsValidReturnType(t ast.Type) bool {
-	return isPrimitiveType(t)
+	return isPrimitiveType(t) || ast.IsUnitType(t)
 }
 
 type cachedCallSpec struct {
@@ -0,0 +1,120 @@
+package elf
+
+import (
+	"debug/elf"
+	"fmt"
+	"io"
+
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+const (
+	// The conventional non-PIE base address on linux amd64.
+	executableBaseAddress = 0x400000
+
+	pageSize = 0x1000
+)
+
+// Writes a static executable which needs no dynamic linking (i.e., no libc).
+// Each image section (.text, .rodata, .data, .bss) is loaded into its own
+// page aligned segment, with the section's access permissions.  The entry
+// label (e.g., the platform's start stub label) must be one of the segments'
+// labels.
+func WriteExecutable(
+	out io.Writer,
+	targetPlatform platform.Platform,
+	entryLabel string,
+	segments []executable.LabelledSegment,
+) error {
+	writer, err := newFileWriter(targetPlatform, elf.ET_EXEC)
+	if err != nil {
+		return err
+	}
+
+	image, err := linker.Layout(
+		writer.byteOrder,
+		functionAlignment,
+		pageSize,
+		segments)
+	if err != nil {
+		return err
+	}
+
+	entryOffset, ok := image.SymbolOffset(entryLabel)
+	if !ok {
+		return fmt.Errorf("undefined entry symbol: %s", entryLabel)
+	}
+
+	writer.programs = make([]elf.Prog64, len(image.Sections))
+
+	sectionIndices := map[executable.SectionKind]int{}
+	sections := make([]*section, 0, len(image.Sections))
+	for _, imageSection := range image.Sections {
+		index, sec := writer.addImageSection(image, imageSection, pageSize)
+		sectionIndices[imageSection.Kind] = index
+		sections = append(sections, sec)
+	}
+
+	// The image sections are the first sections, their offsets won't change
+	// once the remaining sections are added.  Since all image sections are
+	// page aligned, the image is laid out continuously in the file.
+	writer.layout()
+	imageOffset := sections[0].Off - image.Sections[0].Offset
+	imageAddress := executableBaseAddress + imageOffset
+
+	for idx, sec := range sections {
+		imageSection := image.Sections[idx]
+		if sec.Off != imageOffset+imageSection.Offset {
+			panic("should never happen")
+		}
+		sec.Addr = imageAddress + imageSection.Offset
+
+		flags := elf.PF_R
+		if imageSection.Kind.IsExecutable() {
+			flags |= elf.PF_X
+		}
+		if imageSection.Kind.IsWritable() {
+			flags |= elf.PF_W
+		}
+
+		writer.programs[idx] = elf.Prog64{
+			Type:   uint32(elf.PT_LOAD),
+			Flags:  uint32(flags),
+			Off:    sec.Off,
+			Vaddr:  sec.Addr,
+			Paddr:  sec.Addr,
+			Filesz: uint64(len(sec.content)),
+			Memsz:  sec.Size,
+			Align:  pageSize,
+		}
+	}
+
+	writer.entry = imageAddress + entryOffset
+
+	err = image.Link(imageAddress)
+	if err != nil {
+		return err
+	}
+
+	symbols := make([]symbol, 0, len(image.Symbols))
+	for _, sym := range localSymbolsFirst(image.Symbols) {
+		symbols = append(
+			symbols,
+			symbol{
+				Sym64: elf.Sym64{
+					Info:  elf.ST_INFO(symbolBinding(sym), symbolType(sym.Section)),
+					Shndx: uint16(sectionIndices[sym.Section]),
+					Value: imageAddress + sym.Offset,
+					Size:  sym.Size,
+				},
+				name: sym.Label,
+			})
+	}
+
+	writer.addSymbolTable(symbols)
+
+	_, err = writer.WriteTo(out)
+	return err
+}
@@ -0,0 +1,54 @@
+package elf
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+// The start stub exits with zero status when the entry function returns unit.
+func TestWriteExecutableUnitEntry(t *testing.T) {
+	targetPlatform := x64.NewPlatform(platform.Linux)
+
+	entry := executable.LabelledSegment{
+		Label: "entry",
+		Segment: executable.Segment{
+			Bytes: []byte{0xc3}, // ret
+		},
+	}
+
+	entryType := ast.NewFunctionType(
+		parseutil.StartEndPos{},
+		ast.SystemVLiteCallConvention,
+		ast.NewUnitType(parseutil.StartEndPos{}),
+		nil)
+
+	startStub, err := targetPlatform.GenerateStartStub(
+		entry.Label,
+		entryType,
+		nil)
+	expect.Nil(t, err)
+
+	fileName := filepath.Join(t.TempDir(), "unit")
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY, 0755)
+	expect.Nil(t, err)
+
+	err = WriteExecutable(
+		file,
+		targetPlatform,
+		startStub.Label,
+		[]executable.LabelledSegment{entry, startStub})
+	expect.Nil(t, err)
+	expect.Nil(t, file.Close())
+
+	expect.Nil(t, exec.Command(fileName).Run())
+}
@@ -0,0 +1,220 @@
+package elf
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+var (
+	relocationWithAddendSize = binary.Size(elf.Rela64{})
+)
+
+// Writes a relocatable object file which could be linked with objects from
+// other toolchains (e.g., via the system ld).  Each image section becomes its
+// own elf section (.text, .rodata, .data, .bss).  Function segment labels
+// become global function symbols, and data segment labels become global
+// object symbols.  Internal segment labels (e.g., jump tables) become local
+// symbols, which are ordered before the global symbols.
+//
+// Local label rel32 relocations are resolved in place.  Global label
+// relocations are always emitted as relocation entries (even when the label is
+// defined within the object) such that the linker has the final say.  Labels
+// not defined within the object become undefined global symbols.
+func WriteObject(
+	out io.Writer,
+	targetPlatform platform.Platform,
+	segments []executable.LabelledSegment,
+) error {
+	writer, err := newFileWriter(targetPlatform, elf.ET_REL)
+	if err != nil {
+		return err
+	}
+
+	image, err := linker.Layout(
+		writer.byteOrder,
+		functionAlignment,
+		functionAlignment,
+		segments)
+	if err != nil {
+		return err
+	}
+
+	unresolved, err := image.PartialLink()
+	if err != nil {
+		return err
+	}
+
+	// The section symbols are used by relocations which are relative to the
+	// beginning of the sections.
+	symbols := []symbol{}
+	sectionIndices := map[executable.SectionKind]int{}
+	sectionSymbolIndices := map[executable.SectionKind]int{}
+	for _, imageSection := range image.Sections {
+		index, _ := writer.addImageSection(
+			image,
+			imageSection,
+			functionAlignment)
+		sectionIndices[imageSection.Kind] = index
+
+		symbols = append(
+			symbols,
+			symbol{
+				Sym64: elf.Sym64{
+					Info:  elf.ST_INFO(elf.STB_LOCAL, elf.STT_SECTION),
+					Shndx: uint16(index),
+				},
+			})
+		sectionSymbolIndices[imageSection.Kind] = len(symbols)
+	}
+
+	sections := map[executable.SectionKind]linker.Section{}
+	for _, section := range image.Sections {
+		sections[section.Kind] = section
+	}
+
+	symbolIndices := map[string]int{}
+	symbolSections := map[string]executable.SectionKind{}
+	for _, sym := range localSymbolsFirst(image.Symbols) {
+		symbols = append(
+			symbols,
+			symbol{
+				Sym64: elf.Sym64{
+					Info:  elf.ST_INFO(symbolBinding(sym), symbolType(sym.Section)),
+					Shndx: uint16(sectionIndices[sym.Section]),
+					Value: sym.Offset - sections[sym.Section].Offset,
+					Size:  sym.Size,
+				},
+				name: sym.Label,
+			})
+		symbolIndices[sym.Label] = len(symbols)
+		symbolSections[sym.Label] = sym.Section
+	}
+
+	undefined := map[string]struct{}{}
+	for _, reloc := range unresolved {
+		if reloc.Symbol == "" {
+			continue
+		}
+
+		_, ok := symbolIndices[reloc.Symbol]
+		if !ok {
+			undefined[reloc.Symbol] = struct{}{}
+		}
+	}
+
+	undefinedNames := make([]string, 0, len(undefined))
+	for name := range undefined {
+		undefinedNames = append(undefinedNames, name)
+	}
+	sort.Strings(undefinedNames)
+
+	for _, name := range undefinedNames {
+		symbols = append(
+			symbols,
+			symbol{
+				Sym64: elf.Sym64{
+					Info:  elf.ST_INFO(elf.STB_GLOBAL, elf.STT_NOTYPE),
+					Shndx: uint16(elf.SHN_UNDEF),
+				},
+				name: name,
+			})
+		symbolIndices[name] = len(symbols)
+	}
+
+	relocations := map[executable.SectionKind]*bytes.Buffer{}
+	for _, reloc := range unresolved {
+		section := imageSection(image, reloc.Offset)
+		entry := elf.Rela64{
+			Off:    reloc.Offset - section.Offset,
+			Addend: reloc.Addend,
+		}
+
+		// Relative references to data must not go through the plt.
+		relativeType := elf.R_X86_64_PLT32
+		var symbolIndex uint32
+		if reloc.Symbol == "" {
+			// The addend is relative to the beginning of the image.  Convert it
+			// to be relative to the target section's beginning.
+			target := imageSection(image, uint64(reloc.Addend))
+			symbolIndex = uint32(sectionSymbolIndices[target.Kind])
+			entry.Addend -= int64(target.Offset)
+			if !target.Kind.IsExecutable() {
+				relativeType = elf.R_X86_64_PC32
+			}
+		} else {
+			symbolIndex = uint32(symbolIndices[reloc.Symbol])
+			kind, ok := symbolSections[reloc.Symbol]
+			if ok && !kind.IsExecutable() {
+				relativeType = elf.R_X86_64_PC32
+			}
+		}
+
+		switch reloc.Kind {
+		case executable.Rel32Relocation:
+			entry.Info = elf.R_INFO(symbolIndex, uint32(relativeType))
+			entry.Addend -= 4
+		case executable.Abs64Relocation:
+			entry.Info = elf.R_INFO(symbolIndex, uint32(elf.R_X86_64_64))
+		default:
+			return fmt.Errorf("unsupported relocation kind: %s", reloc.Kind)
+		}
+
+		buffer, ok := relocations[section.Kind]
+		if !ok {
+			buffer = &bytes.Buffer{}
+			relocations[section.Kind] = buffer
+		}
+		binary.Write(buffer, writer.byteOrder, entry)
+	}
+	symbolTableIndex := writer.addSymbolTable(symbols)
+
+	for _, section := range image.Sections {
+		buffer, ok := relocations[section.Kind]
+		if !ok {
+			continue
+		}
+
+		writer.addSection(
+			".rela."+string(section.Kind),
+			elf.Section64{
+				Type:      uint32(elf.SHT_RELA),
+				Flags:     uint64(elf.SHF_INFO_LINK),
+				Link:      uint32(symbolTableIndex),
+				Info:      uint32(sectionIndices[section.Kind]),
+				Addralign: 8,
+				Entsize:   uint64(relocationWithAddendSize),
+			},
+			buffer.Bytes())
+	}
+
+	// Marks the stack as non-executable.
+	writer.addSection(
+		".note.GNU-stack",
+		elf.Section64{
+			Type:      uint32(elf.SHT_PROGBITS),
+			Addralign: 1,
+		},
+		nil)
+
+	_, err = writer.WriteTo(out)
+	return err
+}
+
+// Returns the image section which contains the offset.
+func imageSection(image *linker.Image, offset uint64) linker.Section {
+	for _, section := range image.Sections {
+		if section.Offset <= offset && offset < section.Offset+section.Size {
+			return section
+		}
+	}
+
+	panic("should never happen")
+}
@@ -0,0 +1,95 @@
+package elf
+
+import (
+	"bytes"
+	"debug/elf"
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func TestWriteObjectInternalSymbols(t *testing.T) {
+	table := executable.LabelledSegment{
+		Label:      "f:jump-table-0",
+		Section:    executable.ReadOnlyDataSection,
+		IsInternal: true,
+		Segment: executable.Segment{
+			Bytes: make([]byte, 16),
+		},
+	}
+
+	function := executable.LabelledSegment{
+		Label: "f",
+		Segment: executable.Segment{
+			Bytes: make([]byte, 16),
+			Relocations: []executable.Relocation{
+				{
+					Kind:   executable.Rel32Relocation,
+					Offset: 4,
+					Label:  executable.SegmentLabel{Name: table.Label},
+				},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := WriteObject(
+		buffer,
+		x64.NewPlatform(platform.Linux),
+		// The internal segment is intentionally listed after the global segment.
+		[]executable.LabelledSegment{function, table})
+	expect.Nil(t, err)
+
+	file, err := elf.NewFile(bytes.NewReader(buffer.Bytes()))
+	expect.Nil(t, err)
+
+	symbols, err := file.Symbols() // excludes the null symbol
+	expect.Nil(t, err)
+
+	bindings := map[string]elf.SymBind{}
+	for _, sym := range symbols {
+		if elf.ST_TYPE(sym.Info) == elf.STT_SECTION {
+			continue
+		}
+		bindings[sym.Name] = elf.ST_BIND(sym.Info)
+	}
+	expect.Equal(
+		t,
+		map[string]elf.SymBind{
+			"f":              elf.STB_GLOBAL,
+			"f:jump-table-0": elf.STB_LOCAL,
+		},
+		bindings)
+
+	// sh_info is the index of the first global symbol.
+	symbolTable := file.Section(".symtab")
+	expect.NotNil(t, symbolTable)
+
+	numLocals := int(symbolTable.Info)
+	for idx, sym := range symbols {
+		isLocal := elf.ST_BIND(sym.Info) == elf.STB_LOCAL
+		expect.Equal(t, idx+1 < numLocals, isLocal, "%s", sym.Name)
+	}
+	expect.Equal(t, "f", symbols[numLocals-1].Name)
+
+	// The relocation must reference the local symbol with a pc relative
+	// (non-plt) relocation.
+	relocations := file.Section(".rela.text")
+	expect.NotNil(t, relocations)
+
+	content, err := relocations.Data()
+	expect.Nil(t, err)
+	expect.Equal(t, relocationWithAddendSize, len(content))
+
+	info := file.ByteOrder.Uint64(content[8:])
+	symbolIndex := int(elf.R_SYM64(info))
+	expect.Equal(t, "f:jump-table-0", symbols[symbolIndex-1].Name)
+	expect.Equal(
+		t,
+		elf.R_X86_64_PC32,
+		elf.R_X86_64(elf.R_TYPE64(info)))
+}
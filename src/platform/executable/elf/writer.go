@@ -0,0 +1,338 @@
+package elf
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable"
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+const (
+	functionAlignment = 16
+)
+
+var (
+	fileHeaderSize    = binary.Size(elf.Header64{})
+	programHeaderSize = binary.Size(elf.Prog64{})
+	sectionHeaderSize = binary.Size(elf.Section64{})
+	symbolSize        = binary.Size(elf.Sym64{})
+)
+
+func align(offset uint64, alignment uint64) uint64 {
+	if alignment <= 1 {
+		return offset
+	}
+	return (offset + alignment - 1) / alignment * alignment
+}
+
+// Null terminated string table.  The first entry is always the empty string.
+type stringTable struct {
+	content []byte
+	offsets map[string]uint32
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{
+		content: []byte{0},
+		offsets: map[string]uint32{"": 0},
+	}
+}
+
+func (table *stringTable) Add(str string) uint32 {
+	offset, ok := table.offsets[str]
+	if ok {
+		return offset
+	}
+
+	offset = uint32(len(table.content))
+	table.content = append(table.content, str...)
+	table.content = append(table.content, 0)
+	table.offsets[str] = offset
+	return offset
+}
+
+type section struct {
+	elf.Section64
+
+	name    string
+	content []byte
+}
+
+type symbol struct {
+	elf.Sym64
+
+	name string
+}
+
+// A minimal ELF64 file writer.  The file is laid out as:
+//
+//	file header
+//	program headers
+//	section contents (in the order added, followed by .shstrtab)
+//	section headers
+//
+// The null section and the section name string table are managed by the
+// writer.
+type fileWriter struct {
+	byteOrder binary.ByteOrder
+
+	fileType elf.Type
+	machine  elf.Machine
+	entry    uint64
+
+	programs []elf.Prog64
+
+	sections []*section // excluding the null section
+
+	sectionHeadersOffset uint64
+}
+
+func newFileWriter(
+	targetPlatform platform.Platform,
+	fileType elf.Type,
+) (
+	*fileWriter,
+	error,
+) {
+	var machine elf.Machine
+	switch targetPlatform.ArchitectureName() {
+	case platform.Amd64:
+		machine = elf.EM_X86_64
+	default:
+		return nil, fmt.Errorf(
+			"unsupported elf architecture: %s",
+			targetPlatform.ArchitectureName())
+	}
+
+	if targetPlatform.OperatingSystemName() != platform.Linux {
+		return nil, fmt.Errorf(
+			"unsupported elf operating system: %s",
+			targetPlatform.OperatingSystemName())
+	}
+
+	return &fileWriter{
+		byteOrder: targetPlatform.ByteOrder(),
+		fileType:  fileType,
+		machine:   machine,
+	}, nil
+}
+
+// Returns the section's index and the section.
+func (writer *fileWriter) addSection(
+	name string,
+	header elf.Section64,
+	content []byte,
+) (
+	int,
+	*section,
+) {
+	sec := &section{
+		Section64: header,
+		name:      name,
+		content:   content,
+	}
+	writer.sections = append(writer.sections, sec)
+	return len(writer.sections), sec
+}
+
+// Adds the image section's corresponding elf section (.text, .rodata, .data,
+// or .bss).  Note that the .bss section occupies no file space.
+func (writer *fileWriter) addImageSection(
+	image *linker.Image,
+	imageSection linker.Section,
+	alignment uint64,
+) (
+	int,
+	*section,
+) {
+	header := elf.Section64{
+		Type:      uint32(elf.SHT_PROGBITS),
+		Flags:     uint64(elf.SHF_ALLOC),
+		Addralign: alignment,
+	}
+
+	if imageSection.Kind.IsExecutable() {
+		header.Flags |= uint64(elf.SHF_EXECINSTR)
+	}
+
+	if imageSection.Kind.IsWritable() {
+		header.Flags |= uint64(elf.SHF_WRITE)
+	}
+
+	start := imageSection.Offset
+	content := image.Bytes[start : start+imageSection.Size]
+	if imageSection.Kind == executable.ZeroDataSection {
+		header.Type = uint32(elf.SHT_NOBITS)
+		header.Size = imageSection.Size
+		content = nil
+	}
+
+	return writer.addSection("."+string(imageSection.Kind), header, content)
+}
+
+// Returns the symbol type associated with the section kind.
+func symbolType(kind executable.SectionKind) elf.SymType {
+	if kind.IsExecutable() {
+		return elf.STT_FUNC
+	}
+	return elf.STT_OBJECT
+}
+
+// Internal symbols (e.g., jump tables) are local to the file.  All other
+// symbols are global.
+func symbolBinding(sym linker.Symbol) elf.SymBind {
+	if sym.IsInternal {
+		return elf.STB_LOCAL
+	}
+	return elf.STB_GLOBAL
+}
+
+// Returns a copy of the image symbols with the local symbols ordered before
+// the global symbols.  The relative order is otherwise preserved.
+func localSymbolsFirst(symbols []linker.Symbol) []linker.Symbol {
+	sorted := slices.Clone(symbols)
+	slices.SortStableFunc(
+		sorted,
+		func(a linker.Symbol, b linker.Symbol) int {
+			if a.IsInternal == b.IsInternal {
+				return 0
+			} else if a.IsInternal {
+				return -1
+			}
+			return 1
+		})
+	return sorted
+}
+
+// Adds the .symtab and .strtab sections.  Local symbols must precede global
+// symbols.  The null symbol is managed by the writer.
+func (writer *fileWriter) addSymbolTable(symbols []symbol) int {
+	names := newStringTable()
+
+	content := &bytes.Buffer{}
+	// The null symbol is a local symbol.
+	binary.Write(content, writer.byteOrder, elf.Sym64{})
+	numLocals := 1
+	hasGlobals := false
+
+	for _, sym := range symbols {
+		if elf.ST_BIND(sym.Info) == elf.STB_LOCAL {
+			if hasGlobals {
+				panic("should never happen. local symbol follows global symbol")
+			}
+			numLocals++
+		} else {
+			hasGlobals = true
+		}
+
+		sym.Sym64.Name = names.Add(sym.name)
+		binary.Write(content, writer.byteOrder, sym.Sym64)
+	}
+
+	stringTableIndex, _ := writer.addSection(
+		".strtab",
+		elf.Section64{
+			Type:      uint32(elf.SHT_STRTAB),
+			Addralign: 1,
+		},
+		names.content)
+
+	symbolTableIndex, _ := writer.addSection(
+		".symtab",
+		elf.Section64{
+			Type:      uint32(elf.SHT_SYMTAB),
+			Link:      uint32(stringTableIndex),
+			Info:      uint32(numLocals),
+			Addralign: 8,
+			Entsize:   uint64(symbolSize),
+		},
+		content.Bytes())
+
+	return symbolTableIndex
+}
+
+// Assigns file offsets to all sections.  Sections' offsets are stable as
+// long as the preceding sections' sizes remain unchanged.
+func (writer *fileWriter) layout() {
+	offset := uint64(fileHeaderSize + len(writer.programs)*programHeaderSize)
+	for _, sec := range writer.sections {
+		offset = align(offset, sec.Addralign)
+		sec.Off = offset
+		if sec.Type != uint32(elf.SHT_NOBITS) { // nobits' size is preset
+			sec.Size = uint64(len(sec.content))
+		}
+		offset += uint64(len(sec.content))
+	}
+
+	writer.sectionHeadersOffset = align(offset, 8)
+}
+
+func (writer *fileWriter) WriteTo(out io.Writer) (int64, error) {
+	names := newStringTable()
+	for _, sec := range writer.sections {
+		sec.Section64.Name = names.Add(sec.name)
+	}
+
+	sectionNamesIndex, sectionNames := writer.addSection(
+		".shstrtab",
+		elf.Section64{
+			Type:      uint32(elf.SHT_STRTAB),
+			Addralign: 1,
+		},
+		nil)
+	sectionNames.Section64.Name = names.Add(sectionNames.name)
+	sectionNames.content = names.content
+
+	writer.layout()
+
+	header := elf.Header64{
+		Type:      uint16(writer.fileType),
+		Machine:   uint16(writer.machine),
+		Version:   uint32(elf.EV_CURRENT),
+		Entry:     writer.entry,
+		Shoff:     writer.sectionHeadersOffset,
+		Ehsize:    uint16(fileHeaderSize),
+		Phentsize: uint16(programHeaderSize),
+		Phnum:     uint16(len(writer.programs)),
+		Shentsize: uint16(sectionHeaderSize),
+		Shnum:     uint16(len(writer.sections) + 1),
+		Shstrndx:  uint16(sectionNamesIndex),
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	if writer.byteOrder == binary.BigEndian {
+		header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2MSB)
+	}
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	header.Ident[elf.EI_OSABI] = byte(elf.ELFOSABI_NONE)
+
+	if len(writer.programs) > 0 {
+		header.Phoff = uint64(fileHeaderSize)
+	}
+
+	buffer := &bytes.Buffer{}
+	binary.Write(buffer, writer.byteOrder, header)
+	for _, program := range writer.programs {
+		binary.Write(buffer, writer.byteOrder, program)
+	}
+
+	for _, sec := range writer.sections {
+		buffer.Write(make([]byte, int(sec.Off)-buffer.Len()))
+		buffer.Write(sec.content)
+	}
+
+	buffer.Write(make([]byte, int(writer.sectionHeadersOffset)-buffer.Len()))
+	binary.Write(buffer, writer.byteOrder, elf.Section64{}) // null section
+	for _, sec := range writer.sections {
+		binary.Write(buffer, writer.byteOrder, sec.Section64)
+	}
+
+	return buffer.WriteTo(out)
+}
@@ -0,0 +1,22 @@
+#include "textflag.h"
+
+// func callStub(stub uintptr, slots unsafe.Pointer, stackTop uintptr)
+//
+// Calls the SystemV-lite call stub on the jit stack.  The go stack pointer
+// and frame pointer are saved at the top of the jit stack.  All other
+// registers may be clobbered by the stub (the go ABI wrapper restores the
+// fixed registers on return).
+TEXT ·callStub(SB), NOSPLIT, $0-24
+	MOVQ stub+0(FP), AX
+	MOVQ slots+8(FP), DI
+	MOVQ stackTop+16(FP), CX
+
+	MOVQ SP, -8(CX)
+	MOVQ BP, -16(CX)
+	LEAQ -16(CX), SP
+
+	CALL AX
+
+	MOVQ 0(SP), BP
+	MOVQ 8(SP), SP
+	RET
@@ -0,0 +1,334 @@
+package jit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+const (
+	functionAlignment = 16
+
+	stackSize = 8 * 1024 * 1024
+
+	// It's unlikely this suffix will conflict with any real function label.
+	callStubLabelSuffix = "%%jit-call-stub%%"
+)
+
+// A set of compiled functions (and data), loaded into the current process'
+// memory.
+//
+// All calls into the module run on the module's own stack (the go stack is
+// too small and could move).  Hence, calls into the same module are
+// serialized.  The stack is guarded by an inaccessible page; stack overflow
+// crashes the process.
+type Module struct {
+	mutex sync.Mutex
+
+	image []byte
+	stack []byte
+
+	functions map[string]*Function
+
+	// data label -> offset relative to the beginning of the image
+	dataOffsets map[string]uint64
+}
+
+// A callable handle to a compiled function.
+type Function struct {
+	module *Module
+
+	Label string
+	Type  *ast.FunctionType
+
+	stub    uintptr
+	stubErr error
+}
+
+// Compiles the source entries and loads the resulting code into executable
+// memory (and the resulting data into read-only / writable memory).  The
+// module must be closed once it's no longer needed.
+func Compile(
+	sources []ast.SourceEntry,
+	targetPlatform platform.Platform,
+) (
+	*Module,
+	error,
+) {
+	emitter := &parseutil.Emitter{}
+	segments := analyzer.Analyze(sources, targetPlatform, emitter)
+	if emitter.HasErrors() {
+		return nil, errors.Join(emitter.Errors()...)
+	}
+
+	module := &Module{
+		functions:   map[string]*Function{},
+		dataOffsets: map[string]uint64{},
+	}
+
+	stubLabels := map[*Function]string{}
+	for _, entry := range sources {
+		funcDef, ok := entry.(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+
+		function := &Function{
+			module: module,
+			Label:  funcDef.Label,
+			Type:   funcDef.FuncType,
+		}
+		module.functions[funcDef.Label] = function
+
+		stub, err := targetPlatform.GenerateCallStub(
+			funcDef.Label+callStubLabelSuffix,
+			funcDef.Label,
+			funcDef.FuncType)
+		if err != nil {
+			// The function is still callable by other compiled functions.
+			function.stubErr = err
+			continue
+		}
+
+		segments = append(segments, stub)
+		stubLabels[function] = stub.Label
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("no function to compile")
+	}
+
+	image, err := linker.Layout(
+		targetPlatform.ByteOrder(),
+		functionAlignment,
+		os.Getpagesize(),
+		segments)
+	if err != nil {
+		return nil, err
+	}
+
+	module.image, err = mapImage(image)
+	if err != nil {
+		return nil, err
+	}
+
+	module.stack, err = mapStack(stackSize)
+	if err != nil {
+		unmap(module.image)
+		return nil, err
+	}
+
+	for function, label := range stubLabels {
+		offset, ok := image.SymbolOffset(label)
+		if !ok {
+			panic("should never happen")
+		}
+		function.stub = address(module.image, offset)
+	}
+
+	for _, symbol := range image.Symbols {
+		if !symbol.Section.IsExecutable() {
+			module.dataOffsets[symbol.Label] = symbol.Offset
+		}
+	}
+
+	return module, nil
+}
+
+// Returns the data definition's address.  The data must not be accessed after
+// the module is closed.
+func (module *Module) DataAddress(label string) (uintptr, error) {
+	offset, ok := module.dataOffsets[label]
+	if !ok {
+		return 0, fmt.Errorf("data not found: %s", label)
+	}
+
+	module.mutex.Lock()
+	defer module.mutex.Unlock()
+
+	if module.image == nil {
+		return 0, fmt.Errorf("module is closed")
+	}
+
+	return address(module.image, offset), nil
+}
+
+func (module *Module) Function(label string) (*Function, error) {
+	function, ok := module.functions[label]
+	if !ok {
+		return nil, fmt.Errorf("function not found: %s", label)
+	}
+
+	if function.stubErr != nil {
+		return nil, function.stubErr
+	}
+
+	return function, nil
+}
+
+// Releases the module's memory.  The module's functions must not be called
+// after the module is closed.
+func (module *Module) Close() error {
+	module.mutex.Lock()
+	defer module.mutex.Unlock()
+
+	if module.image == nil {
+		return nil
+	}
+
+	err := errors.Join(unmap(module.image), unmap(module.stack))
+	module.image = nil
+	module.stack = nil
+	return err
+}
+
+// Calls the function with the given arguments.  Int arguments must be go
+// integers, float arguments must be go floats, bool arguments must be go
+// bools, and pointer arguments must be go unsigned integers (e.g., uintptr).
+// Int return values are returned as int64 (signed) / uint64 (unsigned), float
+// return values are returned as float64, bool return values are returned as
+// bool, pointer return values are returned as uint64, and unit return values
+// are returned as nil.
+//
+// NOTE: the caller is responsible for keeping the memory referenced by
+// pointer arguments alive (and unmoved) for the duration of the call.
+//
+// NOTE: the compiled code runs without go preemption or gc cooperation.  The
+// calling goroutine cannot be preempted, and may delay garbage collection
+// (which must stop the world), until the call returns.  Long running
+// functions should not be called through the jit.
+//
+// NOTE: exit terminates the calling thread (via the linux exit syscall) rather
+// than returning to the caller.  Functions which may exit should not be called
+// through the jit.
+func (function *Function) Call(args ...interface{}) (interface{}, error) {
+	paramTypes := function.Type.ParameterTypes
+	if len(args) != len(paramTypes) {
+		return nil, fmt.Errorf(
+			"@%s expects %d arguments, but %d were given",
+			function.Label,
+			len(paramTypes),
+			len(args))
+	}
+
+	slots := make([]uint64, len(args)+1) // the last slot is the return value
+	for idx, arg := range args {
+		slot, err := toSlot(paramTypes[idx], arg)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"@%s argument %d: %w",
+				function.Label,
+				idx,
+				err)
+		}
+		slots[idx] = slot
+	}
+
+	module := function.module
+	module.mutex.Lock()
+	if module.image == nil {
+		module.mutex.Unlock()
+		return nil, fmt.Errorf("module is closed")
+	}
+	call(function.stub, slots, module.stack)
+	module.mutex.Unlock()
+
+	return fromSlot(function.Type.ReturnType, slots[len(args)]), nil
+}
+
+func toSlot(valueType ast.Type, arg interface{}) (uint64, error) {
+	bitSize := 8 * architecture.ByteSize(valueType)
+
+	value := reflect.ValueOf(arg)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !ast.IsIntSubType(valueType) {
+			break
+		}
+
+		val := value.Int()
+		min := int64(0)
+		max := uint64(math.MaxUint64) >> (64 - bitSize)
+		if ast.IsSignedIntSubType(valueType) {
+			max >>= 1
+			min = -int64(max) - 1
+		}
+		if val < min || (val > 0 && uint64(val) > max) {
+			return 0, fmt.Errorf("%d overflows %s", val, valueType)
+		}
+		return uint64(val), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+
+		if !ast.IsIntSubType(valueType) && !ast.IsPointerType(valueType) {
+			break
+		}
+
+		val := value.Uint()
+		maxBitSize := bitSize
+		if ast.IsSignedIntSubType(valueType) {
+			maxBitSize--
+		}
+		if maxBitSize < 64 && val >= 1<<maxBitSize {
+			return 0, fmt.Errorf("%d overflows %s", val, valueType)
+		}
+		return val, nil
+	case reflect.Float32, reflect.Float64:
+		if !ast.IsFloatSubType(valueType) {
+			break
+		}
+
+		if bitSize == 32 {
+			return uint64(math.Float32bits(float32(value.Float()))), nil
+		}
+		return math.Float64bits(value.Float()), nil
+	case reflect.Bool:
+		if !ast.IsBoolType(valueType) {
+			break
+		}
+
+		if value.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf("cannot use %v (%T) as %s", arg, arg, valueType)
+}
+
+func fromSlot(valueType ast.Type, slot uint64) interface{} {
+	if ast.IsUnitType(valueType) {
+		return nil
+	}
+
+	bitSize := 8 * architecture.ByteSize(valueType)
+	shift := 64 - bitSize
+
+	if ast.IsFloatSubType(valueType) {
+		if bitSize == 32 {
+			return float64(math.Float32frombits(uint32(slot)))
+		}
+		return math.Float64frombits(slot)
+	}
+
+	if ast.IsBoolType(valueType) {
+		return slot<<shift>>shift != 0
+	}
+
+	if ast.IsSignedIntSubType(valueType) {
+		return int64(slot<<shift) >> shift
+	}
+
+	return slot << shift >> shift
+}
@@ -0,0 +1,711 @@
+//go:build linux && amd64
+
+package jit
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/pattyshack/gt/parseutil"
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/analyzer"
+	"github.com/pattyshack/chickadee/interpreter"
+	"github.com/pattyshack/chickadee/parser"
+	"github.com/pattyshack/chickadee/platform"
+	"github.com/pattyshack/chickadee/platform/x64"
+)
+
+func compile(t *testing.T, source string) *Module {
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(source)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	module, err := Compile(entries, x64.NewPlatform(platform.Linux))
+	expect.Nil(t, err)
+	t.Cleanup(func() { module.Close() })
+
+	return module
+}
+
+// Compiles the source natively (via the jit), and analyzes the same source
+// for the reference interpreter.
+func compileWithOracle(
+	t *testing.T,
+	source string,
+) (
+	*Module,
+	*interpreter.Interpreter,
+) {
+	module := compile(t, source)
+
+	emitter := &parseutil.Emitter{}
+	entries := parser.Parse(
+		parseutil.NewBufferedByteLocationReaderFromSlice(
+			"test.chi",
+			[]byte(source)),
+		emitter)
+	expect.False(t, emitter.HasErrors())
+
+	targetPlatform := x64.NewPlatform(platform.Linux)
+	analyzer.AnalyzeSemantics(entries, targetPlatform, emitter, false)
+	expect.False(t, emitter.HasErrors())
+
+	return module, interpreter.NewInterpreter(targetPlatform, entries)
+}
+
+// Checks that the native call's result matches the interpreter's result.
+func expectSameCall(
+	t *testing.T,
+	module *Module,
+	oracle *interpreter.Interpreter,
+	label string,
+	args ...interface{},
+) {
+	function, err := module.Function(label)
+	expect.Nil(t, err)
+
+	result, err := function.Call(args...)
+	expect.Nil(t, err)
+
+	expected, err := oracle.Call(label, args...)
+	expect.Nil(t, err)
+	expect.Equal(t, expected, result, "@%s%v", label, args)
+}
+
+func expectCall(
+	t *testing.T,
+	module *Module,
+	expected interface{},
+	label string,
+	args ...interface{},
+) {
+	function, err := module.Function(label)
+	expect.Nil(t, err)
+
+	result, err := function.Call(args...)
+	expect.Nil(t, err)
+	expect.Equal(t, expected, result)
+}
+
+func TestFactorial(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @recursive_factorial(%i I32) I32 {
+  jlt :base, %i, 2
+  %s = sub %i, 1
+  %r = call @recursive_factorial(%s)
+  %x = mul %i, %r
+  ret %x
+:base
+  ret 1
+}
+
+define func @tail_factorial(%i I32) I32 {
+  %r = call @tail_factorial_helper(%i, 1)
+  ret %r
+}
+
+define func @tail_factorial_helper(%i I32, %acc I32) I32 {
+  jlt :base, %i, 2
+  %acc = mul %acc, %i
+  %i = sub %i, 1
+  %acc = call @tail_factorial_helper(%i, %acc)
+:base
+  ret %acc
+}
+
+define func @loop_factorial(%i I32) I32 {
+  %acc I32 = 1
+:loop
+  jlt :done, %i, 2
+  %acc = mul %i, %acc
+  %i = sub %i, 1
+  jmp :loop
+:done
+  ret %acc
+}
+`)
+
+	for _, label := range []string{
+		"recursive_factorial",
+		"tail_factorial",
+		"loop_factorial",
+	} {
+		expectCall(t, module, int64(1), label, 0)
+		expectCall(t, module, int64(1), label, 1)
+		expectCall(t, module, int64(120), label, 5)
+		expectCall(t, module, int64(3628800), label, 10)
+	}
+}
+
+func TestSystemVLiteArguments(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func{SystemV-lite} @mixed(
+  %a I64,
+  %b F64,
+  %c U8,
+  %d F32,
+  %e I16,
+) F64 {
+  %x = toF64 %a
+  %x = mul %x, %b
+  %y = toF64 %c
+  %x = add %x, %y
+  %z = toF64 %d
+  %x = add %x, %z
+  %w = toF64 %e
+  %x = sub %x, %w
+  ret %x
+}
+
+define func{SystemV-lite} @negate(%a I8) I8 {
+  %a = neg %a
+  ret %a
+}
+
+define func{SystemV-lite} @max(%a U64, %b U64) U64 {
+  jlt :second, %a, %b
+  ret %a
+:second
+  ret %b
+}
+`)
+
+	// 3 * 1.5 + 200 + 0.25 - (-7)
+	expectCall(
+		t,
+		module,
+		211.75,
+		"mixed",
+		3,
+		1.5,
+		uint8(200),
+		float32(0.25),
+		-7)
+
+	expectCall(t, module, int64(-5), "negate", 5)
+	expectCall(t, module, int64(127), "negate", -127)
+
+	expectCall(t, module, uint64(1<<63), "max", uint64(1<<63), uint64(5))
+}
+
+func TestStackArguments(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @sum(
+  %a1 I64,
+  %a2 I64,
+  %a3 I64,
+  %a4 I64,
+  %a5 I64,
+  %a6 I64,
+  %a7 I64,
+  %a8 I64,
+  %a9 I64,
+  %a10 I64,
+) I64 {
+  %r = add %a1, %a2
+  %r = add %r, %a3
+  %r = add %r, %a4
+  %r = add %r, %a5
+  %r = add %r, %a6
+  %r = add %r, %a7
+  %r = add %r, %a8
+  %r = add %r, %a9
+  %r = add %r, %a10
+  ret %r
+}
+`)
+
+	expectCall(t, module, int64(55), "sum", 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+}
+
+func TestInvalidArguments(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @identity(%a I8) I8 {
+  ret %a
+}
+`)
+
+	function, err := module.Function("identity")
+	expect.Nil(t, err)
+
+	_, err = function.Call()
+	expect.Error(t, err, "expects 1 arguments, but 0 were given")
+
+	_, err = function.Call(128)
+	expect.Error(t, err, "128 overflows I8")
+
+	_, err = function.Call(1.5)
+	expect.Error(t, err, "cannot use 1.5 (float64) as I8")
+
+	_, err = module.Function("missing")
+	expect.Error(t, err, "function not found: missing")
+
+	expect.Nil(t, module.Close())
+
+	_, err = function.Call(1)
+	expect.Error(t, err, "module is closed")
+}
+
+func TestBoolArguments(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @inRange(%v I64, %lo I64, %hi I64) Bool {
+  %ge = ge %v, %lo
+  %le = le %v, %hi
+  %ok = and %ge, %le
+  ret %ok
+}
+
+define func @choose(%b Bool, %x I64, %y I64) I64 {
+  jfalse :no, %b
+  ret %x
+:no
+  ret %y
+}
+
+define func @invert(%b Bool) Bool {
+  %b = not %b
+  ret %b
+}
+`)
+
+	expectCall(t, module, true, "inRange", 5, 0, 10)
+	expectCall(t, module, false, "inRange", -1, 0, 10)
+	expectCall(t, module, false, "inRange", 11, 0, 10)
+
+	expectCall(t, module, int64(1), "choose", true, 1, 2)
+	expectCall(t, module, int64(2), "choose", false, 1, 2)
+
+	expectCall(t, module, false, "invert", true)
+	expectCall(t, module, true, "invert", false)
+
+	function, err := module.Function("invert")
+	expect.Nil(t, err)
+
+	_, err = function.Call(1)
+	expect.Error(t, err, "cannot use 1 (int) as Bool")
+}
+
+const arraySource = `
+define func @squares() [4]I64 {
+  %t [4]I64 = setelem zero, 0, 0
+  %t = setelem %t, 1, 1
+  %t = setelem %t, 2, 4
+  %t = setelem %t, 3, 9
+  ret %t
+}
+
+define func @square(%i I8) I64 {
+  %t = call @squares()
+  %v = getelem %t, %i
+  ret %v
+}
+
+define func @unused_element(%i I64) I64 {
+  %t = call @squares()
+  %v = getelem %t, %i
+  ret %i
+}
+
+define func @swap(%i U16, %j U16) F64 {
+  %a [3]F64 = setelem zero, 0, 1.5
+  %a = setelem %a, 1, 2.5
+  %a = setelem %a, 2, 4.0
+  %x = getelem %a, %i
+  %y = getelem %a, %j
+  %a = setelem %a, %i, %y
+  %a = setelem %a, %j, %x
+  %r = getelem %a, 0
+  %s = getelem %a, 2
+  %r = sub %r, %s
+  ret %r
+}
+`
+
+func TestArrays(t *testing.T) {
+	module := compile(t, arraySource)
+
+	expectCall(t, module, int64(0), "square", 0)
+	expectCall(t, module, int64(4), "square", 2)
+	expectCall(t, module, int64(9), "square", 3)
+
+	expectCall(t, module, int64(3), "unused_element", 3)
+
+	expectCall(t, module, -2.5, "swap", uint16(1), uint16(1))
+	expectCall(t, module, 2.5, "swap", uint16(0), uint16(2))
+	expectCall(t, module, -1.0, "swap", uint16(1), uint16(2))
+}
+
+// Out of bound array access traps (invalid opcode) and kills the process.
+// Hence, the access is performed in a child test process.
+func TestArrayIndexOutOfBounds(t *testing.T) {
+	label := os.Getenv("JIT_OUT_OF_BOUNDS_LABEL")
+	if label != "" {
+		idx, err := strconv.Atoi(os.Getenv("JIT_OUT_OF_BOUNDS_INDEX"))
+		expect.Nil(t, err)
+
+		module := compile(t, arraySource)
+		function, err := module.Function(label)
+		expect.Nil(t, err)
+
+		function.Call(idx) // should never return
+		t.FailNow()
+	}
+
+	type testCase struct {
+		label string
+		index string
+	}
+
+	testCases := []testCase{
+		{"square", "4"},
+		{"square", "-1"},
+		{"square", "127"},
+		{"square", "-128"},
+		{"unused_element", "9"}, // the out of bound element is never used
+		{"unused_element", "-1"},
+	}
+
+	for _, test := range testCases {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestArrayIndexOutOfBounds$")
+		cmd.Env = append(
+			os.Environ(),
+			"JIT_OUT_OF_BOUNDS_LABEL="+test.label,
+			"JIT_OUT_OF_BOUNDS_INDEX="+test.index)
+
+		// NOTE: the go runtime may crash (again) while unwinding the jit stack.
+		output, err := cmd.CombinedOutput()
+		expect.NotNil(t, err)
+		expect.True(
+			t,
+			strings.Contains(string(output), "SIGILL: illegal instruction"))
+	}
+}
+
+const unusedLoadSource = `
+define func @unused_int_load(%p *I64) I64 {
+  %v = load %p
+  ret 1
+}
+
+define func @unused_float_load(%p *F64) I64 {
+  %v = load %p
+  ret 1
+}
+`
+
+// Unused loads are not elided since the load may fault on invalid address.
+// Hence, the faulting access is performed in a child test process.
+func TestUnusedLoadFault(t *testing.T) {
+	module := compile(t, unusedLoadSource)
+
+	value := 1.5
+	address := uintptr(unsafe.Pointer(&value))
+	expectCall(t, module, int64(1), "unused_int_load", address)
+	expectCall(t, module, int64(1), "unused_float_load", address)
+
+	label := os.Getenv("JIT_UNUSED_LOAD_LABEL")
+	if label != "" {
+		function, err := module.Function(label)
+		expect.Nil(t, err)
+
+		function.Call(uintptr(0)) // should never return
+		t.FailNow()
+	}
+
+	for _, label := range []string{"unused_int_load", "unused_float_load"} {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestUnusedLoadFault$")
+		cmd.Env = append(os.Environ(), "JIT_UNUSED_LOAD_LABEL="+label)
+
+		// NOTE: the go runtime converts the fault into a panic, which then crashes
+		// while unwinding the jit stack.
+		output, err := cmd.CombinedOutput()
+		expect.NotNil(t, err)
+		expect.True(
+			t,
+			strings.Contains(string(output), "runtime.sigpanic"))
+	}
+}
+
+func TestFloatComparisonsMatchInterpreter(t *testing.T) {
+	module, oracle := compileWithOracle(
+		t,
+		`
+define func @lt(%a F64, %b F64) Bool {
+  %c = lt %a, %b
+  ret %c
+}
+
+define func @le(%a F64, %b F64) Bool {
+  %c = le %a, %b
+  ret %c
+}
+
+define func @gt(%a F64, %b F64) Bool {
+  %c = gt %a, %b
+  ret %c
+}
+
+define func @ge(%a F64, %b F64) Bool {
+  %c = ge %a, %b
+  ret %c
+}
+
+define func @le32(%a F32, %b F32) Bool {
+  %c = le %a, %b
+  ret %c
+}
+`)
+
+	nan := math.NaN()
+	pairs := [][2]float64{
+		{1, 2},
+		{2, 1},
+		{1, 1},
+		{nan, 1},
+		{1, nan},
+		{nan, nan},
+		{math.Inf(-1), math.Inf(1)},
+	}
+
+	for _, label := range []string{"lt", "le", "gt", "ge", "le32"} {
+		for _, pair := range pairs {
+			expectSameCall(t, module, oracle, label, pair[0], pair[1])
+		}
+	}
+
+	expectCall(t, module, false, "lt", nan, 1.0)
+	expectCall(t, module, false, "le", nan, 1.0)
+}
+
+func TestFloatConditionalJumpsMatchInterpreter(t *testing.T) {
+	module, oracle := compileWithOracle(
+		t,
+		`
+define func @jlt(%a F64, %b F64) I64 {
+  jlt :yes, %a, %b
+  ret 1
+:yes
+  ret 2
+}
+
+define func @jge(%a F32, %b F32) I64 {
+  jge :yes, %a, %b
+  ret 1
+:yes
+  ret 2
+}
+`)
+
+	nan := math.NaN()
+	pairs := [][2]float64{
+		{1, 2},
+		{2, 1},
+		{1, 1},
+		{nan, 1},
+		{1, nan},
+		{nan, nan},
+	}
+
+	for _, label := range []string{"jlt", "jge"} {
+		for _, pair := range pairs {
+			expectSameCall(t, module, oracle, label, pair[0], pair[1])
+		}
+	}
+
+	expectCall(t, module, int64(1), "jlt", nan, 1.0)
+}
+
+func TestUnsignedInt64FloatConversionsMatchInterpreter(t *testing.T) {
+	module, oracle := compileWithOracle(
+		t,
+		`
+define func @u64ToF64(%a U64) F64 {
+  %f = toF64 %a
+  ret %f
+}
+
+define func @u64ToF32(%a U64) F32 {
+  %f = toF32 %a
+  ret %f
+}
+
+define func @f64ToU64(%a F64) U64 {
+  %i = toU64 %a
+  ret %i
+}
+
+define func @f32ToU64(%a F32) U64 {
+  %i = toU64 %a
+  ret %i
+}
+
+define func @sourceUnmodified(%a U64) U64 {
+  %f = toF64 %a
+  %i = toU64 %f
+  %i = sub %i, %a
+  ret %i
+}
+`)
+
+	ints := []uint64{
+		0,
+		1,
+		math.MaxInt64,
+		1 << 63,
+		1<<63 + 1,
+		1<<63 + 1025, // rounding depends on the lowest bit
+		math.MaxUint64 - 2048,
+		math.MaxUint64,
+	}
+
+	for _, value := range ints {
+		expectSameCall(t, module, oracle, "u64ToF64", value)
+		expectSameCall(t, module, oracle, "u64ToF32", value)
+	}
+
+	expectCall(t, module, float64(1<<64), "u64ToF64", uint64(math.MaxUint64))
+	expectCall(t, module, uint64(0), "sourceUnmodified", uint64(1<<63))
+
+	floats := []float64{
+		0,
+		1.5,
+		math.MaxInt64,
+		1 << 63,
+		1.5 * (1 << 63),
+		1<<64 - 2048,
+	}
+
+	for _, value := range floats {
+		expectSameCall(t, module, oracle, "f64ToU64", value)
+	}
+
+	// NOTE: float32(1<<64 - 2048) rounds up to 1<<64, which is out of range.
+	for _, value := range floats[:len(floats)-1] {
+		expectSameCall(t, module, oracle, "f32ToU64", float32(value))
+	}
+	expectSameCall(t, module, oracle, "f32ToU64", float32(1<<64-1<<40))
+
+	expectCall(t, module, uint64(1<<64-2048), "f64ToU64", float64(1<<64-2048))
+	expectCall(t, module, uint64(3<<62), "f32ToU64", float32(1.5*(1<<63)))
+}
+
+func TestUnitReturn(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @write(%p *I64, %v I64) {
+  store %p, %v
+  ret
+}
+
+define func{SystemV-lite} @write_lite(%p *I64, %v I64) {
+  store %p, %v
+  ret
+}
+`)
+
+	for _, label := range []string{"write", "write_lite"} {
+		value := int64(0)
+		address := uintptr(unsafe.Pointer(&value))
+		expectCall(t, module, nil, label, address, int64(42))
+		expect.Equal(t, int64(42), value, "%s", label)
+	}
+}
+
+// The lowest page of the jit stack is an inaccessible guard page.
+func TestStackGuardPage(t *testing.T) {
+	module := compile(
+		t,
+		`
+define func @one() I64 {
+  ret 1
+}
+`)
+
+	start := uintptr(unsafe.Pointer(&module.stack[0]))
+	pageSize := uintptr(os.Getpagesize())
+
+	maps, err := os.ReadFile("/proc/self/maps")
+	expect.Nil(t, err)
+
+	permissions := map[uintptr]string{}
+	for _, line := range strings.Split(string(maps), "\n") {
+		var low, high uintptr
+		var perms string
+		_, err := fmt.Sscanf(line, "%x-%x %s", &low, &high, &perms)
+		if err != nil {
+			continue
+		}
+
+		for _, page := range []uintptr{start, start + pageSize} {
+			if low <= page && page < high {
+				permissions[page] = perms[:3]
+			}
+		}
+	}
+
+	expect.Equal(t, "---", permissions[start])
+	expect.Equal(t, "rw-", permissions[start+pageSize])
+}
+
+// Unbounded recursion overflows the jit stack into the guard page.  Hence,
+// the overflow is performed in a child test process.
+func TestStackOverflow(t *testing.T) {
+	if os.Getenv("JIT_STACK_OVERFLOW") != "" {
+		module := compile(
+			t,
+			`
+define func @recurse(%i I64) I64 {
+  %i = add %i, 1
+  %r = call @recurse(%i)
+  %r = add %r, %i
+  ret %r
+}
+`)
+		function, err := module.Function("recurse")
+		expect.Nil(t, err)
+
+		function.Call(int64(0)) // should never return
+		t.FailNow()
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestStackOverflow$")
+	cmd.Env = append(os.Environ(), "JIT_STACK_OVERFLOW=1")
+
+	// NOTE: the go runtime's signal handler may crash (again) on the
+	// overflowed stack, in which case the process is killed by the signal.
+	output, err := cmd.CombinedOutput()
+	expect.NotNil(t, err)
+
+	exitErr, ok := err.(*exec.ExitError)
+	expect.True(t, ok)
+
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	expect.True(t, ok)
+	expect.True(
+		t,
+		strings.Contains(string(output), "runtime.sigpanic") ||
+			(status.Signaled() && status.Signal() == syscall.SIGSEGV))
+}
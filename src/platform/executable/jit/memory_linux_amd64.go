@@ -0,0 +1,96 @@
+package jit
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+// Links the image at a newly mapped memory region, then restricts each
+// section's access permissions (e.g., .text is read-only executable, and
+// .rodata is read-only).  Sections must be page aligned.
+func mapImage(image *linker.Image) ([]byte, error) {
+	memory, err := syscall.Mmap(
+		-1,
+		0,
+		len(image.Bytes),
+		syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+
+	err = image.Link(uint64(uintptr(unsafe.Pointer(&memory[0]))))
+	if err != nil {
+		syscall.Munmap(memory)
+		return nil, err
+	}
+
+	copy(memory, image.Bytes)
+
+	for _, section := range image.Sections {
+		protection := syscall.PROT_READ
+		if section.Kind.IsExecutable() {
+			protection |= syscall.PROT_EXEC
+		}
+		if section.Kind.IsWritable() {
+			protection |= syscall.PROT_WRITE
+		}
+
+		// NOTE: the protected range is rounded up to the page boundary.
+		err = syscall.Mprotect(
+			memory[section.Offset:section.Offset+section.Size],
+			protection)
+		if err != nil {
+			syscall.Munmap(memory)
+			return nil, err
+		}
+	}
+
+	return memory, nil
+}
+
+// Maps the stack with an extra inaccessible guard page below the stack's
+// usable region.  Stack overflow faults on the guard page rather than
+// silently corrupting the adjacent memory.  The stack grows downward from the
+// end of the returned memory.
+func mapStack(size int) ([]byte, error) {
+	pageSize := syscall.Getpagesize()
+
+	memory, err := syscall.Mmap(
+		-1,
+		0,
+		pageSize+size,
+		syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Mprotect(memory[:pageSize], syscall.PROT_NONE)
+	if err != nil {
+		syscall.Munmap(memory)
+		return nil, err
+	}
+
+	return memory, nil
+}
+
+func unmap(memory []byte) error {
+	return syscall.Munmap(memory)
+}
+
+func address(memory []byte, offset uint64) uintptr {
+	return uintptr(unsafe.Pointer(&memory[0])) + uintptr(offset)
+}
+
+func call(stub uintptr, slots []uint64, stack []byte) {
+	callStub(
+		stub,
+		unsafe.Pointer(&slots[0]),
+		address(stack, uint64(len(stack))))
+}
+
+//go:noescape
+func callStub(stub uintptr, slots unsafe.Pointer, stackTop uintptr)
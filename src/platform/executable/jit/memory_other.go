@@ -0,0 +1,35 @@
+//go:build !(linux && amd64)
+
+package jit
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/pattyshack/chickadee/platform/executable/linker"
+)
+
+var errUnsupported = fmt.Errorf(
+	"jit is not supported on %s/%s",
+	runtime.GOOS,
+	runtime.GOARCH)
+
+func mapImage(image *linker.Image) ([]byte, error) {
+	return nil, errUnsupported
+}
+
+func mapStack(size int) ([]byte, error) {
+	return nil, errUnsupported
+}
+
+func unmap(memory []byte) error {
+	return errUnsupported
+}
+
+func address(memory []byte, offset uint64) uintptr {
+	panic("should never happen")
+}
+
+func call(stub uintptr, slots []uint64, stack []byte) {
+	panic("should never happen")
+}
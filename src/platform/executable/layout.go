@@ -18,6 +18,41 @@ const (
 	Abs64Relocation = RelocationKind("abs64")
 )
 
+// Where a labelled segment is placed within the executable / object file.
+type SectionKind string
+
+const (
+	// Executable instructions (.text).
+	TextSection = SectionKind("text")
+
+	// Read-only data (.rodata).
+	ReadOnlyDataSection = SectionKind("rodata")
+
+	// Mutable initialized data (.data).
+	DataSection = SectionKind("data")
+
+	// Mutable zero-initialized data (.bss).  The segment's bytes must be zeros.
+	ZeroDataSection = SectionKind("bss")
+)
+
+// All section kinds, in layout order.
+var SectionKinds = []SectionKind{
+	TextSection,
+	ReadOnlyDataSection,
+	DataSection,
+	ZeroDataSection,
+}
+
+// Returns true if the section's memory must be executable.
+func (kind SectionKind) IsExecutable() bool {
+	return kind == TextSection
+}
+
+// Returns true if the section's memory must be writable.
+func (kind SectionKind) IsWritable() bool {
+	return kind == DataSection || kind == ZeroDataSection
+}
+
 type SegmentLabel struct {
 	Name    string
 	IsLocal bool // true for block label
@@ -29,6 +64,10 @@ type Relocation struct {
 	Offset int // relative to the beginning of the segment
 
 	Label SegmentLabel
+
+	// The relocation target's offset relative to the label (e.g., a block's
+	// offset within a function segment).
+	Addend int64
 }
 
 // A continuous segment of instruction bytes.
@@ -36,3 +75,33 @@ type Segment struct {
 	Bytes       []byte
 	Relocations []Relocation
 }
+
+// Appends other's bytes to the end of the segment.  other's relocations are
+// shifted accordingly.
+func (segment *Segment) Append(other Segment) {
+	offset := len(segment.Bytes)
+	segment.Bytes = append(segment.Bytes, other.Bytes...)
+	for _, reloc := range other.Relocations {
+		reloc.Offset += offset
+		segment.Relocations = append(segment.Relocations, reloc)
+	}
+}
+
+// A globally labelled (e.g., function or data) segment.  Local labels (e.g.,
+// block labels) are only visible to the segment's own relocations.
+type LabelledSegment struct {
+	Label string
+
+	// The segment is placed in the text section when unspecified.
+	Section SectionKind
+
+	// Internal segments (e.g., jump tables) are compiler generated, and are
+	// only referenced by their generating function.  Their labels are not
+	// exported from object files.
+	IsInternal bool
+
+	Segment
+
+	// local label -> offset relative to the beginning of the segment
+	LocalLabels map[string]int
+}
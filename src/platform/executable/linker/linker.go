@@ -0,0 +1,305 @@
+package linker
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+// A globally labelled segment's location within the image.
+type Symbol struct {
+	Label string
+
+	Section executable.SectionKind
+
+	Offset uint64 // relative to the beginning of the image
+	Size   uint64
+
+	IsInternal bool // see executable.LabelledSegment
+}
+
+// A continuous range of the image which holds all segments of the same
+// section kind.
+type Section struct {
+	Kind executable.SectionKind
+
+	Offset uint64 // relative to the beginning of the image
+	Size   uint64
+}
+
+// A relocation which must be resolved by a later link step (e.g., by the
+// system linker).
+type Relocation struct {
+	Kind executable.RelocationKind
+
+	Offset uint64 // relative to the beginning of the image
+
+	// When empty, the relocation target is relative to the beginning of the
+	// image.  Otherwise, the relocation target is relative to the symbol.
+	Symbol string
+
+	// The relocation target's offset relative to the symbol (or the beginning
+	// of the image).  Note that the addend does not include rel32's implicit
+	// -4 adjustment.
+	Addend int64
+}
+
+// A continuous sequence of segments, grouped into sections.
+type Image struct {
+	Bytes []byte
+
+	Symbols []Symbol
+
+	// Non-empty sections, in executable.SectionKinds order.
+	Sections []Section
+
+	byteOrder binary.ByteOrder
+
+	segments      []executable.LabelledSegment
+	symbolOffsets map[string]uint64
+}
+
+// Lays out the segments into a single image.  Segments are grouped by
+// section kind (in executable.SectionKinds order), and retain their relative
+// order within each section.  Each section is aligned to the section
+// alignment (e.g., the page size), and each segment is aligned to the given
+// alignment (both relative to the beginning of the image).  Gaps are padded
+// with zeros.  Relocations are not patched until the image is linked.
+func Layout(
+	byteOrder binary.ByteOrder,
+	alignment int,
+	sectionAlignment int,
+	segments []executable.LabelledSegment,
+) (
+	*Image,
+	error,
+) {
+	if alignment < 1 {
+		return nil, fmt.Errorf("invalid alignment: %d", alignment)
+	}
+
+	if sectionAlignment < 1 || sectionAlignment%alignment != 0 {
+		return nil, fmt.Errorf("invalid section alignment: %d", sectionAlignment)
+	}
+
+	grouped := map[executable.SectionKind][]executable.LabelledSegment{}
+	errs := []error{}
+	for _, segment := range segments {
+		if segment.Section == "" {
+			segment.Section = executable.TextSection
+		}
+
+		if !slices.Contains(executable.SectionKinds, segment.Section) {
+			errs = append(
+				errs,
+				fmt.Errorf(
+					"unsupported section kind (%s) for %s",
+					segment.Section,
+					segment.Label))
+			continue
+		}
+
+		grouped[segment.Section] = append(grouped[segment.Section], segment)
+	}
+
+	image := &Image{
+		Bytes:         []byte{},
+		Symbols:       make([]Symbol, 0, len(segments)),
+		byteOrder:     byteOrder,
+		segments:      make([]executable.LabelledSegment, 0, len(segments)),
+		symbolOffsets: make(map[string]uint64, len(segments)),
+	}
+
+	pad := func(alignment int) {
+		padding := (alignment - len(image.Bytes)%alignment) % alignment
+		image.Bytes = append(image.Bytes, make([]byte, padding)...)
+	}
+
+	for _, kind := range executable.SectionKinds {
+		sectionSegments := grouped[kind]
+		if len(sectionSegments) == 0 {
+			continue
+		}
+
+		pad(sectionAlignment)
+		sectionOffset := uint64(len(image.Bytes))
+
+		for _, segment := range sectionSegments {
+			_, ok := image.symbolOffsets[segment.Label]
+			if ok {
+				errs = append(errs, fmt.Errorf("duplicate symbol: %s", segment.Label))
+				continue
+			}
+
+			pad(alignment)
+
+			offset := uint64(len(image.Bytes))
+			image.Bytes = append(image.Bytes, segment.Bytes...)
+
+			image.segments = append(image.segments, segment)
+			image.symbolOffsets[segment.Label] = offset
+			image.Symbols = append(
+				image.Symbols,
+				Symbol{
+					Label:      segment.Label,
+					Section:    kind,
+					Offset:     offset,
+					Size:       uint64(len(segment.Bytes)),
+					IsInternal: segment.IsInternal,
+				})
+		}
+
+		image.Sections = append(
+			image.Sections,
+			Section{
+				Kind:   kind,
+				Offset: sectionOffset,
+				Size:   uint64(len(image.Bytes)) - sectionOffset,
+			})
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return image, nil
+}
+
+// Returns the symbol's offset relative to the beginning of the image.
+func (image *Image) SymbolOffset(label string) (uint64, bool) {
+	offset, ok := image.symbolOffsets[label]
+	return offset, ok
+}
+
+// Patches all relocations in place, assuming the image is loaded at the given
+// base address.  All referenced symbols must be defined within the image.
+func (image *Image) Link(baseAddress uint64) error {
+	unresolved, err := image.resolve(baseAddress, true)
+	if err != nil {
+		return err
+	}
+
+	if len(unresolved) > 0 {
+		panic("should never happen")
+	}
+
+	return nil
+}
+
+// Patches relocations which are independent of the image's final address
+// (i.e., rel32 relocations to local labels).  All other relocations are
+// returned for a later link step.  Undefined global symbols are not errors.
+func (image *Image) PartialLink() ([]Relocation, error) {
+	return image.resolve(0, false)
+}
+
+func (image *Image) resolve(
+	baseAddress uint64,
+	resolveAll bool,
+) (
+	[]Relocation,
+	error,
+) {
+	unresolved := []Relocation{}
+	errs := []error{}
+	for _, segment := range image.segments {
+		segmentOffset := image.symbolOffsets[segment.Label]
+
+		for _, reloc := range segment.Relocations {
+			offset := segmentOffset + uint64(reloc.Offset)
+
+			var target uint64
+			if reloc.Label.IsLocal {
+				localOffset, ok := segment.LocalLabels[reloc.Label.Name]
+				if !ok {
+					errs = append(
+						errs,
+						fmt.Errorf(
+							"undefined local label (%s) in %s",
+							reloc.Label.Name,
+							segment.Label))
+					continue
+				}
+
+				target = segmentOffset + uint64(localOffset) + uint64(reloc.Addend)
+
+				if !resolveAll && reloc.Kind != executable.Rel32Relocation {
+					unresolved = append(
+						unresolved,
+						Relocation{
+							Kind:   reloc.Kind,
+							Offset: offset,
+							Addend: int64(target),
+						})
+					continue
+				}
+			} else {
+				if !resolveAll {
+					unresolved = append(
+						unresolved,
+						Relocation{
+							Kind:   reloc.Kind,
+							Offset: offset,
+							Symbol: reloc.Label.Name,
+							Addend: reloc.Addend,
+						})
+					continue
+				}
+
+				var ok bool
+				target, ok = image.symbolOffsets[reloc.Label.Name]
+				if !ok {
+					errs = append(
+						errs,
+						fmt.Errorf(
+							"undefined symbol (%s) referenced by %s",
+							reloc.Label.Name,
+							segment.Label))
+					continue
+				}
+
+				target += uint64(reloc.Addend)
+			}
+
+			err := image.patch(reloc, offset, target, baseAddress)
+			if err != nil {
+				errs = append(
+					errs,
+					fmt.Errorf("%w (%s in %s)", err, reloc.Label.Name, segment.Label))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return unresolved, nil
+}
+
+// offset and target are relative to the beginning of the image.
+func (image *Image) patch(
+	reloc executable.Relocation,
+	offset uint64,
+	target uint64,
+	baseAddress uint64,
+) error {
+	switch reloc.Kind {
+	case executable.Rel32Relocation:
+		relative := int64(target) - int64(offset+4)
+		if relative < math.MinInt32 || relative > math.MaxInt32 {
+			return fmt.Errorf("rel32 relocation overflow")
+		}
+		image.byteOrder.PutUint32(image.Bytes[offset:], uint32(relative))
+	case executable.Abs64Relocation:
+		image.byteOrder.PutUint64(image.Bytes[offset:], baseAddress+target)
+	default:
+		return fmt.Errorf("unsupported relocation kind: %s", reloc.Kind)
+	}
+
+	return nil
+}
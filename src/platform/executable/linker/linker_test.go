@@ -0,0 +1,370 @@
+package linker
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/pattyshack/gt/testing/expect"
+
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+const (
+	testAlignment        = 8
+	testSectionAlignment = 16
+)
+
+func reference(
+	kind executable.RelocationKind,
+	offset int,
+	name string,
+	isLocal bool,
+	addend int64,
+) executable.Relocation {
+	return executable.Relocation{
+		Kind:   kind,
+		Offset: offset,
+		Label: executable.SegmentLabel{
+			Name:    name,
+			IsLocal: isLocal,
+		},
+		Addend: addend,
+	}
+}
+
+func textSegment(
+	label string,
+	size int,
+	relocations ...executable.Relocation,
+) executable.LabelledSegment {
+	return executable.LabelledSegment{
+		Label: label,
+		Segment: executable.Segment{
+			Bytes:       make([]byte, size),
+			Relocations: relocations,
+		},
+		LocalLabels: map[string]int{"block": 8},
+	}
+}
+
+func dataSegment(label string) executable.LabelledSegment {
+	return executable.LabelledSegment{
+		Label:   label,
+		Section: executable.DataSection,
+		Segment: executable.Segment{
+			Bytes: make([]byte, 8),
+		},
+	}
+}
+
+func layout(
+	t *testing.T,
+	segments ...executable.LabelledSegment,
+) *Image {
+	image, err := Layout(
+		binary.LittleEndian,
+		testAlignment,
+		testSectionAlignment,
+		segments)
+	expect.Nil(t, err)
+	return image
+}
+
+// f is placed at the beginning of the text section, and g is placed at the
+// beginning of the data section (offset 32).
+func addendSegments() []executable.LabelledSegment {
+	return []executable.LabelledSegment{
+		textSegment(
+			"f",
+			32,
+			reference(executable.Rel32Relocation, 0, "block", true, 2),
+			reference(executable.Abs64Relocation, 8, "g", false, 3),
+			reference(executable.Rel32Relocation, 16, "g", false, -1),
+			reference(executable.Abs64Relocation, 24, "block", true, 1)),
+		dataSegment("g"),
+	}
+}
+
+func TestLayout(t *testing.T) {
+	image := layout(t, addendSegments()...)
+
+	expect.Equal(t, 40, len(image.Bytes))
+	expect.Equal(
+		t,
+		[]Symbol{
+			{Label: "f", Section: executable.TextSection, Offset: 0, Size: 32},
+			{Label: "g", Section: executable.DataSection, Offset: 32, Size: 8},
+		},
+		image.Symbols)
+	expect.Equal(
+		t,
+		[]Section{
+			{Kind: executable.TextSection, Offset: 0, Size: 32},
+			{Kind: executable.DataSection, Offset: 32, Size: 8},
+		},
+		image.Sections)
+
+	offset, ok := image.SymbolOffset("g")
+	expect.True(t, ok)
+	expect.Equal(t, uint64(32), offset)
+
+	_, ok = image.SymbolOffset("h")
+	expect.False(t, ok)
+}
+
+func TestLinkAddends(t *testing.T) {
+	image := layout(t, addendSegments()...)
+
+	baseAddress := uint64(0x400000)
+	err := image.Link(baseAddress)
+	expect.Nil(t, err)
+
+	// local: block (8) + 2 - (0 + 4)
+	expect.Equal(t, uint32(6), binary.LittleEndian.Uint32(image.Bytes[0:]))
+	// global: g (32) + 3
+	expect.Equal(
+		t,
+		baseAddress+35,
+		binary.LittleEndian.Uint64(image.Bytes[8:]))
+	// global: g (32) - 1 - (16 + 4)
+	expect.Equal(t, uint32(11), binary.LittleEndian.Uint32(image.Bytes[16:]))
+	// local: block (8) + 1
+	expect.Equal(
+		t,
+		baseAddress+9,
+		binary.LittleEndian.Uint64(image.Bytes[24:]))
+}
+
+func TestPartialLinkAddends(t *testing.T) {
+	segments := addendSegments()
+	segments[0].Relocations = append(
+		segments[0].Relocations,
+		reference(executable.Rel32Relocation, 28, "undefined", false, 5))
+	image := layout(t, segments...)
+
+	unresolved, err := image.PartialLink()
+	expect.Nil(t, err)
+
+	// Only the local rel32 relocation is patched.
+	expect.Equal(t, uint32(6), binary.LittleEndian.Uint32(image.Bytes[0:]))
+	expect.Equal(t, uint64(0), binary.LittleEndian.Uint64(image.Bytes[8:]))
+
+	// Local relocations' addends are relative to the beginning of the image.
+	// Undefined global symbols are not errors.
+	expect.Equal(
+		t,
+		[]Relocation{
+			{
+				Kind:   executable.Abs64Relocation,
+				Offset: 8,
+				Symbol: "g",
+				Addend: 3,
+			},
+			{
+				Kind:   executable.Rel32Relocation,
+				Offset: 16,
+				Symbol: "g",
+				Addend: -1,
+			},
+			{
+				Kind:   executable.Abs64Relocation,
+				Offset: 24,
+				Addend: 9,
+			},
+			{
+				Kind:   executable.Rel32Relocation,
+				Offset: 28,
+				Symbol: "undefined",
+				Addend: 5,
+			},
+		},
+		unresolved)
+}
+
+func TestLayoutErrors(t *testing.T) {
+	type testCase struct {
+		name             string
+		alignment        int
+		sectionAlignment int
+		segments         []executable.LabelledSegment
+		expectedError    string
+	}
+
+	testCases := []testCase{
+		{
+			name:             "invalid alignment",
+			alignment:        0,
+			sectionAlignment: testSectionAlignment,
+			expectedError:    "invalid alignment: 0",
+		},
+		{
+			name:             "invalid section alignment",
+			alignment:        testAlignment,
+			sectionAlignment: 12,
+			expectedError:    "invalid section alignment: 12",
+		},
+		{
+			name:             "duplicate symbol",
+			alignment:        testAlignment,
+			sectionAlignment: testSectionAlignment,
+			segments: []executable.LabelledSegment{
+				textSegment("f", 8),
+				dataSegment("g"),
+				textSegment("f", 8),
+			},
+			expectedError: "duplicate symbol: f",
+		},
+		{
+			name:             "duplicate symbol across sections",
+			alignment:        testAlignment,
+			sectionAlignment: testSectionAlignment,
+			segments: []executable.LabelledSegment{
+				textSegment("f", 8),
+				dataSegment("f"),
+			},
+			expectedError: "duplicate symbol: f",
+		},
+		{
+			name:             "unsupported section",
+			alignment:        testAlignment,
+			sectionAlignment: testSectionAlignment,
+			segments: []executable.LabelledSegment{
+				{
+					Label:   "f",
+					Section: executable.SectionKind("tls"),
+				},
+			},
+			expectedError: "unsupported section kind (tls) for f",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			image, err := Layout(
+				binary.LittleEndian,
+				test.alignment,
+				test.sectionAlignment,
+				test.segments)
+			expect.Nil(t, image)
+			expect.Error(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestLinkErrors(t *testing.T) {
+	type testCase struct {
+		name          string
+		relocation    executable.Relocation
+		expectedError string
+
+		// Errors reported by PartialLink.  Empty if PartialLink succeeds.
+		expectedPartialLinkError string
+	}
+
+	testCases := []testCase{
+		{
+			name: "undefined symbol",
+			relocation: reference(
+				executable.Rel32Relocation,
+				0,
+				"undefined",
+				false,
+				0),
+			expectedError: "undefined symbol (undefined) referenced by f",
+		},
+		{
+			name: "undefined local label",
+			relocation: reference(
+				executable.Rel32Relocation,
+				0,
+				"undefined",
+				true,
+				0),
+			expectedError:            "undefined local label (undefined) in f",
+			expectedPartialLinkError: "undefined local label (undefined) in f",
+		},
+		{
+			name: "local rel32 overflow",
+			relocation: reference(
+				executable.Rel32Relocation,
+				0,
+				"block",
+				true,
+				math.MaxInt32),
+			expectedError:            "rel32 relocation overflow (block in f)",
+			expectedPartialLinkError: "rel32 relocation overflow (block in f)",
+		},
+		{
+			name: "local negative rel32 overflow",
+			relocation: reference(
+				executable.Rel32Relocation,
+				0,
+				"block",
+				true,
+				math.MinInt32-8),
+			expectedError:            "rel32 relocation overflow (block in f)",
+			expectedPartialLinkError: "rel32 relocation overflow (block in f)",
+		},
+		{
+			name: "global rel32 overflow",
+			relocation: reference(
+				executable.Rel32Relocation,
+				0,
+				"g",
+				false,
+				math.MaxInt32),
+			expectedError: "rel32 relocation overflow (g in f)",
+		},
+		{
+			name: "unsupported relocation",
+			relocation: reference(
+				executable.RelocationKind("rel8"),
+				0,
+				"g",
+				false,
+				0),
+			expectedError: "unsupported relocation kind: rel8 (g in f)",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			segments := []executable.LabelledSegment{
+				textSegment("f", 16, test.relocation),
+				dataSegment("g"),
+			}
+
+			err := layout(t, segments...).Link(0)
+			expect.Error(t, err, test.expectedError)
+
+			image := layout(t, segments...)
+			unresolved, err := image.PartialLink()
+			if test.expectedPartialLinkError == "" {
+				expect.Nil(t, err)
+				expect.Equal(t, 1, len(unresolved))
+			} else {
+				expect.Nil(t, unresolved)
+				expect.Error(t, err, test.expectedPartialLinkError)
+			}
+		})
+	}
+}
+
+func TestLinkReportsAllErrors(t *testing.T) {
+	image := layout(
+		t,
+		textSegment(
+			"f",
+			16,
+			reference(executable.Rel32Relocation, 0, "undefined1", false, 0),
+			reference(executable.Rel32Relocation, 4, "block", true, 0)),
+		textSegment(
+			"h",
+			16,
+			reference(executable.Abs64Relocation, 0, "undefined2", false, 0)))
+
+	err := image.Link(0)
+	expect.Error(t, err, "undefined symbol (undefined1) referenced by f")
+	expect.Error(t, err, "undefined symbol (undefined2) referenced by h")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pattyshack/chickadee/architecture"
 	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform/executable"
 )
 
 type ArchitectureName string
@@ -34,4 +35,39 @@ type Platform interface {
 	) *architecture.InstructionConstraints
 
 	CanEncodeImmediate(ast.Value) bool
+
+	// Lowers the allocated function's operations into machine code.  The
+	// function's blocks must be in the allocator's final layout order.  The
+	// first returned segment is the function's code segment; the rest are
+	// auxiliary data segments (e.g., jump tables) referenced by the code.
+	GenerateCode(
+		*ast.FunctionDefinition,
+		*architecture.StackFrame,
+		map[*ast.Block][]architecture.Operation,
+	) []executable.LabelledSegment
+
+	// Generates the process entry point stub, which calls the entry function
+	// with the given constant arguments and exits the process using the entry
+	// function's return value as exit status.
+	GenerateStartStub(
+		entryLabel string,
+		entryType *ast.FunctionType,
+		args []int64,
+	) (
+		executable.LabelledSegment,
+		error,
+	)
+
+	// Generates a SystemV-lite stub which calls the entry function on behalf
+	// of a foreign host (e.g., the jit).  The stub takes a single pointer to a
+	// list of register sized slots: one slot per entry function argument,
+	// followed by the return value slot.
+	GenerateCallStub(
+		stubLabel string,
+		entryLabel string,
+		entryType *ast.FunctionType,
+	) (
+		executable.LabelledSegment,
+		error,
+	)
 }
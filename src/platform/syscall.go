@@ -62,12 +62,7 @@ func (LinuxSysCallTypeSpec) MaxNumberOfArgs() int {
 }
 
 func (LinuxSysCallTypeSpec) IsValidArgType(argType ast.Type) bool {
-	if ast.IsIntSubType(argType) {
-		return true
-	}
-
-	// TODO pointer
-	return false
+	return ast.IsIntSubType(argType) || ast.IsPointerType(argType)
 }
 
 func (LinuxSysCallTypeSpec) IsValidExitArgType(argType ast.Type) bool {
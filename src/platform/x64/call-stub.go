@@ -0,0 +1,153 @@
+package x64
+
+import (
+	"fmt"
+
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+// The call stub is a SystemV-lite function which takes a single pointer
+// argument (in rdi) to a list of register sized slots: one slot per entry
+// function argument, followed by the return value slot.  The stub copies the
+// arguments from the slots into the entry function's call convention
+// locations, calls the entry function, then writes the return value into the
+// return value slot.  The return value slot is left untouched for unit return
+// type.
+//
+// The stub does not preserve any register other than the stack pointer.  The
+// host (e.g., the jit) is responsible for saving registers it cares about.
+func (p Platform) GenerateCallStub(
+	stubLabel string,
+	entryLabel string,
+	entryType *ast.FunctionType,
+) (
+	executable.LabelledSegment,
+	error,
+) {
+	isSupported := func(valueType ast.Type) bool {
+		return ast.IsIntSubType(valueType) ||
+			ast.IsFloatSubType(valueType) ||
+			ast.IsBoolType(valueType) ||
+			ast.IsPointerType(valueType)
+	}
+
+	for idx, paramType := range entryType.ParameterTypes {
+		if !isSupported(paramType) {
+			return executable.LabelledSegment{}, fmt.Errorf(
+				"entry function (@%s) argument %d has unsupported type (%s)",
+				entryLabel,
+				idx,
+				paramType)
+		}
+	}
+
+	if !isSupported(entryType.ReturnType) &&
+		!ast.IsUnitType(entryType.ReturnType) {
+
+		return executable.LabelledSegment{}, fmt.Errorf(
+			"entry function (@%s) has unsupported return type (%s)",
+			entryLabel,
+			entryType.ReturnType)
+	}
+
+	constraints := p.CallConvention(entryType).CallConstraints
+	layout := newStubCallLayout(constraints, entryType)
+
+	gen := &codeGenerator{
+		LabelledSegment: executable.LabelledSegment{
+			Label:       stubLabel,
+			LocalLabels: map[string]int{},
+		},
+	}
+
+	// The slots pointer is saved right below the caller's return address.
+	// Note that the stack pointer is not stack frame aligned on entry due to
+	// the return address.
+	slotsOffset := int32(layout.stackSize)
+	frameSize := uint64(layout.stackSize + registerSize)
+	gen.Append(subIntImmediate(64, rsp, frameSize))
+	gen.Append(storeInt(64, rsp, slotsOffset, rdi))
+
+	// rdi may be used by the entry function's call convention.  Stack sources
+	// are populated first (using rax as scratch), followed by register
+	// sources, and rdi (if used) is populated last.
+	sources := constraints.Sources[1:] // skip func value
+	for idx, src := range sources {
+		if src.RequireOnStack {
+			gen.Append(loadInt(64, rax, rdi, int32(idx*registerSize)))
+			gen.Append(storeInt(64, rsp, layout.sourceOffsets[idx], rax))
+		}
+	}
+
+	var rdiSlot *int32
+	for idx, src := range sources {
+		if src.RequireOnStack {
+			continue
+		}
+
+		slot := int32(idx * registerSize)
+		reg := src.Registers[0].Require
+		if reg == rdi {
+			rdiSlot = &slot
+			continue
+		}
+
+		gen.loadSlot(reg, rdi, slot)
+	}
+
+	if rdiSlot != nil {
+		gen.Append(loadInt(64, rdi, rdi, *rdiSlot))
+	}
+
+	gen.Append(callRel(entryLabel))
+
+	returnSlot := int32(len(sources) * registerSize)
+	if ast.IsUnitType(entryType.ReturnType) {
+		// Nothing to write back.
+	} else if constraints.Destination.RequireOnStack {
+		gen.Append(loadInt(64, rdi, rsp, slotsOffset))
+		gen.Append(loadInt(64, rax, rsp, layout.destinationOffset))
+		gen.Append(storeInt(64, rdi, returnSlot, rax))
+	} else {
+		dest := constraints.Destination.Registers[0].Require
+
+		slots := rdi
+		if dest == rdi {
+			slots = rsi
+		}
+
+		gen.Append(loadInt(64, slots, rsp, slotsOffset))
+		gen.storeSlot(slots, returnSlot, dest)
+	}
+
+	gen.Append(addIntImmediate(64, rsp, frameSize))
+	gen.Append(executable.Segment{Bytes: ret})
+
+	return gen.LabelledSegment, nil
+}
+
+func (gen *codeGenerator) loadSlot(
+	dest *arch.Register,
+	slots *arch.Register,
+	displacement int32,
+) {
+	if dest.AllowGeneralOp {
+		gen.Append(loadInt(64, dest, slots, displacement))
+	} else {
+		gen.Append(loadFloat(dest, slots, displacement))
+	}
+}
+
+func (gen *codeGenerator) storeSlot(
+	slots *arch.Register,
+	displacement int32,
+	src *arch.Register,
+) {
+	if src.AllowGeneralOp {
+		gen.Append(storeInt(64, slots, displacement, src))
+	} else {
+		gen.Append(storeFloat(slots, displacement, src))
+	}
+}
@@ -22,18 +22,29 @@ func newCallSpecs() *platform.CallSpecs {
 			NumCallerSavedFloat:   8,
 		},
 		internalCalleeSavedCallSpec{},
+		// As the name implies, every general / float register (other than the
+		// frame pointer and r15) is caller saved.
+		//
+		// NOTE: NumGeneral must not exceed len(RegisterSet.General)-2 (see
+		// internalCallSpec).  Also, callee-saved argument registers (i.e.,
+		// NumCallerSavedGeneral < NumGeneral) are not usable at the call site,
+		// since the register allocator runs out of evictable registers while
+		// reducing register pressure.
 		internalCallSpec{
 			name:                  ast.InternalCallerSavedCallConvention,
-			NumGeneral:            14,
+			NumGeneral:            13,
 			NumFloat:              16,
-			NumCallerSavedGeneral: 1,
-			NumCallerSavedFloat:   0,
+			NumCallerSavedGeneral: 13,
+			NumCallerSavedFloat:   16,
 		},
 		systemVLiteCallSpec{})
 }
 
-// All arguments and destination are pass via stack.  All registers are callee
-// saved.
+// All arguments and destination are pass via stack.  All registers, except
+// the function location value register, are callee saved.
+//
+// NOTE: The function location value register is not a callee's (pseudo)
+// parameter, and hence the callee is not responsible for preserving it.
 type internalCalleeSavedCallSpec struct {
 	platform.InternalCallTypeSpec
 }
@@ -45,16 +56,22 @@ func (internalCalleeSavedCallSpec) Name() ast.CallConventionName {
 func (internalCalleeSavedCallSpec) CallConvention(
 	funcType *ast.FunctionType,
 ) *architecture.CallConvention {
-	convention := architecture.NewCallConvention(false, RegisterSet.General[1])
+	convention := architecture.NewCallConvention(true, RegisterSet.General[1])
 	convention.SetFramePointerRegister(RegisterSet.General[0])
 
-	convention.CalleeSaved(RegisterSet.General...)
+	convention.CalleeSaved(RegisterSet.General[2:]...)
 	convention.CalleeSaved(RegisterSet.Float...)
 
 	for _, paramType := range funcType.ParameterTypes {
 		convention.AddStackSource(paramType)
 	}
-	convention.SetStackDestination(funcType.ReturnType)
+
+	if architecture.NumRegisters(funcType.ReturnType) == 0 {
+		// Zero-sized return value (e.g., unit) occupies no space.
+		convention.SetRegisterDestination()
+	} else {
+		convention.SetStackDestination(funcType.ReturnType)
+	}
 
 	return convention
 }
@@ -101,7 +118,9 @@ func (spec internalCallSpec) Name() ast.CallConventionName {
 // The first NumFloat float registers are usable for float/data arguments.
 //
 // The same set of NumGeneral general registers and NumFloat float registers
-// are usable for the return value.
+// are usable for the return value.  Multiple return values are returned as a
+// single tuple, with each element assigned to the remaining registers in order
+// (the tuple is returned on stack if it does not fit).
 func (spec internalCallSpec) CallConvention(
 	funcType *ast.FunctionType,
 ) *architecture.CallConvention {
@@ -191,6 +210,9 @@ type internalCallRegisterPicker struct {
 
 // This return nil if the value should be on memory, empty list if the value
 // occupies no space, or a non-empty list if value fits in memory.
+//
+// Each register sized chunk prefers a register from its register class's
+// pool, and falls back to the other pool when the preferred pool is exhausted.
 func (picker *internalCallRegisterPicker) Pick(
 	valueType ast.Type,
 	numNeeded int,
@@ -203,29 +225,32 @@ func (picker *internalCallRegisterPicker) Pick(
 		return []*architecture.Register{}
 	}
 
-	if ast.IsIntSubType(valueType) || ast.IsFunctionType(valueType) {
-		if len(picker.availableGeneral) > 0 {
-			result := []*architecture.Register{picker.availableGeneral[0]}
-			picker.availableGeneral = picker.availableGeneral[1:]
-			return result
+	classes := getRegisterClasses(valueType)
+	if classes == nil { // too large to fit in registers
+		return nil
+	}
+
+	if len(classes) != numNeeded {
+		panic("should never happen")
+	}
+
+	result := make([]*architecture.Register, 0, numNeeded)
+	for _, isFloat := range classes {
+		preferred := &picker.availableGeneral
+		fallback := &picker.availableFloat
+		if isFloat {
+			preferred, fallback = fallback, preferred
 		}
 
-		result := []*architecture.Register{picker.availableFloat[0]}
-		picker.availableFloat = picker.availableFloat[1:]
-		return result
-	} else if ast.IsFloatSubType(valueType) {
-		if len(picker.availableFloat) > 0 {
-			result := []*architecture.Register{picker.availableFloat[0]}
-			picker.availableFloat = picker.availableFloat[1:]
-			return result
+		if len(*preferred) == 0 {
+			preferred = fallback
 		}
 
-		result := []*architecture.Register{picker.availableGeneral[0]}
-		picker.availableGeneral = picker.availableGeneral[1:]
-		return result
-	} else {
-		panic("unhandled type")
+		result = append(result, (*preferred)[0])
+		*preferred = (*preferred)[1:]
 	}
+
+	return result
 }
 
 type systemVLiteCallSpec struct {
@@ -283,7 +308,10 @@ func (systemVLiteCallSpec) CallConvention(
 		}
 	}
 
-	if ast.IsFloatSubType(funcType.ReturnType) {
+	if ast.IsUnitType(funcType.ReturnType) {
+		// unit (void) return value occupies no register.
+		convention.SetRegisterDestination()
+	} else if ast.IsFloatSubType(funcType.ReturnType) {
 		// xmm0 is also the float return register
 		convention.SetRegisterDestination(floatRet)
 	} else {
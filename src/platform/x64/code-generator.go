@@ -0,0 +1,1277 @@
+package x64
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+const (
+	// Linux (SystemV) guarantees the 128 bytes below the stack pointer are not
+	// clobbered by signal / interrupt handlers.  The code generator uses the
+	// first register sized chunk below the stack pointer as scratch memory
+	// whenever the allocator did not provide a scratch register.
+	redZoneOffset = -registerSize
+
+	// Switches with at least this many cases are lowered into jump tables when
+	// the table would have at most maxJumpTableSpanFactor entries per case.
+	// Otherwise, switches are lowered into compare-and-branch chains.
+	minJumpTableCases      = 4
+	maxJumpTableSpanFactor = 3
+)
+
+// Lowers the allocator's per-block operations into a single continuous
+// segment.
+//
+// The entire stack frame is allocated on function entry, and deallocated
+// immediately prior to return.  All stack locations are addressed relative to
+// the stack pointer.
+//
+// Block labels are emitted as local labels; function labels are emitted as
+// global relocations.
+type codeGenerator struct {
+	funcDef *ast.FunctionDefinition
+	frame   *arch.StackFrame
+
+	executable.LabelledSegment
+
+	jumpTables []jumpTable
+}
+
+// A switch's jump table.  The table is placed in its own internal read-only
+// segment, and its entries are the targets' offsets relative to the entry
+// block.  Since the entries are position independent, the table does not
+// require any relocation (i.e., no text relocations in position independent
+// executables / shared objects).
+type jumpTable struct {
+	label   string
+	targets []string // block labels, indexed by case value - minimum value
+}
+
+func (Platform) GenerateCode(
+	funcDef *ast.FunctionDefinition,
+	frame *arch.StackFrame,
+	operations map[*ast.Block][]arch.Operation,
+) []executable.LabelledSegment {
+	gen := &codeGenerator{
+		funcDef: funcDef,
+		frame:   frame,
+		LabelledSegment: executable.LabelledSegment{
+			Label:       funcDef.Label,
+			LocalLabels: map[string]int{},
+		},
+	}
+
+	if frame.TotalFrameSize > 0 {
+		gen.Append(subIntImmediate(64, rsp, uint64(frame.TotalFrameSize)))
+	}
+
+	for _, block := range funcDef.Blocks {
+		_, ok := gen.LocalLabels[block.Label]
+		if ok {
+			panic("duplicate block label: " + block.Label)
+		}
+		gen.LocalLabels[block.Label] = len(gen.Bytes)
+
+		ops := operations[block]
+		for idx, op := range ops {
+			gen.generateOperation(op, ops[idx+1:])
+		}
+	}
+
+	segments := []executable.LabelledSegment{gen.LabelledSegment}
+	for _, table := range gen.jumpTables {
+		segments = append(segments, gen.generateJumpTable(table))
+	}
+
+	return segments
+}
+
+func (gen *codeGenerator) generateJumpTable(
+	table jumpTable,
+) executable.LabelledSegment {
+	segment := executable.LabelledSegment{
+		Label:      table.label,
+		Section:    executable.ReadOnlyDataSection,
+		IsInternal: true,
+		Segment: executable.Segment{
+			Bytes: make([]byte, 0, len(table.targets)*registerSize),
+		},
+	}
+
+	entryOffset := gen.LocalLabels[gen.funcDef.Blocks[0].Label]
+	for _, target := range table.targets {
+		offset, ok := gen.LocalLabels[target]
+		if !ok {
+			panic("should never happen. missing block label: " + target)
+		}
+
+		segment.Bytes = binary.LittleEndian.AppendUint64(
+			segment.Bytes,
+			uint64(offset-entryOffset))
+	}
+
+	return segment
+}
+
+func (gen *codeGenerator) appendAll(segments []executable.Segment) {
+	for _, segment := range segments {
+		gen.Append(segment)
+	}
+}
+
+func (gen *codeGenerator) generateOperation(
+	op arch.Operation,
+	following []arch.Operation,
+) {
+	switch op.Kind {
+	case arch.ExecuteInstruction:
+		gen.executeInstruction(op, following)
+	case arch.PushStackFrame, arch.PopStackFrame:
+		// The allocator does not schedule these operations.  The stack frame is
+		// (de)allocated as part of function entry / return.
+		panic("should never happen")
+	case arch.MoveRegister:
+		gen.copyRegister(op.DestRegister, op.SrcRegister)
+	case arch.CopyLocation:
+		gen.copyLocation(op.Destination, op.Sources[0], op.DestRegister)
+	case arch.SetConstantValue:
+		gen.setConstantValue(op.Destination, op.Value, op.DestRegister)
+	case arch.SetFramePointerAddress:
+		// The frame pointer points to the current frame's return address.
+		gen.Append(
+			loadEffectiveAddress(
+				op.Destination.Registers[0],
+				rsp,
+				int32(gen.frame.TotalFrameSize)))
+	case arch.InitializeZeros:
+		offset := gen.stackOffset(op.Destination)
+		for idx := 0; idx < numChunks(op.Destination); idx++ {
+			gen.Append(
+				storeIntImmediate(64, rsp, offset+int32(idx*registerSize), 0))
+		}
+	case arch.AllocateLocation,
+		arch.FreeLocation,
+		arch.AssignLocationToDefinition:
+		// debugging operations emit no instruction
+	default:
+		panic("unhandled operation kind: " + op.Kind)
+	}
+}
+
+func operandSize(valueType ast.Type) int {
+	return 8 * arch.ByteSize(valueType)
+}
+
+func numChunks(loc *arch.DataLocation) int {
+	if loc.IsOnStack() {
+		return loc.AlignedSize / registerSize
+	}
+	return len(loc.Registers)
+}
+
+// Operations hold copies of the data locations made prior to stack frame
+// finalization.  Hence, fixed stack offsets must be looked up from the
+// finalized frame.
+func (gen *codeGenerator) stackOffset(loc *arch.DataLocation) int32 {
+	if loc.OnTempStack {
+		return int32(loc.Offset)
+	}
+
+	if !loc.OnFixedStack {
+		panic("should never happen")
+	}
+
+	frameLoc, ok := gen.frame.Locations[loc.Name]
+	if !ok {
+		panic("should never happen. missing stack location: " + loc.Name)
+	}
+	return int32(frameLoc.Offset)
+}
+
+func (gen *codeGenerator) copyRegister(dest *arch.Register, src *arch.Register) {
+	if dest == src {
+		return
+	}
+
+	if dest.AllowGeneralOp {
+		if src.AllowGeneralOp {
+			gen.Append(copyInt(64, dest, src))
+		} else {
+			gen.Append(copyFloatToGeneral(dest, src))
+		}
+	} else {
+		if src.AllowGeneralOp {
+			gen.Append(copyGeneralToFloat(dest, src))
+		} else {
+			gen.Append(copyFloat(dest, src))
+		}
+	}
+}
+
+func (gen *codeGenerator) loadRegister(
+	dest *arch.Register,
+	displacement int32,
+) {
+	if dest.AllowGeneralOp {
+		gen.Append(loadInt(64, dest, rsp, displacement))
+	} else {
+		gen.Append(loadFloat(dest, rsp, displacement))
+	}
+}
+
+func (gen *codeGenerator) storeRegister(
+	displacement int32,
+	src *arch.Register,
+) {
+	if src.AllowGeneralOp {
+		gen.Append(storeInt(64, rsp, displacement, src))
+	} else {
+		gen.Append(storeFloat(rsp, displacement, src))
+	}
+}
+
+// Returns true if the 64-bit value could be encoded as a sign-extended 32-bit
+// immediate.
+func isInt32Immediate(value uint64) bool {
+	return int64(math.MinInt32) <= int64(value) && int64(value) <= math.MaxInt32
+}
+
+// Stores a 64-bit value directly onto stack.
+func (gen *codeGenerator) storeImmediate(displacement int32, value uint64) {
+	if isInt32Immediate(value) {
+		gen.Append(storeIntImmediate(64, rsp, displacement, uint32(value)))
+		return
+	}
+
+	gen.Append(storeIntImmediate(32, rsp, displacement, uint32(value)))
+	gen.Append(storeIntImmediate(32, rsp, displacement+4, uint32(value>>32)))
+}
+
+// Calls generate with a general scratch register.  When the scratch register
+// is not provided (or is not a general register), rax's original value is
+// preserved in the red zone.
+func (gen *codeGenerator) withGeneralScratch(
+	scratch *arch.Register,
+	generate func(*arch.Register),
+) {
+	if scratch != nil && scratch.AllowGeneralOp {
+		generate(scratch)
+		return
+	}
+
+	gen.Append(storeInt(64, rsp, redZoneOffset, rax))
+	generate(rax)
+	gen.Append(loadInt(64, rax, rsp, redZoneOffset))
+}
+
+func (gen *codeGenerator) copyLocation(
+	dest *arch.DataLocation,
+	src *arch.DataLocation,
+	scratch *arch.Register,
+) {
+	if numChunks(dest) != numChunks(src) {
+		panic("should never happen")
+	}
+
+	var destOffset int32
+	if dest.IsOnStack() {
+		destOffset = gen.stackOffset(dest)
+	}
+
+	var srcOffset int32
+	if src.IsOnStack() {
+		srcOffset = gen.stackOffset(src)
+	}
+
+	for idx := 0; idx < numChunks(dest); idx++ {
+		chunkOffset := int32(idx * registerSize)
+
+		if !dest.IsOnStack() {
+			if src.IsOnStack() {
+				gen.loadRegister(dest.Registers[idx], srcOffset+chunkOffset)
+			} else {
+				gen.copyRegister(dest.Registers[idx], src.Registers[idx])
+			}
+		} else if !src.IsOnStack() {
+			gen.storeRegister(destOffset+chunkOffset, src.Registers[idx])
+		} else if scratch != nil {
+			gen.loadRegister(scratch, srcOffset+chunkOffset)
+			gen.storeRegister(destOffset+chunkOffset, scratch)
+		} else {
+			gen.withGeneralScratch(
+				nil,
+				func(reg *arch.Register) {
+					gen.loadRegister(reg, srcOffset+chunkOffset)
+					gen.storeRegister(destOffset+chunkOffset, reg)
+				})
+		}
+	}
+}
+
+func intImmediateBits(imm *ast.IntImmediate) uint64 {
+	if imm.IsNegative {
+		return -imm.Value
+	}
+	return imm.Value
+}
+
+func (gen *codeGenerator) setConstantValue(
+	dest *arch.DataLocation,
+	value ast.Value,
+	scratch *arch.Register,
+) {
+	var bits uint64
+	switch imm := value.(type) {
+	case *ast.ZeroImmediate:
+		gen.setZeros(dest)
+		return
+	case *ast.IntImmediate:
+		bits = intImmediateBits(imm)
+	case *ast.BoolImmediate:
+		if imm.Value {
+			bits = 1
+		}
+	case *ast.FloatImmediate:
+		if operandSize(imm.Type()) == 32 {
+			bits = uint64(math.Float32bits(float32(imm.Value)))
+		} else {
+			bits = math.Float64bits(imm.Value)
+		}
+	case *ast.GlobalLabelReference:
+		if !dest.IsOnStack() && dest.Registers[0].AllowGeneralOp {
+			gen.Append(loadLabelAddress(dest.Registers[0], imm.Label, false))
+			return
+		}
+
+		gen.withGeneralScratch(
+			scratch,
+			func(reg *arch.Register) {
+				gen.Append(loadLabelAddress(reg, imm.Label, false))
+				if dest.IsOnStack() {
+					gen.storeRegister(gen.stackOffset(dest), reg)
+				} else {
+					gen.copyRegister(dest.Registers[0], reg)
+				}
+			})
+		return
+	default:
+		panic("unhandled constant value")
+	}
+
+	if dest.IsOnStack() {
+		gen.storeImmediate(gen.stackOffset(dest), bits)
+	} else if dest.Registers[0].AllowGeneralOp {
+		size := 64
+		if bits <= math.MaxUint32 { // 32-bit operand zero-extends to 64-bit
+			size = 32
+		}
+		gen.Append(setIntImmediate(size, dest.Registers[0], bits))
+	} else {
+		gen.storeImmediate(redZoneOffset, bits)
+		gen.Append(loadFloat(dest.Registers[0], rsp, redZoneOffset))
+	}
+}
+
+// Clears all of the location's chunks.
+func (gen *codeGenerator) setZeros(dest *arch.DataLocation) {
+	if dest.IsOnStack() {
+		offset := gen.stackOffset(dest)
+		for idx := 0; idx < numChunks(dest); idx++ {
+			gen.Append(
+				storeIntImmediate(64, rsp, offset+int32(idx*registerSize), 0))
+		}
+		return
+	}
+
+	storedZero := false
+	for _, reg := range dest.Registers {
+		if reg.AllowGeneralOp {
+			gen.Append(setIntImmediate(32, reg, 0))
+			continue
+		}
+
+		if !storedZero {
+			gen.storeImmediate(redZoneOffset, 0)
+			storedZero = true
+		}
+		gen.Append(loadFloat(reg, rsp, redZoneOffset))
+	}
+}
+
+// The allocator selects register destination after the instruction's
+// execution (the destination may reuse source registers).  Hence, the
+// instruction's destination registers are only known from the location
+// allocation that immediately follows the instruction.  This returns nil if
+// the destination is never used.
+func allocatedDestination(
+	def *ast.VariableDefinition,
+	following []arch.Operation,
+) *arch.DataLocation {
+	for _, op := range following {
+		switch op.Kind {
+		case arch.ExecuteInstruction:
+			return nil
+		case arch.AllocateLocation:
+			if op.Destination.Name == def.Name && !op.Destination.IsOnStack() {
+				return op.Destination
+			}
+		}
+	}
+
+	return nil
+}
+
+func (gen *codeGenerator) executeInstruction(
+	op arch.Operation,
+	following []arch.Operation,
+) {
+	switch inst := op.Instruction.(type) {
+	case *ast.UnaryOperation:
+		// Unless the operation converts between int and float, the destination
+		// reuses the source register.
+		src := op.Sources[0].Registers[0]
+		dest := src
+		if ast.IsFloatSubType(inst.Src.Type()) !=
+			ast.IsFloatSubType(inst.Dest.Type) {
+
+			destLoc := allocatedDestination(inst.Dest, following)
+			if destLoc == nil {
+				// The conversion has no side effect.  Note that float to int
+				// conversion never traps natively, even on NaN / out of range values
+				// (the interpreter reports these undefined conversions as errors).
+				return
+			}
+			dest = destLoc.Registers[0]
+		}
+
+		gen.executeUnaryOperation(inst, dest, src)
+	case *ast.BinaryOperation:
+		if inst.Kind.IsComparison() {
+			destLoc := allocatedDestination(inst.Dest, following)
+			if destLoc == nil { // the comparison has no side effect
+				return
+			}
+
+			gen.executeComparison(
+				inst,
+				destLoc.Registers[0],
+				op.Sources[0].Registers[0],
+				op.Sources[1].Registers[0])
+			return
+		}
+
+		// The destination reuses the first source register (div / rem's
+		// destination is implied by the instruction).
+		gen.executeBinaryOperation(inst, op.Sources[0].Registers[0], op.Sources)
+	case *ast.LoadOperation:
+		address := op.Sources[0].Registers[0]
+		if !ast.IsFloatSubType(inst.Dest.Type) {
+			// The destination reuses the address register.
+			gen.Append(
+				loadInt(operandSize(inst.Dest.Type), address, address, 0))
+			return
+		}
+
+		destLoc := allocatedDestination(inst.Dest, following)
+		if destLoc == nil {
+			// The unused value is loaded into a general scratch register since the
+			// load may fault on invalid address (same as int load).
+			gen.withGeneralScratch(
+				nil,
+				func(reg *arch.Register) {
+					gen.Append(
+						loadInt(operandSize(inst.Dest.Type), reg, address, 0))
+				})
+			return
+		}
+		gen.Append(
+			loadScalarFloat(
+				operandSize(inst.Dest.Type),
+				destLoc.Registers[0],
+				address,
+				0))
+	case *ast.StoreOperation:
+		address := op.Sources[0].Registers[0]
+		src := op.Sources[1].Registers[0]
+		size := operandSize(inst.Src.Type())
+		if ast.IsFloatSubType(inst.Src.Type()) {
+			gen.Append(storeScalarFloat(size, address, 0, src))
+		} else {
+			gen.Append(storeInt(size, address, 0, src))
+		}
+	case *ast.ExtractOperation:
+		gen.executeExtractOperation(inst, op)
+	case *ast.InsertOperation:
+		gen.executeInsertOperation(inst, op)
+	case *ast.GetElementOperation:
+		// The index is bound checked even when the element is unused.
+		arrayType := inst.Src.Type().(*ast.ArrayType)
+		scale := arch.ElementStride(arrayType)
+		index, displacement := gen.elementAddress(
+			arrayType,
+			op.Sources[0],
+			inst.Index,
+			op.Sources[1])
+
+		destLoc := allocatedDestination(inst.Dest, following)
+		if destLoc == nil { // the element load itself has no side effect
+			return
+		}
+
+		dest := destLoc.Registers[0]
+		if dest.AllowGeneralOp {
+			gen.Append(loadIntIndexed(dest, rsp, index, scale, displacement))
+		} else {
+			gen.Append(loadFloatIndexed(dest, rsp, index, scale, displacement))
+		}
+	case *ast.SetElementOperation:
+		// The source array is copied into the destination stack slot, then the
+		// element value is stored into the destination.
+		gen.copyChunks(
+			op.Destination,
+			0,
+			op.Sources[0],
+			0,
+			numChunks(op.Destination))
+
+		value := op.Sources[2].Registers[0]
+		arrayType := inst.Dest.Type.(*ast.ArrayType)
+		scale := arch.ElementStride(arrayType)
+		index, displacement := gen.elementAddress(
+			arrayType,
+			op.Destination,
+			inst.Index,
+			op.Sources[1])
+		if value.AllowGeneralOp {
+			gen.Append(storeIntIndexed(rsp, index, scale, displacement, value))
+		} else {
+			gen.Append(storeFloatIndexed(rsp, index, scale, displacement, value))
+		}
+	case *ast.SelectOperation:
+		// The destination reuses the false value register.
+		gen.executeSelectOperation(
+			inst,
+			op.Sources[2].Registers[0],
+			op.Sources[0].Registers[0],
+			op.Sources[1].Registers[0])
+	case *ast.Jump:
+		gen.Append(jmp(inst.Label))
+	case *ast.ConditionalJump:
+		gen.executeConditionalJump(
+			inst,
+			op.Sources[0].Registers[0],
+			op.Sources[1].Registers[0])
+	case *ast.Switch:
+		gen.executeSwitch(inst, op.Sources[0].Registers[0])
+	case *ast.FuncCall:
+		switch inst.Kind {
+		case ast.Call:
+			ref, ok := inst.Func.(*ast.GlobalLabelReference)
+			if ok {
+				gen.Append(callRel(ref.Label))
+			} else {
+				gen.Append(callAbs(op.Sources[0].Registers[0]))
+			}
+		case ast.SysCall:
+			gen.Append(executable.Segment{Bytes: syscall})
+		default:
+			panic("unhandled func call kind: " + inst.Kind)
+		}
+	case *ast.TailCall:
+		gen.executeTailCall(inst, op)
+	case *ast.Terminal:
+		if inst.Kind != ast.Ret {
+			// exit is replaced by syscall immediately after cfg initialization
+			panic("should never happen")
+		}
+
+		if gen.frame.Destination != nil {
+			// The return value is placed on the temp stack by the allocator.  Move
+			// it to the caller allocated destination.
+			gen.copyLocation(gen.frame.Destination, op.Sources[0], nil)
+		}
+
+		if gen.frame.TotalFrameSize > 0 {
+			gen.Append(addIntImmediate(64, rsp, uint64(gen.frame.TotalFrameSize)))
+		}
+		gen.Append(executable.Segment{Bytes: ret})
+	default:
+		panic("unhandled instruction: " + inst.Loc().String())
+	}
+}
+
+// Copies count register sized chunks from the src location (starting at the
+// srcStart-th chunk) to the dest location (starting at the destStart-th chunk).
+func (gen *codeGenerator) copyChunks(
+	dest *arch.DataLocation,
+	destStart int,
+	src *arch.DataLocation,
+	srcStart int,
+	count int,
+) {
+	if !dest.IsOnStack() && !src.IsOnStack() {
+		for idx := 0; idx < count; idx++ {
+			gen.copyRegister(
+				dest.Registers[destStart+idx],
+				src.Registers[srcStart+idx])
+		}
+		return
+	}
+
+	// Stack operands are either both on stack, or both on registers.
+	if !dest.IsOnStack() || !src.IsOnStack() {
+		panic("should never happen")
+	}
+
+	destOffset := gen.stackOffset(dest) + int32(destStart*registerSize)
+	srcOffset := gen.stackOffset(src) + int32(srcStart*registerSize)
+	gen.withGeneralScratch(
+		nil,
+		func(reg *arch.Register) {
+			for idx := 0; idx < count; idx++ {
+				chunkOffset := int32(idx * registerSize)
+				gen.loadRegister(reg, srcOffset+chunkOffset)
+				gen.storeRegister(destOffset+chunkOffset, reg)
+			}
+		})
+}
+
+// Returns the array element's stack address, [rsp + <index> * <stride> +
+// <displacement>].  The index register is nil when the index is an encoded
+// immediate (the type checker rejects out of bound immediate indices).
+//
+// The index register is extended in-place.  This does not modify the index
+// value's semantic since only the lower operand size bits are meaningful.
+// The extended index is then bound checked; out of bound access traps.  Note
+// that negative signed indices are out of bound when compared as unsigned.
+func (gen *codeGenerator) elementAddress(
+	arrayType *ast.ArrayType,
+	array *arch.DataLocation,
+	index ast.Value,
+	indexLoc *arch.DataLocation,
+) (
+	*arch.Register,
+	int32,
+) {
+	stride := int32(arch.ElementStride(arrayType))
+	displacement := gen.stackOffset(array)
+	if indexLoc.EncodedImmediate != nil {
+		imm := indexLoc.EncodedImmediate.(*ast.IntImmediate)
+		return nil, displacement + int32(imm.Value)*stride
+	}
+
+	indexType := index.Type()
+	indexSize := operandSize(indexType)
+	reg := indexLoc.Registers[0]
+	if indexSize < 64 {
+		if ast.IsSignedIntSubType(indexType) {
+			gen.Append(extendSignedInt(64, reg, indexSize, reg))
+		} else {
+			gen.Append(extendUnsignedInt(reg, indexSize, reg))
+		}
+	}
+
+	gen.Append(cmpIntImmediate(64, reg, uint64(arrayType.Length)))
+	gen.Append(jbRel8(int8(len(ud2))))
+	gen.Append(executable.Segment{Bytes: ud2})
+
+	return reg, displacement
+}
+
+// The stack arguments are placed on the temp stack by the allocator.  Move
+// them to the caller allocated stack argument area, deallocate the current
+// frame, and jump to the callee.  The return address remains on the stack,
+// hence the callee returns directly to the current function's caller.
+//
+// NOTE: the temp stack never overlaps with the stack argument area, and the
+// callee-saved registers are already restored by the allocator.
+func (gen *codeGenerator) executeTailCall(
+	inst *ast.TailCall,
+	op arch.Operation,
+) {
+	stackArgs := []*arch.DataLocation{}
+	for _, loc := range op.Sources[1 : len(inst.Args)+1] {
+		if loc.IsOnStack() {
+			stackArgs = append(stackArgs, loc)
+		}
+	}
+
+	if len(stackArgs) > 0 {
+		destOffset := int32(gen.frame.StackArgumentsOffset())
+		gen.withGeneralScratch(
+			nil,
+			func(reg *arch.Register) {
+				for _, loc := range stackArgs {
+					srcOffset := gen.stackOffset(loc)
+					for idx := 0; idx < numChunks(loc); idx++ {
+						chunkOffset := int32(idx * registerSize)
+						gen.loadRegister(reg, srcOffset+chunkOffset)
+						gen.storeRegister(destOffset+chunkOffset, reg)
+					}
+					destOffset += int32(loc.AlignedSize)
+				}
+			})
+	}
+
+	if gen.frame.TotalFrameSize > 0 {
+		gen.Append(addIntImmediate(64, rsp, uint64(gen.frame.TotalFrameSize)))
+	}
+
+	ref, ok := inst.Func.(*ast.GlobalLabelReference)
+	if ok {
+		gen.Append(jmpRel(ref.Label))
+	} else {
+		gen.Append(jmpAbs(op.Sources[0].Registers[0]))
+	}
+}
+
+func (gen *codeGenerator) executeExtractOperation(
+	inst *ast.ExtractOperation,
+	op arch.Operation,
+) {
+	src := op.Sources[0]
+	if !src.IsOnStack() {
+		// The destination reuses the field's source registers.
+		return
+	}
+
+	structType := inst.Src.Type().(*ast.StructType)
+	fieldIdx := structType.FieldIndex(inst.Field)
+	gen.copyChunks(
+		op.Destination,
+		0,
+		src,
+		arch.FieldOffset(structType, fieldIdx)/registerSize,
+		numChunks(op.Destination))
+}
+
+func (gen *codeGenerator) executeInsertOperation(
+	inst *ast.InsertOperation,
+	op arch.Operation,
+) {
+	src := op.Sources[0]
+	value := op.Sources[1]
+
+	structType := inst.Dest.Type.(*ast.StructType)
+	fieldIdx := structType.FieldIndex(inst.Field)
+	fieldStart := arch.FieldOffset(structType, fieldIdx) / registerSize
+
+	dest := src // the destination reuses the source struct registers
+	if src.IsOnStack() {
+		dest = op.Destination
+		gen.copyChunks(dest, 0, src, 0, numChunks(src))
+	}
+
+	gen.copyChunks(dest, fieldStart, value, 0, numChunks(value))
+}
+
+func (gen *codeGenerator) executeUnaryOperation(
+	inst *ast.UnaryOperation,
+	dest *arch.Register,
+	src *arch.Register,
+) {
+	srcType := inst.Src.Type()
+	srcSize := operandSize(srcType)
+	srcIsFloat := ast.IsFloatSubType(srcType)
+
+	destType := inst.Dest.Type
+	destSize := operandSize(destType)
+	destIsFloat := ast.IsFloatSubType(destType)
+
+	switch inst.Kind {
+	case ast.Neg:
+		if !destIsFloat {
+			gen.Append(negSignedInt(destSize, dest))
+			return
+		}
+
+		// Flip the sign bit via the red zone
+		gen.Append(storeFloat(rsp, redZoneOffset, dest))
+		gen.Append(
+			bitwiseXorInt8MemoryImmediate(
+				rsp,
+				redZoneOffset+int32(destSize/8-1),
+				0x80))
+		gen.Append(loadFloat(dest, rsp, redZoneOffset))
+		return
+	case ast.Not:
+		if ast.IsBoolType(destType) {
+			// Only flip the lowest bit to keep the value as 0 / 1.
+			gen.Append(bitwiseXorIntImmediate(destSize, dest, 1))
+		} else {
+			gen.Append(bitwiseNotInt(destSize, dest))
+		}
+		return
+	}
+
+	// Conversion operations
+
+	if srcIsFloat {
+		if destIsFloat {
+			if srcSize == destSize {
+				gen.copyRegister(dest, src)
+			} else {
+				gen.Append(convertFloat(srcSize, dest, src))
+			}
+			return
+		}
+
+		// NaN / out of range values are undefined, and produce the integer
+		// indefinite value (truncated to destSize) rather than trapping.
+		if inst.Kind == ast.ToU64 {
+			gen.truncateFloatToUnsignedInt64(dest, srcSize, src)
+			return
+		}
+
+		convertSize := 32
+		if destSize == 64 || inst.Kind == ast.ToU32 {
+			convertSize = 64
+		}
+		gen.Append(truncateFloatToInt(convertSize, dest, srcSize, src))
+		return
+	}
+
+	if destIsFloat {
+		// The int source is extended in-place.  This does not modify the source
+		// value's semantic since only the lower srcSize bits are meaningful.
+		convertSize := srcSize
+		if ast.IsSignedIntSubType(srcType) {
+			if srcSize < 32 {
+				gen.Append(extendSignedInt(32, src, srcSize, src))
+				convertSize = 32
+			}
+		} else if srcSize < 64 {
+			gen.Append(extendUnsignedInt(src, srcSize, src))
+			convertSize = 64
+		} else {
+			gen.convertUnsignedInt64ToFloat(destSize, dest, src)
+			return
+		}
+
+		gen.Append(convertIntToFloat(destSize, dest, convertSize, src))
+		return
+	}
+
+	if destSize <= srcSize { // truncation is a no-op
+		gen.copyRegister(dest, src)
+	} else if ast.IsSignedIntSubType(srcType) {
+		gen.Append(extendSignedInt(destSize, dest, srcSize, src))
+	} else {
+		gen.Append(extendUnsignedInt(dest, srcSize, src))
+	}
+}
+
+// Values less than 2^63 are converted directly.  Larger values are halved
+// (the shifted out lowest bit is or-ed back in to preserve rounding), then
+// converted and doubled.  The source register is not modified.
+func (gen *codeGenerator) convertUnsignedInt64ToFloat(
+	destSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) {
+	// NOTE: the large value conversion is generated in-line (rather than via
+	// withGeneralScratch) since its size is needed for the jump offset.  rax's
+	// original value (which could be the source) is preserved in the red zone.
+	setLowestBit := bitwiseOrIntImmediate(64, rax, 1)
+
+	large := executable.Segment{}
+	large.Append(storeInt(64, rsp, redZoneOffset, rax))
+	large.Append(copyInt(64, rax, src))
+	large.Append(shiftRightUnsignedIntImmediate(64, rax, 1))
+	large.Append(jaeRel8(int8(len(setLowestBit.Bytes))))
+	large.Append(setLowestBit)
+	large.Append(convertIntToFloat(destSize, dest, 64, rax))
+	large.Append(loadInt(64, rax, rsp, redZoneOffset))
+	large.Append(addFloat(destSize, dest, dest))
+
+	gen.Append(convertIntToFloat(destSize, dest, 64, src))
+	gen.Append(cmpIntImmediate(64, src, 0))
+	gen.Append(jgeRel8(int8(len(large.Bytes))))
+	gen.Append(large)
+}
+
+// Values less than 2^63 are converted directly.  Otherwise, the signed
+// conversion produces the integer indefinite value (1 << 63), and the value
+// in [2^63, 2^64) is reconstructed from the float's significand bits, i.e.,
+// the exponent is 63, and the result is the implicit leading bit (bit 63)
+// followed by the fraction bits.  Larger values are undefined.
+func (gen *codeGenerator) truncateFloatToUnsignedInt64(
+	dest *arch.Register,
+	srcSize int,
+	src *arch.Register,
+) {
+	// Aligns the fraction's highest bit to bit 62.  Note that the float's upper
+	// bits (the sign, exponent, and for F32, the unused upper register bits)
+	// are shifted out.
+	fractionShift := uint8(63 - 52)
+	if srcSize == 32 {
+		fractionShift = 63 - 23
+	}
+
+	large := executable.Segment{}
+	large.Append(copyFloatToGeneral(dest, src))
+	large.Append(shiftLeftIntImmediate(64, dest, fractionShift))
+	large.Append(setBitImmediate(dest, 63))
+
+	gen.Append(truncateFloatToInt(64, dest, srcSize, src))
+	gen.Append(cmpIntImmediate(64, dest, 0))
+	gen.Append(jgeRel8(int8(len(large.Bytes))))
+	gen.Append(large)
+}
+
+func (gen *codeGenerator) executeBinaryOperation(
+	inst *ast.BinaryOperation,
+	dest *arch.Register,
+	srcs []*arch.DataLocation,
+) {
+	size := operandSize(inst.Dest.Type)
+
+	if ast.IsFloatSubType(inst.Dest.Type) {
+		src := srcs[1].Registers[0]
+		switch inst.Kind {
+		case ast.Add:
+			gen.Append(addFloat(size, dest, src))
+		case ast.Sub:
+			gen.Append(subFloat(size, dest, src))
+		case ast.Mul:
+			gen.Append(mulFloat(size, dest, src))
+		case ast.Div:
+			gen.Append(divFloat(size, dest, src))
+		default:
+			panic("unhandled float binary operation: " + inst.Kind)
+		}
+		return
+	}
+
+	isSigned := ast.IsSignedIntSubType(inst.Dest.Type)
+
+	imm, isImmediate := srcs[1].EncodedImmediate.(*ast.IntImmediate)
+	if isImmediate {
+		value := intImmediateBits(imm)
+		switch inst.Kind {
+		case ast.Add:
+			gen.Append(addIntImmediate(size, dest, value))
+		case ast.Sub:
+			gen.Append(subIntImmediate(size, dest, value))
+		case ast.Mul:
+			gen.Append(mulIntImmediate(size, dest, value))
+		case ast.Xor:
+			gen.Append(bitwiseXorIntImmediate(size, dest, value))
+		case ast.Or:
+			gen.Append(bitwiseOrIntImmediate(size, dest, value))
+		case ast.And:
+			gen.Append(bitwiseAndIntImmediate(size, dest, value))
+		case ast.Shl:
+			gen.Append(shiftLeftIntImmediate(size, dest, uint8(value)))
+		case ast.Shr:
+			if isSigned {
+				gen.Append(shiftRightSignedIntImmediate(size, dest, uint8(value)))
+			} else {
+				gen.Append(shiftRightUnsignedIntImmediate(size, dest, uint8(value)))
+			}
+		default:
+			panic("unhandled int binary operation immediate: " + inst.Kind)
+		}
+		return
+	} else if srcs[1].EncodedImmediate != nil {
+		panic("should never happen")
+	}
+
+	src := srcs[1].Registers[0]
+	switch inst.Kind {
+	case ast.Add:
+		gen.Append(addInt(size, dest, src))
+	case ast.Sub:
+		gen.Append(subInt(size, dest, src))
+	case ast.Mul:
+		gen.Append(mulInt(size, dest, src))
+	case ast.Div, ast.Rem:
+		// quotient / remainder destination is selected by the constraints.
+		if isSigned {
+			gen.appendAll(divRemSignedInt(size, src))
+		} else {
+			gen.appendAll(divRemUnsignedInt(size, src))
+		}
+	case ast.Xor:
+		gen.Append(bitwiseXorInt(size, dest, src))
+	case ast.Or:
+		gen.Append(bitwiseOrIntRegister(size, dest, src))
+	case ast.And:
+		gen.Append(bitwiseAndInt(size, dest, src))
+	case ast.Shl:
+		gen.Append(shiftLeftInt(size, dest))
+	case ast.Shr:
+		if isSigned {
+			gen.Append(shiftRightSignedInt(size, dest))
+		} else {
+			gen.Append(shiftRightUnsignedInt(size, dest))
+		}
+	default:
+		panic("unhandled int binary operation: " + inst.Kind)
+	}
+}
+
+func (gen *codeGenerator) executeComparison(
+	inst *ast.BinaryOperation,
+	dest *arch.Register,
+	src1 *arch.Register,
+	src2 *arch.Register,
+) {
+	srcType := inst.Src1.Type()
+	size := operandSize(srcType)
+
+	if ast.IsFloatSubType(srcType) {
+		gen.executeFloatComparison(inst, dest, src1, src2)
+		return
+	}
+
+	gen.Append(cmpInt(size, src1, src2))
+	isSigned := ast.IsSignedIntSubType(srcType)
+
+	switch inst.Kind {
+	case ast.Eq:
+		gen.Append(sete(dest))
+	case ast.Ne:
+		gen.Append(setne(dest))
+	case ast.Lt:
+		if isSigned {
+			gen.Append(setl(dest))
+		} else {
+			gen.Append(setb(dest))
+		}
+	case ast.Le:
+		if isSigned {
+			gen.Append(setle(dest))
+		} else {
+			gen.Append(setbe(dest))
+		}
+	case ast.Gt:
+		if isSigned {
+			gen.Append(setg(dest))
+		} else {
+			gen.Append(seta(dest))
+		}
+	case ast.Ge:
+		if isSigned {
+			gen.Append(setge(dest))
+		} else {
+			gen.Append(setae(dest))
+		}
+	default:
+		panic("unhandled comparison kind: " + inst.Kind)
+	}
+}
+
+// Unordered (NaN) float comparison sets ZF, PF and CF, and all comparisons
+// involving NaN are false.  Hence, lt / le swap the operands and check for
+// gt / ge instead (which are false when CF is set).
+//
+// NOTE: float is not comparable (eq / ne).
+func (gen *codeGenerator) executeFloatComparison(
+	inst *ast.BinaryOperation,
+	dest *arch.Register,
+	src1 *arch.Register,
+	src2 *arch.Register,
+) {
+	size := operandSize(inst.Src1.Type())
+
+	switch inst.Kind {
+	case ast.Lt:
+		gen.Append(cmpFloat(size, src2, src1))
+		gen.Append(seta(dest))
+	case ast.Le:
+		gen.Append(cmpFloat(size, src2, src1))
+		gen.Append(setae(dest))
+	case ast.Gt:
+		gen.Append(cmpFloat(size, src1, src2))
+		gen.Append(seta(dest))
+	case ast.Ge:
+		gen.Append(cmpFloat(size, src1, src2))
+		gen.Append(setae(dest))
+	default:
+		panic("unhandled float comparison kind: " + inst.Kind)
+	}
+}
+
+func (gen *codeGenerator) executeSelectOperation(
+	inst *ast.SelectOperation,
+	dest *arch.Register,
+	cond *arch.Register,
+	trueValue *arch.Register,
+) {
+	gen.Append(cmpIntImmediate(8, cond, 0))
+
+	if !ast.IsFloatSubType(inst.Dest.Type) {
+		gen.Append(cmovne(operandSize(inst.Dest.Type), dest, trueValue))
+		return
+	}
+
+	// There's no float cmov.  Skip over the true value copy when the condition
+	// is false.
+	copyTrueValue := copyFloat(dest, trueValue)
+	gen.Append(jeRel8(int8(len(copyTrueValue.Bytes))))
+	gen.Append(copyTrueValue)
+}
+
+func (gen *codeGenerator) executeConditionalJump(
+	inst *ast.ConditionalJump,
+	src1 *arch.Register,
+	src2 *arch.Register,
+) {
+	srcType := inst.Src1.Type()
+	size := operandSize(srcType)
+
+	if ast.IsFloatSubType(srcType) {
+		// Unordered (NaN) float comparison sets ZF, PF and CF, and the jump is
+		// never taken.  Hence, jlt swaps the operands and checks for gt instead
+		// (which is false when CF is set).  Note that float is not comparable
+		// (jeq / jne).
+		switch inst.Kind {
+		case ast.Jlt:
+			gen.Append(cmpFloat(size, src2, src1))
+			gen.Append(ja(inst.Label))
+		case ast.Jge:
+			gen.Append(cmpFloat(size, src1, src2))
+			gen.Append(jae(inst.Label))
+		default:
+			panic("unhandled float conditional jump kind: " + inst.Kind)
+		}
+		return
+	}
+
+	gen.Append(cmpInt(size, src1, src2))
+	isSigned := ast.IsSignedIntSubType(srcType)
+
+	switch inst.Kind {
+	case ast.Jeq:
+		gen.Append(je(inst.Label))
+	case ast.Jne:
+		gen.Append(jne(inst.Label))
+	case ast.Jlt:
+		if isSigned {
+			gen.Append(jl(inst.Label))
+		} else {
+			gen.Append(jb(inst.Label))
+		}
+	case ast.Jge:
+		if isSigned {
+			gen.Append(jge(inst.Label))
+		} else {
+			gen.Append(jae(inst.Label))
+		}
+	default:
+		panic("unhandled conditional jump kind: " + inst.Kind)
+	}
+}
+
+// The source register is clobbered.  Case values are compared as 64-bit
+// values, which preserves the source type's ordering.
+func (gen *codeGenerator) executeSwitch(
+	inst *ast.Switch,
+	src *arch.Register,
+) {
+	srcType := inst.Src.Type()
+	isSigned := ast.IsSignedIntSubType(srcType)
+
+	size := operandSize(srcType)
+	if size < 64 {
+		if isSigned {
+			gen.Append(extendSignedInt(64, src, size, src))
+		} else {
+			gen.Append(extendUnsignedInt(src, size, src))
+		}
+	}
+
+	cases := slices.Clone(inst.Cases)
+	slices.SortFunc(
+		cases,
+		func(a *ast.SwitchCase, b *ast.SwitchCase) int {
+			aBits := intImmediateBits(a.Value)
+			bBits := intImmediateBits(b.Value)
+			if isSigned {
+				return cmp.Compare(int64(aBits), int64(bBits))
+			}
+			return cmp.Compare(aBits, bBits)
+		})
+
+	// The scratch register's original value is preserved in the red zone.
+	scratch := rax
+	if src == rax {
+		scratch = rcx
+	}
+
+	if len(cases) >= minJumpTableCases {
+		minValue := intImmediateBits(cases[0].Value)
+		span := intImmediateBits(cases[len(cases)-1].Value) - minValue
+		if span < uint64(maxJumpTableSpanFactor*len(cases)) &&
+			isInt32Immediate(minValue) {
+
+			gen.executeJumpTable(inst, cases, src, scratch, minValue, span)
+			return
+		}
+	}
+
+	for _, switchCase := range cases {
+		bits := intImmediateBits(switchCase.Value)
+		if isInt32Immediate(bits) {
+			gen.Append(cmpIntImmediate(64, src, bits))
+		} else {
+			// NOTE: restoring the scratch register does not modify the flags.
+			gen.Append(storeInt(64, rsp, redZoneOffset, scratch))
+			gen.Append(setIntImmediate(64, scratch, bits))
+			gen.Append(cmpInt(64, src, scratch))
+			gen.Append(loadInt(64, scratch, rsp, redZoneOffset))
+		}
+		gen.Append(je(switchCase.Label))
+	}
+	gen.Append(jmp(inst.DefaultLabel))
+}
+
+// The source value is rebased to zero and range checked (values below the
+// minimum wrap around to large unsigned values) before indexing into the
+// table.  The jump target is the entry block's address plus the table entry.
+func (gen *codeGenerator) executeJumpTable(
+	inst *ast.Switch,
+	sortedCases []*ast.SwitchCase,
+	src *arch.Register,
+	scratch *arch.Register,
+	minValue uint64,
+	span uint64,
+) {
+	table := jumpTable{
+		label: fmt.Sprintf(
+			"%s:jump-table-%d",
+			gen.funcDef.Label,
+			len(gen.jumpTables)),
+		targets: make([]string, span+1),
+	}
+	for idx := range table.targets {
+		table.targets[idx] = inst.DefaultLabel
+	}
+	for _, switchCase := range sortedCases {
+		table.targets[intImmediateBits(switchCase.Value)-minValue] =
+			switchCase.Label
+	}
+	gen.jumpTables = append(gen.jumpTables, table)
+
+	if minValue != 0 {
+		gen.Append(subIntImmediate(64, src, minValue))
+	}
+	gen.Append(cmpIntImmediate(64, src, span))
+	gen.Append(ja(inst.DefaultLabel))
+
+	gen.Append(storeInt(64, rsp, redZoneOffset, scratch))
+	gen.Append(loadLabelAddress(scratch, table.label, false))
+	gen.Append(loadIntIndexed(src, scratch, src, registerSize, 0))
+	gen.Append(loadLabelAddress(scratch, gen.funcDef.Blocks[0].Label, true))
+	gen.Append(addInt(64, src, scratch))
+	gen.Append(loadInt(64, scratch, rsp, redZoneOffset))
+	gen.Append(jmpAbs(src))
+}
@@ -12,6 +12,11 @@ var (
 	intConditionalJumpConstraints   = newConditionalJumpConstraints(false)
 	floatConditionalJumpConstraints = newConditionalJumpConstraints(true)
 
+	switchConstraints = newSwitchConstraints()
+
+	intComparisonConstraints   = newComparisonConstraints(false)
+	floatComparisonConstraints = newComparisonConstraints(true)
+
 	intUnaryOpConstraints   = newUnaryOpConstraints(false)
 	floatUnaryOpConstraints = newUnaryOpConstraints(true)
 
@@ -24,6 +29,20 @@ var (
 	shiftConstraints = newShiftConstraints()
 	divConstraints   = newDivRemConstraints(false)
 	remConstraints   = newDivRemConstraints(true)
+
+	intLoadConstraints   = newLoadConstraints(false)
+	floatLoadConstraints = newLoadConstraints(true)
+
+	intStoreConstraints   = newStoreConstraints(false)
+	floatStoreConstraints = newStoreConstraints(true)
+
+	intSelectConstraints   = newSelectConstraints(false)
+	floatSelectConstraints = newSelectConstraints(true)
+)
+
+const (
+	// Structs that occupy more registers than this are operated on stack.
+	maxRegisterStructSize = 4
 )
 
 // nil indicates the value should be in memory.  Otherwise, the return
@@ -32,7 +51,7 @@ var (
 func getRegisterClasses(
 	valueType ast.Type,
 ) []bool {
-	switch valueType.(type) {
+	switch valueType := valueType.(type) {
 	case *ast.ErrorType:
 		panic("should never happen")
 	case *ast.PositiveIntLiteralType:
@@ -41,22 +60,65 @@ func getRegisterClasses(
 		panic("should never happen")
 	case *ast.FloatLiteralType:
 		panic("should never happen")
+	case *ast.ZeroLiteralType:
+		panic("should never happen")
 
+	case *ast.BoolType:
+		return []bool{false}
 	case *ast.SignedIntType:
 		return []bool{false}
 	case *ast.UnsignedIntType:
 		return []bool{false}
 	case *ast.FunctionType:
 		return []bool{false}
+	case *ast.PointerType:
+		return []bool{false}
 
 	case *ast.FloatType:
 		return []bool{true}
 
+	case *ast.StructType:
+		if architecture.NumRegisters(valueType) > maxRegisterStructSize {
+			return nil
+		}
+
+		// Every field is register aligned, hence the struct's register classes
+		// is the concatenation of its fields' register classes.
+		classes := []bool{}
+		for _, field := range valueType.Fields {
+			fieldClasses := getRegisterClasses(field.Type)
+			if fieldClasses == nil {
+				return nil
+			}
+			classes = append(classes, fieldClasses...)
+		}
+		return classes
+
+	case *ast.ArrayType:
+		// Arrays are always indexed in memory.
+		return nil
+
 	default:
 		panic("unhandled type")
 	}
 }
 
+func selectAnyRegisters(
+	constraints *architecture.InstructionConstraints,
+	classes []bool,
+	clobbered bool,
+) []*architecture.RegisterConstraint {
+	registers := make([]*architecture.RegisterConstraint, 0, len(classes))
+	for _, isFloat := range classes {
+		if isFloat {
+			registers = append(registers, constraints.SelectAnyFloat(clobbered))
+		} else {
+			registers = append(registers, constraints.SelectAnyGeneral(clobbered))
+		}
+	}
+	return registers
+}
+
 func newCopyOpConstraints(
 	valueType ast.Type,
 ) *architecture.InstructionConstraints {
@@ -85,6 +147,57 @@ func newConditionalJumpConstraints(
 	return constraints
 }
 
+func newSwitchConstraints() *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// The source register is clobbered by extension to 64-bit and by jump table
+	// indexing.  There's no destination register.
+	constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(true))
+
+	return constraints
+}
+
+func newComparisonConstraints(
+	isFloat bool,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// Comparison compares two source registers without clobbering them, and
+	// sets the bool result in a separate general destination register.
+	if isFloat {
+		constraints.AddRegisterSource(false, constraints.SelectAnyFloat(false))
+		constraints.AddRegisterSource(false, constraints.SelectAnyFloat(false))
+	} else {
+		constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+		constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+	}
+	constraints.SetRegisterDestination(constraints.SelectAnyGeneral(true))
+
+	return constraints
+}
+
+func newSelectConstraints(
+	isFloat bool,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	selectAny := constraints.SelectAnyGeneral
+	if isFloat {
+		selectAny = constraints.SelectAnyFloat
+	}
+
+	// The bool condition and the true value registers are not clobbered.  The
+	// destination reuses the false value register, which is conditionally
+	// overwritten by the true value.
+	constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+	constraints.AddRegisterSource(false, selectAny(false))
+	falseValue := selectAny(true)
+	constraints.AddRegisterSource(false, falseValue)
+	constraints.SetRegisterDestination(falseValue)
+
+	return constraints
+}
+
 func newUnaryOpConstraints(
 	isFloat bool,
 ) *architecture.InstructionConstraints {
@@ -177,3 +290,136 @@ func newDivRemConstraints(
 
 	return constraints
 }
+
+func newLoadConstraints(
+	isFloat bool,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// Int destination reuses the address register.  Float destination is
+	// loaded into a separate float register.
+	if isFloat {
+		constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+		constraints.SetRegisterDestination(constraints.SelectAnyFloat(true))
+	} else {
+		reg := constraints.SelectAnyGeneral(true)
+		constraints.AddRegisterSource(false, reg)
+		constraints.SetRegisterDestination(reg)
+	}
+
+	return constraints
+}
+
+func newStoreConstraints(
+	isFloat bool,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// Neither the address register nor the source register is clobbered.
+	// There's no destination register.
+	constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+	if isFloat {
+		constraints.AddRegisterSource(false, constraints.SelectAnyFloat(false))
+	} else {
+		constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+	}
+
+	return constraints
+}
+
+func newExtractConstraints(
+	structType *ast.StructType,
+	fieldIdx int,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+	fieldType := structType.Fields[fieldIdx].Type
+
+	classes := getRegisterClasses(structType)
+	if classes == nil {
+		// The field is copied from the source stack slot to the destination
+		// stack slot.
+		constraints.AddStackSource(structType)
+		constraints.SetStackDestination(fieldType)
+		return constraints
+	}
+
+	// Destination reuses the field's source registers.  The entire source is
+	// clobbered since clobbering must be uniform across the source registers.
+	registers := selectAnyRegisters(constraints, classes, true)
+	constraints.AddRegisterSource(false, registers...)
+
+	start := architecture.FieldOffset(structType, fieldIdx) / registerSize
+	end := start + architecture.NumRegisters(fieldType)
+	constraints.SetRegisterDestination(registers[start:end]...)
+
+	return constraints
+}
+
+func newInsertConstraints(
+	structType *ast.StructType,
+	fieldIdx int,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+	fieldType := structType.Fields[fieldIdx].Type
+
+	classes := getRegisterClasses(structType)
+	if classes == nil {
+		// The source struct and the field value are copied into the destination
+		// stack slot.
+		constraints.AddStackSource(structType)
+		constraints.AddStackSource(fieldType)
+		constraints.SetStackDestination(structType)
+		return constraints
+	}
+
+	// Destination reuses the source struct registers.  The field value
+	// registers are not clobbered.
+	registers := selectAnyRegisters(constraints, classes, true)
+	constraints.AddRegisterSource(false, registers...)
+	constraints.AddRegisterSource(
+		false,
+		selectAnyRegisters(constraints, getRegisterClasses(fieldType), false)...)
+	constraints.SetRegisterDestination(registers...)
+
+	return constraints
+}
+
+func newGetElementConstraints(
+	arrayType *ast.ArrayType,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// The element is loaded directly from the array's fixed stack slot.  The
+	// index register is not clobbered (constant index is encoded as part of the
+	// displacement).
+	constraints.AddInPlaceStackSource(arrayType)
+	constraints.AddRegisterSource(true, constraints.SelectAnyGeneral(false))
+	if ast.IsFloatSubType(arrayType.ElementType) {
+		constraints.SetRegisterDestination(constraints.SelectAnyFloat(true))
+	} else {
+		constraints.SetRegisterDestination(constraints.SelectAnyGeneral(true))
+	}
+
+	return constraints
+}
+
+func newSetElementConstraints(
+	arrayType *ast.ArrayType,
+) *architecture.InstructionConstraints {
+	constraints := architecture.NewInstructionConstraints()
+
+	// The source array is copied from its fixed stack slot into the
+	// destination stack slot, then the element value is stored into the
+	// destination.  Neither the index register nor the value register is
+	// clobbered (constant index is encoded as part of the displacement).
+	constraints.AddInPlaceStackSource(arrayType)
+	constraints.AddRegisterSource(true, constraints.SelectAnyGeneral(false))
+	if ast.IsFloatSubType(arrayType.ElementType) {
+		constraints.AddRegisterSource(false, constraints.SelectAnyFloat(false))
+	} else {
+		constraints.AddRegisterSource(false, constraints.SelectAnyGeneral(false))
+	}
+	constraints.SetStackDestination(arrayType)
+
+	return constraints
+}
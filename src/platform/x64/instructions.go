@@ -70,6 +70,9 @@ var (
 
 	// https://www.felixcloutier.com/x86/ret
 	ret = []byte{0xc3}
+
+	// https://www.felixcloutier.com/x86/ud
+	ud2 = []byte{0x0f, 0x0b}
 )
 
 // https://www.felixcloutier.com/x86/nop
@@ -173,7 +176,7 @@ func modRMInstruction(
 	addressingModePrefix int,
 	regXReg int, // could also be op code extension
 	rmXReg int,
-	sib *byte, // could be nil
+	sib *scaledIndexBase, // could be nil
 	immediate interface{}, // or displacement; int/uint
 ) executable.Segment {
 	// [0x66] [rex] [op code] [mod rm] [sib] [immediate]
@@ -201,7 +204,16 @@ func modRMInstruction(
 	rexRmX := (rmXReg & 0x08) >> 3
 	modRMRm := rmXReg & 0x07
 
-	rex |= byte(rexRegX | rexRmX)
+	// When SIB is present, modR/M rm is 100 and the B-bit extends SIB.base
+	// instead.  SIB.index's extension bit is encoded in X-bit.
+	rexIndexX := 0
+	if sib != nil {
+		rexRmX = (sib.baseXReg & 0x08) >> 3
+		modRMRm = 0x04
+		rexIndexX = (sib.indexXReg & 0x08) >> 2
+	}
+
+	rex |= byte(rexRegX | rexIndexX | rexRmX)
 
 	// NOTE: rex makes AH / CH / DH / BH inaccessible for 8-bit operand
 	if operandSize == 8 || rex != rexPrefix {
@@ -221,7 +233,7 @@ func modRMInstruction(
 	idx++
 
 	if sib != nil {
-		result[idx] = *sib
+		result[idx] = sib.encode()
 		idx++
 	}
 
@@ -257,30 +269,81 @@ func directAddressInstruction(
 		immediate)
 }
 
+// SIB byte's [<base> + <index> * <scale>] address computation.
+type scaledIndexBase struct {
+	scale     int // 1, 2, 4, or 8
+	indexXReg int // 0.100 (rsp) indicates no index
+	baseXReg  int
+}
+
+// sibByte = (SIB.scale, SIB.index, SIB.base).  The upper bits of index and
+// base are encoded in REX.X and REX.B respectively.
+func (sib *scaledIndexBase) encode() byte {
+	scaleBits := 0
+	switch sib.scale {
+	case 1:
+	case 2:
+		scaleBits = 1
+	case 4:
+		scaleBits = 2
+	case 8:
+		scaleBits = 3
+	default:
+		panic("should never happen")
+	}
+
+	return byte(scaleBits<<6 | (sib.indexXReg&0x07)<<3 | sib.baseXReg&0x07)
+}
+
 // Operations of the forms:
 //
 //	<opCode> <reg>, [<rm> + <displacement>]  ; RM operand-encoding
 //	<opCode> [<rm> + <displacement>], <reg>  ; MR operand-encoding
-//
-// XXX: maybe support SIB's (scale * index)?
 func indirectAddressInstruction(
 	operandSize int,
 	extendedOpCode bool,
 	opCode byte,
-	reg *arch.Register,
+	regXReg int, // could also be op code extension
 	rm *arch.Register,
 	displacement int32,
+) executable.Segment {
+	return indexedAddressInstruction(
+		operandSize,
+		extendedOpCode,
+		opCode,
+		regXReg,
+		rm,
+		nil,
+		1,
+		displacement)
+}
+
+// Operations of the forms:
+//
+//	<opCode> <reg>, [<base> + <index> * <scale> + <displacement>]  ; RM
+//	<opCode> [<base> + <index> * <scale> + <displacement>], <reg>  ; MR
+//
+// The index is optional (could be nil), and the scale must be 1, 2, 4, or 8.
+func indexedAddressInstruction(
+	operandSize int,
+	extendedOpCode bool,
+	opCode byte,
+	regXReg int, // could also be op code extension
+	base *arch.Register,
+	index *arch.Register,
+	scale int,
+	displacement int32,
 ) executable.Segment {
 	addressingMode := modRMIndirectAddressing0
-	var sib *byte
 	var immediate interface{} = displacement
 	if displacement == 0 {
 		immediate = nil
 
 		// NOTE: We must use an alternative encoding for rbp/r13 since the default
-		// encoding refers to [RIP + disp32].
-		if rm == rbp || rm == r13 {
-			addressingMode = modRMIndirectAddressing8 // [<rm> + 0x0]
+		// encoding refers to [RIP + disp32] (or [<index> * <scale> + disp32]
+		// when SIB is present).
+		if base == rbp || base == r13 {
+			addressingMode = modRMIndirectAddressing8 // [<base> + 0x0]
 			immediate = int8(0)
 		}
 	} else if math.MinInt8 <= displacement && displacement <= math.MaxInt8 {
@@ -290,20 +353,27 @@ func indirectAddressInstruction(
 		addressingMode = modRMIndirectAddressing32
 	}
 
-	// NOTE: rsp and r12 require SIB byte to encode [<rsp/r12> + <disp>]
-	if rm == rsp || rm == r12 {
-		// sibByte = (SIB.scale, SIB.index, SIB.base) where
-		//
-		// SIB.scale = 00 (factor s = 1)
-		//  - We can use any scale factor since it's ignored.
-		//
-		// SIB.index = 0.100 (rsp)
-		//  - rsp address computation mode ignores index and scale
-		//
-		// SIB.base = ?.100 (either rsp or r12)
-		//  - the upper bit is in REX.B
-		sibByte := byte(0x24)
-		sib = &sibByte
+	var sib *scaledIndexBase
+	if index != nil {
+		// NOTE: SIB.index = 0.100 (rsp) indicates no index.  Hence, rsp cannot
+		// be used as index.
+		if index == rsp {
+			panic("should never happen")
+		}
+
+		sib = &scaledIndexBase{
+			scale:     scale,
+			indexXReg: xRegMapping[index],
+			baseXReg:  xRegMapping[base],
+		}
+	} else if base == rsp || base == r12 {
+		// NOTE: rsp and r12 require SIB byte to encode [<rsp/r12> + <disp>].
+		// rsp address computation mode ignores index and scale.
+		sib = &scaledIndexBase{
+			scale:     1,
+			indexXReg: xRegMapping[rsp],
+			baseXReg:  xRegMapping[base],
+		}
 	}
 
 	return modRMInstruction(
@@ -311,12 +381,29 @@ func indirectAddressInstruction(
 		extendedOpCode,
 		opCode,
 		addressingMode,
-		xRegMapping[reg],
-		xRegMapping[rm],
+		regXReg,
+		xRegMapping[base],
 		sib,
 		immediate)
 }
 
+// Without rex, 8-bit r/m operand refers to AH / CH / DH / BH rather than
+// SPL / BPL / SIL / DIL.  This ensures the (non-16-bit operand) instruction is
+// prefixed by rex.
+func withRexPrefix(instruction executable.Segment) executable.Segment {
+	if instruction.Bytes[0]&0xf0 == rexPrefix {
+		return instruction
+	}
+
+	if len(instruction.Relocations) > 0 {
+		panic("should never happen")
+	}
+
+	return executable.Segment{
+		Bytes: append([]byte{rexPrefix}, instruction.Bytes...),
+	}
+}
+
 func opCode(operandSize int, opCode byte, opCode8Bit byte) byte {
 	if operandSize == 8 {
 		return opCode8Bit
@@ -356,6 +443,7 @@ func immediate(operandSize int, val uint64, allow64 bool) interface{} {
 // uint/int jne (jne): 0F 85 cd
 // uint jlt (jb):      0F 82 cd
 // uint jge (jae):     0F 83 cd
+// uint jgt (ja):      0F 87 cd
 // int jlt (jl):       0F 8C cd
 // int jge (jge):      0F 8D cd
 //
@@ -470,13 +558,14 @@ func extendSignedInt(
 
 	switch srcOperandSize {
 	case 8:
-		return directAddressInstruction(
-			destOperandSize,
-			true,
-			0xbe,
-			xRegMapping[dest],
-			xRegMapping[src],
-			nil)
+		return withRexPrefix(
+			directAddressInstruction(
+				destOperandSize,
+				true,
+				0xbe,
+				xRegMapping[dest],
+				xRegMapping[src],
+				nil))
 	case 16:
 		return directAddressInstruction(
 			destOperandSize,
@@ -525,13 +614,14 @@ func extendUnsignedInt(
 ) executable.Segment {
 	switch srcOperandSize {
 	case 8:
-		return directAddressInstruction(
-			32,
-			true,
-			0xb6,
-			xRegMapping[dest],
-			xRegMapping[src],
-			nil)
+		return withRexPrefix(
+			directAddressInstruction(
+				32,
+				true,
+				0xb6,
+				xRegMapping[dest],
+				xRegMapping[src],
+				nil))
 	case 16:
 		return directAddressInstruction(
 			32,
@@ -1061,6 +1151,26 @@ func shiftLeftInt(
 		nil)
 }
 
+// <int/uint dest> |= 1 << <uint8 immediate> (aka bts)
+//
+// https://www.felixcloutier.com/x86/bts
+//
+// (Not sign extension sensitive)
+//
+// REX.W + 0F BA /5 ib
+func setBitImmediate(
+	dest *arch.Register,
+	bit uint8,
+) executable.Segment {
+	return directAddressInstruction(
+		64,
+		true,
+		0xba,
+		5,
+		xRegMapping[dest],
+		bit)
+}
+
 // <int/uint dest> <<= <uint8 immediate>
 //
 // https://www.felixcloutier.com/x86/sal:sar:shl:shr
@@ -1247,7 +1357,7 @@ func storeInt(
 		operandSize,
 		false,
 		opCode(operandSize, 0x89, 0x88),
-		src,
+		xRegMapping[src],
 		address,
 		displacement)
 }
@@ -1270,11 +1380,157 @@ func loadInt(
 		operandSize,
 		false,
 		opCode(operandSize, 0x8b, 0x8a),
-		dest,
+		xRegMapping[dest],
+		address,
+		displacement)
+}
+
+// [<base> + <index> * <scale> + <displacement>] = <src>
+//
+// https://www.felixcloutier.com/x86/mov
+//
+// 64-bit: REX.W + 89 /r
+func storeIntIndexed(
+	base *arch.Register,
+	index *arch.Register,
+	scale int,
+	displacement int32,
+	src *arch.Register,
+) executable.Segment {
+	return indexedAddressInstruction(
+		64,
+		false,
+		0x89,
+		xRegMapping[src],
+		base,
+		index,
+		scale,
+		displacement)
+}
+
+// <dest> = [<base> + <index> * <scale> + <displacement>]
+//
+// https://www.felixcloutier.com/x86/mov
+//
+// 64-bit: REX.W + 8B /r
+func loadIntIndexed(
+	dest *arch.Register,
+	base *arch.Register,
+	index *arch.Register,
+	scale int,
+	displacement int32,
+) executable.Segment {
+	return indexedAddressInstruction(
+		64,
+		false,
+		0x8b,
+		xRegMapping[dest],
+		base,
+		index,
+		scale,
+		displacement)
+}
+
+// [<address> + <displacement>] = <sign-extended int32 immediate>
+//
+// https://www.felixcloutier.com/x86/mov
+//
+// 32-bit: C7 /0 id
+// 64-bit: REX.W + C7 /0 id
+func storeIntImmediate(
+	operandSize int,
+	address *arch.Register,
+	displacement int32,
+	value uint32,
+) executable.Segment {
+	if operandSize != 32 && operandSize != 64 {
+		panic("should never happen")
+	}
+
+	segment := indirectAddressInstruction(
+		operandSize,
+		false,
+		0xc7,
+		0,
+		address,
+		displacement)
+	segment.Bytes = binary.LittleEndian.AppendUint32(segment.Bytes, value)
+	return segment
+}
+
+// [<address> + <displacement>] ^= <uint8 immediate>
+//
+// https://www.felixcloutier.com/x86/xor
+//
+// 8-bit: REX + 80 /6 ib
+func bitwiseXorInt8MemoryImmediate(
+	address *arch.Register,
+	displacement int32,
+	value uint8,
+) executable.Segment {
+	segment := indirectAddressInstruction(
+		8,
+		false,
+		0x80,
+		6,
+		address,
+		displacement)
+	segment.Bytes = append(segment.Bytes, value)
+	return segment
+}
+
+// <dest> = <address> + <displacement>
+//
+// https://www.felixcloutier.com/x86/lea
+//
+// 64-bit: REX.W + 8D /r
+func loadEffectiveAddress(
+	dest *arch.Register,
+	address *arch.Register,
+	displacement int32,
+) executable.Segment {
+	return indirectAddressInstruction(
+		64,
+		false,
+		0x8d,
+		xRegMapping[dest],
 		address,
 		displacement)
 }
 
+// <dest> = <label address>  (i.e., lea <dest>, [rip + <rel32>])
+//
+// https://www.felixcloutier.com/x86/lea
+//
+// 64-bit: REX.W + 8D /r (mod = 00, rm = 101)
+func loadLabelAddress(
+	dest *arch.Register,
+	label string,
+	isLocalLabel bool,
+) executable.Segment {
+	segment := modRMInstruction(
+		64,
+		false,
+		0x8d,
+		modRMIndirectAddressing0,
+		xRegMapping[dest],
+		0x05, // [rip + disp32]
+		nil,
+		uint32(0))
+
+	segment.Relocations = []executable.Relocation{
+		{
+			Kind:   executable.Rel32Relocation,
+			Offset: len(segment.Bytes) - 4,
+			Label: executable.SegmentLabel{
+				Name:    label,
+				IsLocal: isLocalLabel,
+			},
+		},
+	}
+	return segment
+}
+
 // cmp <src1>, <src2>
 //
 // https://www.felixcloutier.com/x86/cmp
@@ -1358,6 +1614,88 @@ func jmp(blockLabel string) executable.Segment {
 	return rel32Instruction(false, 0xe9, blockLabel, true)
 }
 
+// jmp <rel32>
+//
+// https://www.felixcloutier.com/x86/jmp
+//
+// unconditional jump to function (tail call): E9 cd
+func jmpRel(functionLabel string) executable.Segment {
+	return rel32Instruction(false, 0xe9, functionLabel, false)
+}
+
+// jmp <address in register>
+//
+// https://www.felixcloutier.com/x86/jmp
+//
+// absolute indirect jump: FF /4
+func jmpAbs(
+	address *arch.Register,
+) executable.Segment {
+	return directAddressInstruction(
+		32, // NOTE: using 32-bit operand to disable REX.W bit
+		false,
+		0xff,
+		4,
+		xRegMapping[address],
+		nil)
+}
+
+// je <rel8>
+//
+// https://www.felixcloutier.com/x86/jcc
+//
+// Only used for skipping over instructions within the same operation (the
+// offset is relative to the end of this instruction).
+//
+// uint/int jeq: 74 cb
+func jeRel8(offset int8) executable.Segment {
+	return executable.Segment{
+		Bytes: []byte{0x74, byte(offset)},
+	}
+}
+
+// jb <rel8>
+//
+// https://www.felixcloutier.com/x86/jcc
+//
+// Only used for skipping over instructions within the same operation (the
+// offset is relative to the end of this instruction).
+//
+// uint jlt: 72 cb
+func jbRel8(offset int8) executable.Segment {
+	return executable.Segment{
+		Bytes: []byte{0x72, byte(offset)},
+	}
+}
+
+// jae <rel8>
+//
+// https://www.felixcloutier.com/x86/jcc
+//
+// Only used for skipping over instructions within the same operation (the
+// offset is relative to the end of this instruction).
+//
+// uint jge (aka jnc): 73 cb
+func jaeRel8(offset int8) executable.Segment {
+	return executable.Segment{
+		Bytes: []byte{0x73, byte(offset)},
+	}
+}
+
+// jge <rel8>
+//
+// https://www.felixcloutier.com/x86/jcc
+//
+// Only used for skipping over instructions within the same operation (the
+// offset is relative to the end of this instruction).
+//
+// int jge: 7D cb
+func jgeRel8(offset int8) executable.Segment {
+	return executable.Segment{
+		Bytes: []byte{0x7d, byte(offset)},
+	}
+}
+
 // je <rel32>
 //
 // https://www.felixcloutier.com/x86/jcc
@@ -1394,6 +1732,15 @@ func jae(blockLabel string) executable.Segment {
 	return rel32Instruction(true, 0x83, blockLabel, true)
 }
 
+// ja <rel32>
+//
+// https://www.felixcloutier.com/x86/jcc
+//
+// uint jgt: 0F 87 cd
+func ja(blockLabel string) executable.Segment {
+	return rel32Instruction(true, 0x87, blockLabel, true)
+}
+
 // jl <rel32>
 //
 // https://www.felixcloutier.com/x86/jcc
@@ -1411,3 +1758,546 @@ func jl(blockLabel string) executable.Segment {
 func jge(blockLabel string) executable.Segment {
 	return rel32Instruction(true, 0x8d, blockLabel, true)
 }
+
+// cmovne <int/uint dest>, <int/uint src>
+//
+// https://www.felixcloutier.com/x86/cmovcc
+//
+// (Not sign extension sensitive)
+//
+// 8/16/32-bit: 0F 45 /r
+// 64-bit:      REX.W + 0F 45 /r
+func cmovne(
+	operandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	if operandSize != 64 {
+		operandSize = 32
+	}
+
+	return directAddressInstruction(
+		operandSize,
+		true,
+		0x45,
+		xRegMapping[dest],
+		xRegMapping[src],
+		nil)
+}
+
+// set<cc> <dest>
+//
+// https://www.felixcloutier.com/x86/setcc
+//
+// Only the lower 8 bits of dest are set.
+//
+// REX + 0F <op code> /0
+func setccInstruction(
+	opCode byte,
+	dest *arch.Register,
+) executable.Segment {
+	return directAddressInstruction(8, true, opCode, 0, xRegMapping[dest], nil)
+}
+
+// sete <dest>
+//
+// uint/int eq: REX + 0F 94 /0
+func sete(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x94, dest)
+}
+
+// setne <dest>
+//
+// uint/int ne: REX + 0F 95 /0
+func setne(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x95, dest)
+}
+
+// setb <dest>
+//
+// uint lt: REX + 0F 92 /0
+func setb(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x92, dest)
+}
+
+// setae <dest>
+//
+// uint ge: REX + 0F 93 /0
+func setae(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x93, dest)
+}
+
+// setbe <dest>
+//
+// uint le: REX + 0F 96 /0
+func setbe(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x96, dest)
+}
+
+// seta <dest>
+//
+// uint gt: REX + 0F 97 /0
+func seta(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x97, dest)
+}
+
+// setl <dest>
+//
+// int lt: REX + 0F 9C /0
+func setl(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x9c, dest)
+}
+
+// setge <dest>
+//
+// int ge: REX + 0F 9D /0
+func setge(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x9d, dest)
+}
+
+// setle <dest>
+//
+// int le: REX + 0F 9E /0
+func setle(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x9e, dest)
+}
+
+// setg <dest>
+//
+// int gt: REX + 0F 9F /0
+func setg(dest *arch.Register) executable.Segment {
+	return setccInstruction(0x9f, dest)
+}
+
+// SSE instructions' mandatory prefix (66 / F2 / F3) must precede the REX
+// prefix.
+func withMandatoryPrefix(
+	prefix byte,
+	instruction executable.Segment,
+) executable.Segment {
+	if len(instruction.Relocations) > 0 {
+		panic("should never happen")
+	}
+
+	return executable.Segment{
+		Bytes: append([]byte{prefix}, instruction.Bytes...),
+	}
+}
+
+// Scalar single (F3) / double (F2) precision float instruction's mandatory
+// prefix.
+func scalarFloatPrefix(operandSize int) byte {
+	switch operandSize {
+	case 32:
+		return 0xf3
+	case 64:
+		return 0xf2
+	default:
+		panic("should never happen")
+	}
+}
+
+// Scalar single / double precision float instruction of the form
+//
+//	F3/F2 [rex] 0F <opcode> /r
+func scalarFloatInstruction(
+	operandSize int,
+	opCode byte,
+	regXReg int,
+	rmXReg int,
+) executable.Segment {
+	return withMandatoryPrefix(
+		scalarFloatPrefix(operandSize),
+		directAddressInstruction(32, true, opCode, regXReg, rmXReg, nil))
+}
+
+// <float dest> = <general src> (bitwise copy of the full 64-bit register)
+//
+// https://www.felixcloutier.com/x86/movd:movq
+//
+// 66 REX.W 0F 6E /r
+func copyGeneralToFloat(
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0x66,
+		directAddressInstruction(
+			64,
+			true,
+			0x6e,
+			xRegMapping[dest],
+			xRegMapping[src],
+			nil))
+}
+
+// <general dest> = <float src> (bitwise copy of the lower 64 bits)
+//
+// https://www.felixcloutier.com/x86/movd:movq
+//
+// 66 REX.W 0F 7E /r
+func copyFloatToGeneral(
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0x66,
+		directAddressInstruction(
+			64,
+			true,
+			0x7e,
+			xRegMapping[src],
+			xRegMapping[dest],
+			nil))
+}
+
+// <float dest> = <float src>
+//
+// https://www.felixcloutier.com/x86/movaps
+//
+// 0F 28 /r
+func copyFloat(
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return directAddressInstruction(
+		32,
+		true,
+		0x28,
+		xRegMapping[dest],
+		xRegMapping[src],
+		nil)
+}
+
+// <float dest> = [<address> + <displacement>] (the full 64-bit chunk)
+//
+// https://www.felixcloutier.com/x86/movq
+//
+// F3 0F 7E /r
+func loadFloat(
+	dest *arch.Register,
+	address *arch.Register,
+	displacement int32,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0xf3,
+		indirectAddressInstruction(
+			32,
+			true,
+			0x7e,
+			xRegMapping[dest],
+			address,
+			displacement))
+}
+
+// [<address> + <displacement>] = <float src> (the lower 64 bits)
+//
+// https://www.felixcloutier.com/x86/movq
+//
+// 66 0F D6 /r
+func storeFloat(
+	address *arch.Register,
+	displacement int32,
+	src *arch.Register,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0x66,
+		indirectAddressInstruction(
+			32,
+			true,
+			0xd6,
+			xRegMapping[src],
+			address,
+			displacement))
+}
+
+// <float dest> = [<base> + <index> * <scale> + <displacement>] (64 bits)
+//
+// https://www.felixcloutier.com/x86/movq
+//
+// F3 0F 7E /r
+func loadFloatIndexed(
+	dest *arch.Register,
+	base *arch.Register,
+	index *arch.Register,
+	scale int,
+	displacement int32,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0xf3,
+		indexedAddressInstruction(
+			32,
+			true,
+			0x7e,
+			xRegMapping[dest],
+			base,
+			index,
+			scale,
+			displacement))
+}
+
+// [<base> + <index> * <scale> + <displacement>] = <float src> (the lower 64
+// bits)
+//
+// https://www.felixcloutier.com/x86/movq
+//
+// 66 0F D6 /r
+func storeFloatIndexed(
+	base *arch.Register,
+	index *arch.Register,
+	scale int,
+	displacement int32,
+	src *arch.Register,
+) executable.Segment {
+	return withMandatoryPrefix(
+		0x66,
+		indexedAddressInstruction(
+			32,
+			true,
+			0xd6,
+			xRegMapping[src],
+			base,
+			index,
+			scale,
+			displacement))
+}
+
+// <float dest> = [<address> + <displacement>] (only the operand size bits)
+//
+// https://www.felixcloutier.com/x86/movss
+// https://www.felixcloutier.com/x86/movsd
+//
+// 32-bit: F3 0F 10 /r
+// 64-bit: F2 0F 10 /r
+func loadScalarFloat(
+	operandSize int,
+	dest *arch.Register,
+	address *arch.Register,
+	displacement int32,
+) executable.Segment {
+	return withMandatoryPrefix(
+		scalarFloatPrefix(operandSize),
+		indirectAddressInstruction(
+			32,
+			true,
+			0x10,
+			xRegMapping[dest],
+			address,
+			displacement))
+}
+
+// [<address> + <displacement>] = <float src> (only the operand size bits)
+//
+// https://www.felixcloutier.com/x86/movss
+// https://www.felixcloutier.com/x86/movsd
+//
+// 32-bit: F3 0F 11 /r
+// 64-bit: F2 0F 11 /r
+func storeScalarFloat(
+	operandSize int,
+	address *arch.Register,
+	displacement int32,
+	src *arch.Register,
+) executable.Segment {
+	return withMandatoryPrefix(
+		scalarFloatPrefix(operandSize),
+		indirectAddressInstruction(
+			32,
+			true,
+			0x11,
+			xRegMapping[src],
+			address,
+			displacement))
+}
+
+// <float dest> += <float src>
+//
+// https://www.felixcloutier.com/x86/addss
+// https://www.felixcloutier.com/x86/addsd
+//
+// 32-bit: F3 0F 58 /r
+// 64-bit: F2 0F 58 /r
+func addFloat(
+	operandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return scalarFloatInstruction(
+		operandSize,
+		0x58,
+		xRegMapping[dest],
+		xRegMapping[src])
+}
+
+// <float dest> -= <float src>
+//
+// https://www.felixcloutier.com/x86/subss
+// https://www.felixcloutier.com/x86/subsd
+//
+// 32-bit: F3 0F 5C /r
+// 64-bit: F2 0F 5C /r
+func subFloat(
+	operandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return scalarFloatInstruction(
+		operandSize,
+		0x5c,
+		xRegMapping[dest],
+		xRegMapping[src])
+}
+
+// <float dest> *= <float src>
+//
+// https://www.felixcloutier.com/x86/mulss
+// https://www.felixcloutier.com/x86/mulsd
+//
+// 32-bit: F3 0F 59 /r
+// 64-bit: F2 0F 59 /r
+func mulFloat(
+	operandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return scalarFloatInstruction(
+		operandSize,
+		0x59,
+		xRegMapping[dest],
+		xRegMapping[src])
+}
+
+// <float dest> /= <float src>
+//
+// https://www.felixcloutier.com/x86/divss
+// https://www.felixcloutier.com/x86/divsd
+//
+// 32-bit: F3 0F 5E /r
+// 64-bit: F2 0F 5E /r
+func divFloat(
+	operandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return scalarFloatInstruction(
+		operandSize,
+		0x5e,
+		xRegMapping[dest],
+		xRegMapping[src])
+}
+
+// <float dest> = <float src> (converted to the other precision)
+//
+// https://www.felixcloutier.com/x86/cvtss2sd
+// https://www.felixcloutier.com/x86/cvtsd2ss
+//
+// 32-bit src: F3 0F 5A /r
+// 64-bit src: F2 0F 5A /r
+func convertFloat(
+	srcOperandSize int,
+	dest *arch.Register,
+	src *arch.Register,
+) executable.Segment {
+	return scalarFloatInstruction(
+		srcOperandSize,
+		0x5a,
+		xRegMapping[dest],
+		xRegMapping[src])
+}
+
+// <float dest> = <signed int src>
+//
+// https://www.felixcloutier.com/x86/cvtsi2ss
+// https://www.felixcloutier.com/x86/cvtsi2sd
+//
+// 32-bit dest: F3 (REX.W) 0F 2A /r
+// 64-bit dest: F2 (REX.W) 0F 2A /r
+//
+// (REX.W is set for 64-bit src operand)
+func convertIntToFloat(
+	destOperandSize int,
+	dest *arch.Register,
+	srcOperandSize int,
+	src *arch.Register,
+) executable.Segment {
+	prefix := byte(0xf2)
+	if destOperandSize == 32 {
+		prefix = 0xf3
+	}
+
+	return withMandatoryPrefix(
+		prefix,
+		directAddressInstruction(
+			srcOperandSize,
+			true,
+			0x2a,
+			xRegMapping[dest],
+			xRegMapping[src],
+			nil))
+}
+
+// <signed int dest> = <float src> (truncated toward zero)
+//
+// https://www.felixcloutier.com/x86/cvttss2si
+// https://www.felixcloutier.com/x86/cvttsd2si
+//
+// 32-bit src: F3 (REX.W) 0F 2C /r
+// 64-bit src: F2 (REX.W) 0F 2C /r
+//
+// (REX.W is set for 64-bit dest operand)
+func truncateFloatToInt(
+	destOperandSize int,
+	dest *arch.Register,
+	srcOperandSize int,
+	src *arch.Register,
+) executable.Segment {
+	prefix := byte(0xf2)
+	if srcOperandSize == 32 {
+		prefix = 0xf3
+	}
+
+	return withMandatoryPrefix(
+		prefix,
+		directAddressInstruction(
+			destOperandSize,
+			true,
+			0x2c,
+			xRegMapping[dest],
+			xRegMapping[src],
+			nil))
+}
+
+// ucomiss / ucomisd <src1>, <src2>
+//
+// https://www.felixcloutier.com/x86/ucomiss
+// https://www.felixcloutier.com/x86/ucomisd
+//
+// The result is reported via ZF / CF (i.e., use unsigned jcc variants).
+//
+// NOTE: unordered (NaN) comparison sets ZF, PF and CF.  Only the ja / jae
+// (seta / setae) variants are false when the comparison is unordered.
+//
+// 32-bit: 0F 2E /r
+// 64-bit: 66 0F 2E /r
+func cmpFloat(
+	operandSize int,
+	src1 *arch.Register,
+	src2 *arch.Register,
+) executable.Segment {
+	instruction := directAddressInstruction(
+		32,
+		true,
+		0x2e,
+		xRegMapping[src1],
+		xRegMapping[src2],
+		nil)
+
+	switch operandSize {
+	case 32:
+		return instruction
+	case 64:
+		return withMandatoryPrefix(0x66, instruction)
+	default:
+		panic("should never happen")
+	}
+}
@@ -54,7 +54,7 @@ func (p Platform) InstructionConstraints(
 	case *ast.CopyOperation:
 		return newCopyOpConstraints(inst.Dest.Type)
 	case *ast.UnaryOperation:
-		if ast.IsFloatSubType(inst.Dest.Type) {
+		if ast.IsFloatSubType(inst.Src.Type()) {
 			switch inst.Kind {
 			case ast.ToI8, ast.ToI16, ast.ToI32, ast.ToI64,
 				ast.ToU8, ast.ToU16, ast.ToU32, ast.ToU64:
@@ -72,7 +72,13 @@ func (p Platform) InstructionConstraints(
 			}
 		}
 	case *ast.BinaryOperation:
-		if ast.IsFloatSubType(inst.Dest.Type) {
+		if inst.Kind.IsComparison() {
+			if ast.IsFloatSubType(inst.Src1.Type()) {
+				return floatComparisonConstraints
+			} else {
+				return intComparisonConstraints
+			}
+		} else if ast.IsFloatSubType(inst.Dest.Type) {
 			return genericFloatBinaryOpConstraints
 		} else {
 			switch inst.Kind {
@@ -86,6 +92,38 @@ func (p Platform) InstructionConstraints(
 				return genericIntBinaryOpConstraints
 			}
 		}
+	case *ast.LoadOperation:
+		if ast.IsFloatSubType(inst.Dest.Type) {
+			return floatLoadConstraints
+		} else {
+			return intLoadConstraints
+		}
+	case *ast.StoreOperation:
+		if ast.IsFloatSubType(inst.Src.Type()) {
+			return floatStoreConstraints
+		} else {
+			return intStoreConstraints
+		}
+	case *ast.ExtractOperation:
+		structType := inst.Src.Type().(*ast.StructType)
+		return newExtractConstraints(
+			structType,
+			structType.FieldIndex(inst.Field))
+	case *ast.InsertOperation:
+		structType := inst.Dest.Type.(*ast.StructType)
+		return newInsertConstraints(
+			structType,
+			structType.FieldIndex(inst.Field))
+	case *ast.GetElementOperation:
+		return newGetElementConstraints(inst.Src.Type().(*ast.ArrayType))
+	case *ast.SetElementOperation:
+		return newSetElementConstraints(inst.Dest.Type.(*ast.ArrayType))
+	case *ast.SelectOperation:
+		if ast.IsFloatSubType(inst.Dest.Type) {
+			return floatSelectConstraints
+		} else {
+			return intSelectConstraints
+		}
 	case *ast.Jump:
 		return jumpConstraints
 	case *ast.ConditionalJump:
@@ -94,6 +132,8 @@ func (p Platform) InstructionConstraints(
 		} else {
 			return intConditionalJumpConstraints
 		}
+	case *ast.Switch:
+		return switchConstraints
 	case *ast.FuncCall:
 		switch inst.Kind {
 		case ast.Call:
@@ -104,6 +144,11 @@ func (p Platform) InstructionConstraints(
 		default:
 			panic("unhandled func call kind: " + inst.Kind)
 		}
+	case *ast.TailCall:
+		calleeType := inst.Func.Type().(*ast.FunctionType)
+		funcType := inst.ParentBlock().ParentFuncDef.FuncType
+		return p.CallConvention(funcType).TailCallConstraints(
+			p.CallConvention(calleeType))
 	case *ast.Terminal:
 		switch inst.Kind {
 		case ast.Ret:
@@ -124,8 +169,13 @@ func (p Platform) CanEncodeImmediate(value ast.Value) bool {
 	switch val := value.(type) {
 	case *ast.IntImmediate:
 		return p.canEncodeIntImmediate(val)
+	case *ast.GlobalLabelReference:
+		// Tail call jumps directly to the function label (see
+		// architecture.CallConvention.CanTailCall).
+		tailCall, ok := val.ParentInstruction.(*ast.TailCall)
+		return ok && tailCall.Func == value
 	default:
-		// TODO handle label references / float immediate
+		// TODO handle other label references / float immediate
 		return false
 	}
 }
@@ -137,6 +187,16 @@ func (p Platform) canEncodeIntImmediate(imm *ast.IntImmediate) bool {
 	//
 	// TODO figure out all the corner cases ...
 
+	// Constant array indices are folded into the element's displacement.  The
+	// indices are always within the array bounds due to type checking
+	// enforcement.
+	switch inst := imm.ParentInstruction.(type) {
+	case *ast.GetElementOperation:
+		return inst.Index == ast.Value(imm)
+	case *ast.SetElementOperation:
+		return inst.Index == ast.Value(imm)
+	}
+
 	binary, ok := imm.ParentInstruction.(*ast.BinaryOperation)
 	if !ok {
 		return false
@@ -0,0 +1,151 @@
+package x64
+
+import (
+	"fmt"
+
+	"github.com/pattyshack/gt/parseutil"
+
+	arch "github.com/pattyshack/chickadee/architecture"
+	"github.com/pattyshack/chickadee/ast"
+	"github.com/pattyshack/chickadee/platform/executable"
+)
+
+const (
+	startStubLabel = "_start"
+)
+
+// The start stub is the process' entry point.  The stub calls the entry
+// function with the given constant int arguments (using the entry function's
+// call convention), then exits the process using the entry function's return
+// value as exit status.  The exit status is zero for unit return type.
+//
+// NOTE: The kernel guarantees the stack pointer is stack frame aligned at
+// process entry.
+func (p Platform) GenerateStartStub(
+	entryLabel string,
+	entryType *ast.FunctionType,
+	args []int64,
+) (
+	executable.LabelledSegment,
+	error,
+) {
+	if len(args) != len(entryType.ParameterTypes) {
+		return executable.LabelledSegment{}, fmt.Errorf(
+			"entry function (@%s) expects %d arguments, but %d were given",
+			entryLabel,
+			len(entryType.ParameterTypes),
+			len(args))
+	}
+
+	for idx, paramType := range entryType.ParameterTypes {
+		if !ast.IsIntSubType(paramType) {
+			return executable.LabelledSegment{}, fmt.Errorf(
+				"entry function (@%s) argument %d has unsupported type (%s)",
+				entryLabel,
+				idx,
+				paramType)
+		}
+	}
+
+	if !ast.IsIntSubType(entryType.ReturnType) &&
+		!ast.IsUnitType(entryType.ReturnType) {
+
+		return executable.LabelledSegment{}, fmt.Errorf(
+			"entry function (@%s) has unsupported return type (%s)",
+			entryLabel,
+			entryType.ReturnType)
+	}
+
+	constraints := p.CallConvention(entryType).CallConstraints
+	layout := newStubCallLayout(constraints, entryType)
+
+	gen := &codeGenerator{
+		LabelledSegment: executable.LabelledSegment{
+			Label:       startStubLabel,
+			LocalLabels: map[string]int{},
+		},
+	}
+
+	if layout.stackSize > 0 {
+		gen.Append(subIntImmediate(64, rsp, uint64(layout.stackSize)))
+	}
+
+	for idx, src := range constraints.Sources[1:] {
+		value := uint64(args[idx])
+		if src.RequireOnStack {
+			gen.storeImmediate(layout.sourceOffsets[idx], value)
+		} else {
+			gen.Append(setIntImmediate(64, src.Registers[0].Require, value))
+		}
+	}
+
+	gen.Append(callRel(entryLabel))
+
+	exitCall := &ast.FuncCall{
+		Kind: ast.SysCall,
+		Func: p.sysCallSpec.ExitSysCallFuncValue(parseutil.StartEndPos{}),
+		Args: []ast.Value{nil},
+	}
+	exitConstraints := newSysCallConstraints(p.os, exitCall)
+	exitFuncValue := exitConstraints.Sources[0].Registers[0].Require
+	exitStatus := exitConstraints.Sources[1].Registers[0].Require
+
+	if ast.IsUnitType(entryType.ReturnType) {
+		gen.Append(setIntImmediate(32, exitStatus, 0))
+	} else if constraints.Destination.RequireOnStack {
+		gen.Append(loadInt(32, exitStatus, rsp, layout.destinationOffset))
+	} else {
+		gen.Append(
+			copyInt(32, exitStatus, constraints.Destination.Registers[0].Require))
+	}
+
+	gen.Append(
+		setIntImmediate(
+			32,
+			exitFuncValue,
+			intImmediateBits(exitCall.Func.(*ast.IntImmediate))))
+	gen.Append(executable.Segment{Bytes: syscall})
+
+	return gen.LabelledSegment, nil
+}
+
+// The entry function call's temp stack layout.
+type stubCallLayout struct {
+	// Stack source offsets (relative to the stack pointer), indexed by
+	// parameter.  Register sources' entries are unused.
+	sourceOffsets []int32
+
+	destinationOffset int32 // only valid for stack destination
+
+	stackSize int // stack frame aligned
+}
+
+// Stack sources are laid out (from top to bottom) in the same order as the
+// call's sources, followed by the stack destination.
+func newStubCallLayout(
+	constraints *arch.InstructionConstraints,
+	entryType *ast.FunctionType,
+) stubCallLayout {
+	layout := stubCallLayout{
+		sourceOffsets: make([]int32, len(entryType.ParameterTypes)),
+	}
+
+	for idx, src := range constraints.Sources[1:] { // skip func value
+		if src.RequireOnStack {
+			layout.sourceOffsets[idx] = int32(layout.stackSize)
+			layout.stackSize += arch.AlignedSize(entryType.ParameterTypes[idx])
+		}
+	}
+
+	layout.destinationOffset = int32(layout.stackSize)
+	if constraints.Destination.RequireOnStack {
+		layout.stackSize += arch.AlignedSize(entryType.ReturnType)
+	}
+
+	if layout.stackSize%arch.StackFrameAlignment != 0 {
+		layout.stackSize += arch.StackFrameAlignment -
+			layout.stackSize%arch.StackFrameAlignment
+	}
+
+	return layout
+}